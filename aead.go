@@ -0,0 +1,272 @@
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// AEAD ciphers as specified by SIP004. Every connection starts with a random
+// salt, from which a per session subkey is derived with HKDF-SHA1, followed by
+// chunks made of an encrypted 2 byte payload length and the encrypted
+// payload, each with its own tag.
+
+const aeadMaxPayload = 0x3FFF
+
+var (
+	errAEADMethod = errors.New("aead cipher method not supported")
+	errAEADChunk  = errors.New("aead chunk too large")
+	errAEADPacket = errors.New("aead packet cannot be opened")
+)
+
+type aeadSpec struct {
+	keySize    int
+	saltSize   int
+	maxPayload int
+	newAEAD    func(key []byte) (cipher.AEAD, error)
+	// subkey derives the session key from the master key and a salt
+	subkey func(key, salt []byte) ([]byte, error)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+var aeadSpecs = map[string]*aeadSpec{
+	"aes-128-gcm":            {16, 16, aeadMaxPayload, newGCM, hkdfSubkey},
+	"aes-192-gcm":            {24, 24, aeadMaxPayload, newGCM, hkdfSubkey},
+	"aes-256-gcm":            {32, 32, aeadMaxPayload, newGCM, hkdfSubkey},
+	"chacha20-ietf-poly1305": {32, 32, aeadMaxPayload, chacha20poly1305.New, hkdfSubkey},
+
+	"2022-blake3-aes-128-gcm":       {16, 16, ss2022MaxPayload, newGCM, blake3Subkey},
+	"2022-blake3-aes-256-gcm":       {32, 32, ss2022MaxPayload, newGCM, blake3Subkey},
+	"2022-blake3-chacha20-poly1305": {32, 32, ss2022MaxPayload, chacha20poly1305.New, blake3Subkey},
+}
+
+func isAEADMethod(method string) bool {
+	_, ok := aeadSpecs[method]
+	return ok
+}
+
+type aeadCipher struct {
+	*aeadSpec
+	key    []byte
+	ss2022 bool
+}
+
+func newAEADCipher(method, password string) (*aeadCipher, error) {
+	spec, ok := aeadSpecs[method]
+	if !ok {
+		return nil, errAEADMethod
+	}
+	if isSS2022Method(method) {
+		psk, err := decodePSK(password, spec.keySize)
+		if err != nil {
+			return nil, err
+		}
+		return &aeadCipher{spec, psk, true}, nil
+	}
+	return &aeadCipher{spec, evpBytesToKey(password, spec.keySize), false}, nil
+}
+
+// evpBytesToKey derives the master key from the password the way OpenSSL's
+// EVP_BytesToKey does with MD5, as every shadowsocks implementation does.
+func evpBytesToKey(password string, keyLen int) []byte {
+	var key, prev []byte
+	h := md5.New()
+	for len(key) < keyLen {
+		h.Write(prev)
+		h.Write([]byte(password))
+		key = h.Sum(key)
+		prev = key[len(key)-h.Size():]
+		h.Reset()
+	}
+	return key[:keyLen]
+}
+
+func hkdfSubkey(key, salt []byte) ([]byte, error) {
+	subkey := make([]byte, len(key))
+	if _, err := io.ReadFull(hkdf.New(sha1.New, key, salt, []byte("ss-subkey")), subkey); err != nil {
+		return nil, err
+	}
+	return subkey, nil
+}
+
+func (c *aeadCipher) sessionAEAD(salt []byte) (cipher.AEAD, error) {
+	subkey, err := c.subkey(c.key, salt)
+	if err != nil {
+		return nil, err
+	}
+	return c.newAEAD(subkey)
+}
+
+// increment treats b as a little endian counter.
+func increment(b []byte) {
+	for i := range b {
+		b[i]++
+		if b[i] != 0 {
+			return
+		}
+	}
+}
+
+// aeadConn encrypts a stream connection with an AEAD cipher. It is used on
+// both ends, each side writing with its own salt.
+type aeadConn struct {
+	net.Conn
+	cipher   *aeadCipher
+	enc, dec cipher.AEAD
+	encNonce []byte
+	decNonce []byte
+	buf      []byte // chunk read buffer
+	readBuf  []byte // decrypted payload not returned yet
+}
+
+func newAEADConn(c net.Conn, cipher *aeadCipher) *aeadConn {
+	return &aeadConn{Conn: c, cipher: cipher}
+}
+
+func (c *aeadConn) Write(b []byte) (n int, err error) {
+	var out []byte
+	if c.enc == nil {
+		salt := make([]byte, c.cipher.saltSize)
+		if _, err = rand.Read(salt); err != nil {
+			return
+		}
+		if c.enc, err = c.cipher.sessionAEAD(salt); err != nil {
+			return
+		}
+		c.encNonce = make([]byte, c.enc.NonceSize())
+		out = salt
+	}
+	overhead := c.enc.Overhead()
+	for n < len(b) {
+		size := len(b) - n
+		if size > c.cipher.maxPayload {
+			size = c.cipher.maxPayload
+		}
+		length := []byte{byte(size >> 8), byte(size)}
+		out = c.enc.Seal(out, c.encNonce, length, nil)
+		increment(c.encNonce)
+		out = c.enc.Seal(out, c.encNonce, b[n:n+size], nil)
+		increment(c.encNonce)
+		n += size
+		if len(out) >= 16*1024 || n == len(b) {
+			if _, err = c.Conn.Write(out); err != nil {
+				return
+			}
+			out = make([]byte, 0, 2+overhead+c.cipher.maxPayload+overhead)
+		}
+	}
+	return
+}
+
+func (c *aeadConn) readChunk() (err error) {
+	if c.dec == nil {
+		salt := make([]byte, c.cipher.saltSize)
+		if _, err = io.ReadFull(c.Conn, salt); err != nil {
+			return
+		}
+		if c.dec, err = c.cipher.sessionAEAD(salt); err != nil {
+			return
+		}
+		c.decNonce = make([]byte, c.dec.NonceSize())
+		c.buf = make([]byte, c.cipher.maxPayload+c.dec.Overhead())
+	}
+	overhead := c.dec.Overhead()
+	buf := c.buf[:2+overhead]
+	if _, err = io.ReadFull(c.Conn, buf); err != nil {
+		return
+	}
+	length, err := c.dec.Open(buf[:0], c.decNonce, buf, nil)
+	if err != nil {
+		return
+	}
+	increment(c.decNonce)
+	size := int(binary.BigEndian.Uint16(length))
+	if size > c.cipher.maxPayload {
+		return errAEADChunk
+	}
+
+	buf = c.buf[:size+overhead]
+	if _, err = io.ReadFull(c.Conn, buf); err != nil {
+		return
+	}
+	if c.readBuf, err = c.dec.Open(buf[:0], c.decNonce, buf, nil); err != nil {
+		return
+	}
+	increment(c.decNonce)
+	return
+}
+
+func (c *aeadConn) Read(b []byte) (int, error) {
+	for len(c.readBuf) == 0 {
+		if err := c.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(b, c.readBuf)
+	c.readBuf = c.readBuf[n:]
+	return n, nil
+}
+
+// aeadPacketConn encrypts every datagram on its own: a random salt followed
+// by the payload sealed with an all zero nonce.
+type aeadPacketConn struct {
+	net.PacketConn
+	cipher *aeadCipher
+}
+
+func newAEADPacketConn(c net.PacketConn, cipher *aeadCipher) *aeadPacketConn {
+	return &aeadPacketConn{c, cipher}
+}
+
+func (c *aeadPacketConn) WriteTo(b []byte, addr net.Addr) (int, error) {
+	salt := make([]byte, c.cipher.saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return 0, err
+	}
+	aead, err := c.cipher.sessionAEAD(salt)
+	if err != nil {
+		return 0, err
+	}
+	out := aead.Seal(salt, make([]byte, aead.NonceSize()), b, nil)
+	if _, err = c.PacketConn.WriteTo(out, addr); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (c *aeadPacketConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	buf := make([]byte, udpBufSize)
+	n, addr, err := c.PacketConn.ReadFrom(buf)
+	if err != nil {
+		return 0, addr, err
+	}
+	if n < c.cipher.saltSize {
+		return 0, addr, errAEADPacket
+	}
+	aead, err := c.cipher.sessionAEAD(buf[:c.cipher.saltSize])
+	if err != nil {
+		return 0, addr, err
+	}
+	ciphertext := buf[c.cipher.saltSize:n]
+	plain, err := aead.Open(ciphertext[:0], make([]byte, aead.NonceSize()), ciphertext, nil)
+	if err != nil {
+		return 0, addr, errAEADPacket
+	}
+	return copy(b, plain), addr, nil
+}
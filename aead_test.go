@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+)
+
+func TestEvpBytesToKey(t *testing.T) {
+	// md5("foobar"), then md5(md5("foobar") + "foobar")
+	key := hex.EncodeToString(evpBytesToKey("foobar", 32))
+	if key != "3858f62230ac3c915f300c664312c63f568378529614d22ddb49237d2f60bfdf" {
+		t.Errorf("unexpected key %s", key)
+	}
+}
+
+// serveAEAD runs a shadowsocks server for one connection: it reads the
+// target address and echoes whatever follows it.
+func serveAEAD(t *testing.T, ln net.Listener, cipher *aeadCipher, rawaddr []byte) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	c := newAEADConn(conn, cipher)
+	addr := make([]byte, len(rawaddr))
+	if _, err = io.ReadFull(c, addr); err != nil {
+		t.Errorf("server reading address: %v", err)
+		return
+	}
+	if !bytes.Equal(addr, rawaddr) {
+		t.Errorf("server got address %v, want %v", addr, rawaddr)
+		return
+	}
+	io.Copy(c, c)
+}
+
+func TestAEADServerConn(t *testing.T) {
+	rawaddr := []byte{socksAddrDomain, 11, 'e', 'x', 'a', 'm', 'p', 'l', 'e', '.', 'c', 'o', 'm', 0, 80}
+	// big enough to be split in several chunks
+	payload := bytes.Repeat([]byte("shadowsocks"), 10000)
+	for method := range aeadSpecs {
+		if isSS2022Method(method) {
+			continue
+		}
+		cipher, err := newAEADCipher(method, "secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		go serveAEAD(t, ln, cipher, rawaddr)
+
+		se := &ServerCipher{server: ln.Addr().String(), aead: cipher, breaker: &circuitBreaker{}}
+		conn, err := dialServer(se, rawaddr)
+		if err != nil {
+			t.Fatalf("%s: dial: %v", method, err)
+		}
+		go conn.Write(payload)
+		got := make([]byte, len(payload))
+		if _, err = io.ReadFull(conn, got); err != nil {
+			t.Errorf("%s: read echo: %v", method, err)
+		} else if !bytes.Equal(got, payload) {
+			t.Errorf("%s: echo does not match", method)
+		}
+		conn.Close()
+		ln.Close()
+	}
+}
+
+func TestAEADWireFormat(t *testing.T) {
+	cipher, _ := newAEADCipher("chacha20-ietf-poly1305", "secret")
+	client, server := net.Pipe()
+	go func() {
+		newAEADConn(client, cipher).Write([]byte("hello"))
+		client.Close()
+	}()
+	wire, _ := ioutil.ReadAll(server)
+
+	// salt, sealed length, sealed payload
+	salt := wire[:cipher.saltSize]
+	aead, err := cipher.sessionAEAD(salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	rest := wire[cipher.saltSize:]
+	length, err := aead.Open(nil, nonce, rest[:2+aead.Overhead()], nil)
+	if err != nil {
+		t.Fatalf("open length: %v", err)
+	}
+	if length[0] != 0 || length[1] != 5 {
+		t.Errorf("unexpected length %v", length)
+	}
+	nonce[0]++
+	data, err := aead.Open(nil, nonce, rest[2+aead.Overhead():], nil)
+	if err != nil || string(data) != "hello" {
+		t.Errorf("unexpected payload %q: %v", data, err)
+	}
+}
+
+// A SIP004 stream made with OpenSSL: EVP_BytesToKey with MD5 for the master
+// key, HKDF-SHA1 with info "ss-subkey" for the session key and AES-256-GCM
+// for the chunk, the way every shadowsocks server sends it.
+func TestAEADKnownAnswer(t *testing.T) {
+	const (
+		masterKey = "5ebe2294ecd0e0f08eab7690d2a6ee6926ae5cc854e36b6bdfca366848dea6bb"
+		subkey    = "556c9aa6723717689c29669ee6bcc4033a3ce613f858fd90f4b3350e2ee00edc"
+		salt      = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+		length    = "990fb6b850a3f41878d19bcd85d3ee5bc29a"       // sealed 0x0005
+		payload   = "21f98bee3c432162386728a1a48b47ccc1a9a2ec83" // sealed "hello"
+		wire      = salt + length + payload
+	)
+	cipher, _ := newAEADCipher("aes-256-gcm", "secret")
+	if key := hex.EncodeToString(cipher.key); key != masterKey {
+		t.Errorf("master key %s", key)
+	}
+	saltBytes, _ := hex.DecodeString(salt)
+	sub, err := hkdfSubkey(cipher.key, saltBytes)
+	if err != nil || hex.EncodeToString(sub) != subkey {
+		t.Errorf("subkey %x: %v", sub, err)
+	}
+	wireBytes, _ := hex.DecodeString(wire)
+	client, server := net.Pipe()
+	go func() {
+		server.Write(wireBytes)
+		server.Close()
+	}()
+	data, err := ioutil.ReadAll(newAEADConn(client, cipher))
+	if err != nil || string(data) != "hello" {
+		t.Errorf("unexpected payload %q: %v", data, err)
+	}
+}
+
+func TestAEADPacketConn(t *testing.T) {
+	cipher, _ := newAEADCipher("aes-256-gcm", "secret")
+	a, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+	b, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	if _, err = newAEADPacketConn(a, cipher).WriteTo([]byte("ping"), b.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 64)
+	n, _, err := newAEADPacketConn(b, cipher).ReadFrom(buf)
+	if err != nil || string(buf[:n]) != "ping" {
+		t.Errorf("unexpected datagram %q: %v", buf[:n], err)
+	}
+}
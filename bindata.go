@@ -4,6 +4,7 @@
 // res/icon22x22.ico
 // res/logo.png
 // res/pac.tpl
+// res/rules.txt
 // res/ui/.DS_Store
 // res/ui/.gitignore
 // res/ui/app.js
@@ -149,7 +150,7 @@ func logoPng() (*asset, error) {
 	return a, nil
 }
 
-var _pacTpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\xbd\x7b\x8f\xec\xca\x91\x1f\xf8\xff\xfd\x14\xed\x8b\x85\x71\xaf\x24\xd2\x5d\x7d\xfa\x9c\x7b\x7a\x65\x61\xe1\x1d\x8d\x0d\x01\xf2\x6a\x20\x7b\x81\x19\x48\xda\x8b\x64\x32\x49\x66\xf1\x91\x6c\x66\x66\x55\xb1\x46\xfa\xee\x1b\x91\xaf\x08\x56\x1f\xcd\x02\x0b\xcf\xb5\x75\xa6\xab\xbb\x8a\x45\x66\xc6\xe3\x17\x11\xbf\x88\x6c\x17\xfb\x5b\x33\x0b\xbd\xfc\xce\x3e\xfd\xe6\xa9\xf3\x8b\x74\xda\x2c\x3f\x0c\xbf\x7a\x6a\x7f\x7c\xfa\xd7\xef\x9e\xe0\xbf\x4d\x39\xbf\x2d\x4f\x43\xad\x97\x56\xdd\xfe\xd0\xfd\xd0\xfe\x0a\x5e\x4c\x6a\xe9\xdd\xf0\x54\x3d\xb5\xe9\xc7\x1f\x9f\xfe\xc3\x6f\x7e\xf3\x54\x9d\x9e\xfe\xe3\x7f\x7c\xfa\x21\xff\xf2\xe9\x37\xf0\xbb\xf2\xe6\xbf\xfe\x95\x5d\xe5\xfb\xfa\xfb\xa7\x5f\x3e\x1d\xaf\x95\x7f\xf9\xe3\xc3\x35\x7f\xfc\xee\x6f\xdf\x7d\x97\x6f\xee\xe9\xbf\xc2\x25\xfe\x69\x33\xb7\xfd\xbf\x9a\xed\xff\xfe\xe3\xef\x7f\xf0\x70\x8d\x7c\xb3\xf0\x8d\x70\x41\x67\x7e\x6f\xae\x6a\xfb\x07\x61\xd5\x0f\x3f\xfe\x3a\xfc\xe1\x22\xb6\x27\x11\x7f\x14\xf0\x9e\x3f\x7d\x7f\x7a\xf9\xa9\x7e\x86\xff\x77\xfa\xfe\x57\x4f\xdf\x4f\x46\x8a\x69\x30\xd6\xe1\x8b\xd3\xdb\x4b\x7d\xfa\xf2\xb5\xfe\xc5\xf7\x7f\x89\x1f\xe8\xcc\xf6\xf4\x83\x86\x4f\x3d\xff\xfa\x49\x3f\xfd\xe7\x27\x91\x6e\x0f\x5e\xfd\xf2\x97\xf9\x9b\xf1\x3f\xdd\x3d\xfd\x60\x87\x7f\xbc\xad\xff\x5d\x38\x39\xe0\x2a\x8a\x3f\xe9\xbf\xfc\xc8\xdf\xc2\x56\xf4\xfb\xdf\xfe\xee\x8f\xff\xf8\x0f\xff\xf3\xfb\xf2\xb7\xbf\x7d\x47\xff\xe2\xfd\x6a\xfb\xdf\x26\xd3\x88\xe9\x37\x3f\xff\xfc\xbb\xff\xf1\xf3\x7f\xfb\xfd\x1f\xfe\xcf\xff\xf2\xfb\x9f\x7f\x8e\xf7\x84\x5f\x95\xff\xce\xaf\x9f\xaf\xfd\xf3\xcf\xff\xf4\xc7\x3f\xfc\xf3\xbf\xfc\xfc\xf3\xf7\xec\xa2\x02\xae\xf4\xdb\x3f\xfc\xf7\xff\xf2\xbb\xff\xeb\x7f\xe4\xeb\xfc\x7b\x3d\xdb\xc3\xf7\x3f\x3e\x1e\x5e\x2d\x4a\xc7\xf7\xda\x58\xb1\xae\xb6\xd6\xce\x2f\xca\xd6\xf0\xf3\xa4\x6a\x69\xe6\xef\xbf\xf5\x58\x7c\xc9\xfe\xc6\x76\xf3\x17\x6f\xa7\xfa\xf4\x8c\x7b\x06\x1b\xf8\x8b\xd3\xf3\x5b\xfd\xf2\xe9\xad\x3e\xbd\x3e\xe7\xdf\xbc\xc2\xab\xcf\xaf\xb0\xaf\xcf\xff\xfe\xfb\xfa\xff\xf1\xec\xf1\x8e\xaf\xd7\x6b\x7c\xd6\x56\xe8\x69\xc7\x07\xae\xdd\x15\xef\xf5\xd3\xd7\x7a\x56\xad\x16\xb5\xf3\x73\x33\x6d\x61\x29\xe0\xd7\x9b\x5a\x4d\xdd\x1b\xd1\xab\xc5\xd5\x66\xeb\xf1\x77\xce\x6c\x1b\xbc\x1c\xb5\x73\xbb\x5c\xf2\x3b\xf1\xca\xab\x6c\x2f\x2d\xbb\x68\xab\x2e\x5a\x2c\x4e\x6c\xae\x5e\x94\x7b\xf8\x0d\xfb\x5c\xeb\xe6\xf2\x52\x35\x52\xc8\x41\xc1\x97\x9a\x7e\x52\xde\xaa\x4d\x9a\xc5\xe1\xd7\xa7\x77\xac\x93\xd8\x57\xa9\xac\x29\x37\xd9\x4c\xa6\xb7\xab\x71\xfc\x35\xfe\x8f\xbf\xee\x55\x79\x7b\xf7\x6e\xc6\xfc\x2c\x93\x5b\xf8\xfd\x4e\x95\xb5\x53\xfa\xea\xb2\x04\xdb\x6e\x4f\x2f\x9f\xf2\x4b\xeb\x57\xb5\x8d\x7a\xd4\x3f\xfd\x54\xe3\x85\xdf\xde\xea\x4e\xbe\xe4\xbf\x86\x35\xac\xc2\x13\x54\x62\x7c\xae\x57\xbd\xe8\xb9\xdc\xc8\xa4\x17\x05\xcb\x8c\x3f\x8a\x75\x4c\x5f\x7a\x5b\x74\xa7\xf2\xfa\xec\xc6\x9f\xf5\xfd\x3e\xe9\x0b\x7d\x7f\x6d\x95\xd8\xe4\x50\xef\x62\x30\x26\xff\xd6\x5d\xeb\x5e\xf7\x42\xea\x4d\xd2\xad\xe2\xd2\x58\x27\xd0\x5e\xe5\x5f\xa9\x6a\x80\xb5\x13\x3a\x3f\x70\xaf\xf4\xa4\x9d\x69\xc5\x9e\x7f\x83\x1b\x30\x99\xa5\xbf\x68\xab\xcb\x12\x5a\xe3\x37\xa9\x40\x54\xfb\x72\x6b\x72\xf0\xb0\x73\xbe\x6e\xf4\x1d\x5f\x0e\xa3\x70\x97\x45\x5d\x6d\xfe\x88\x97\xb5\x6f\xf9\x6a\x5e\x95\x6e\x4c\x78\xc7\x55\xa9\x26\x4a\x5b\xb8\x4b\x7d\xd3\x97\x7c\x55\xfd\x52\x1f\x5e\xf7\x93\xb0\xf6\x6b\xad\x7c\xf8\x4a\xb8\x9e\x18\x05\x78\x8a\xda\x3a\x25\xe6\xf0\x6c\xb2\x88\x0a\xaa\x45\xbd\x9a\x6d\xa9\xf2\x43\xbb\x4b\xb8\x79\xe1\x37\x3d\x96\x2d\xa9\xbb\x4d\xa9\x79\x0f\xea\x9e\x45\x40\x09\x50\x1a\x90\x6d\xbd\xb8\xf2\x4b\x14\x21\xdd\x95\xdb\xfc\x28\xbc\x76\x69\x65\x5b\x56\xf6\xe9\x7c\x5d\xd9\x4b\x27\xfa\x9b\x6a\x0d\x93\xe3\xcd\xc9\xb0\xc6\xff\x7f\xf4\x9e\x79\xc8\xff\x15\x46\xef\x3f\xfd\xb9\xfe\x3f\xb2\x96\xfc\xb9\xfe\xf3\xf5\x5f\x5f\xfe\xf6\xbf\xfd\xa7\xda\x29\xeb\x7e\x00\x27\x06\x1e\x12\xde\x10\xa5\xfe\xcf\xf8\x00\xdf\x7e\xcb\x0f\xf9\x1d\xf1\x8f\x7f\xa5\x0f\xe4\x5f\xfd\x78\xfc\xc0\xd6\x6a\xf3\xa7\xff\xe7\xcf\xf5\x5f\x7e\xf1\xe7\xb4\x8d\x43\xfb\x67\x5c\xcc\xe3\xdb\xea\x5f\xe0\x1b\xf1\x7b\xcb\xef\x7f\xfc\xc6\xc3\x71\x37\xea\x7c\x6b\x3c\x2d\x35\xc8\x59\x7e\x71\xf3\xcb\xa4\x74\x11\xb5\xd5\x5d\x48\xa4\x07\x4f\x9b\x05\x1e\xac\x7c\xe6\xfd\x3d\xff\x74\xfa\x42\x7a\xae\x17\x51\xcb\x25\xe8\xea\xa4\x57\x51\xe4\x02\x54\x74\x8c\x17\xfa\xf7\xde\xd8\x7f\xcb\x53\xc7\x75\xe8\xf5\xbc\xda\xc1\xac\xc5\xd2\x99\xc6\x4f\xc2\x15\x83\xe0\x94\xdb\xcc\xa2\x25\x93\x71\xa5\xda\x75\xf2\xd6\x66\xf5\x9f\xf5\xd2\xaf\xc2\x5c\x44\x91\x64\xeb\x00\xc6\x34\x66\x6f\x99\xad\x99\xf7\x60\x50\x0f\x26\xe8\x6c\xc0\xcc\xe9\x99\xae\x24\x34\x6c\x63\xd2\x95\xb9\xb6\x93\x58\xda\xad\xe8\x79\x7b\x49\x66\x01\xde\x36\x55\x68\xd0\x98\x8d\xb3\x7e\x03\x95\xb4\xd1\x21\x89\xa0\xfb\x83\x1e\x34\xdc\xc9\xb6\xe7\x2b\x34\xda\x49\xd4\x57\x31\x15\xeb\xed\xc0\xb0\x38\x32\x50\x4b\x0d\x66\x4d\x34\xc6\x14\xdd\x17\x93\xba\x4d\x7e\xcb\xef\xef\x60\x4f\x27\xb4\x13\xe7\x15\x5f\x2e\xdb\x58\x2f\x26\xfc\xb4\x17\xcb\xe7\x36\xb1\xd8\x55\x80\x87\x93\xc5\x44\xfa\x46\x6d\x2b\x62\xbf\xfc\x55\x6a\x6e\x54\x4b\x16\xed\x62\x6e\x15\x33\x05\x72\xf6\x64\x93\x7b\xb0\x3b\x6b\xb1\xbe\xf0\xcc\x33\xb8\xae\x59\xb9\x01\xd6\x1d\x1e\x4f\x89\xf6\x20\x86\xf5\x5d\x84\xbb\x18\xd4\x04\x6e\x01\x64\x4d\xc2\xed\x08\x5a\x29\x70\x3e\x1d\xf7\x75\xbe\x2f\xfb\x21\xb6\x79\xaf\x67\x3d\x85\x9f\x67\xa3\x61\xf9\xca\xbb\x5a\xb5\xdd\x0d\x80\x9d\x70\x6d\xbd\xec\x72\x10\xdc\xa1\x4a\x35\xeb\x1b\x6d\xb7\x76\x68\x50\x8b\x0b\xd9\x44\x23\x36\x9f\x9f\xfd\x8e\x36\x77\x54\x9b\x85\x95\x72\xaa\x2f\x8b\x70\x1f\xc0\x89\xcc\x20\xf9\x65\x77\xe0\x7b\x56\x61\x99\x34\x0a\xbd\x82\x6e\xf6\x26\x8b\x02\x3c\x1e\x3a\x94\xe0\x10\x2f\xe0\x1b\x61\xa9\xca\x7b\xc1\xf6\x8a\x05\x2e\xe7\x17\x5d\x54\x38\x18\x72\x58\x33\xee\x76\x56\xd8\x28\x58\x4b\x65\x8b\x1c\xe0\x02\x83\xc8\xe6\xbf\x6f\xfa\x22\xe4\xde\x98\x5b\xdd\xaa\xe8\x84\x85\xb9\x8a\xa5\xbf\xe9\x3b\xfc\x9b\x3f\xd4\x69\x78\xa0\x0e\xb6\xa7\x33\xd3\x84\x30\xbe\x7c\xc1\xd6\xc1\x62\x36\x1a\x76\x34\x3d\xd7\x0d\x3e\x26\xca\xf6\x9a\xb6\x33\x06\xd6\x4b\xad\x45\x11\x5a\x31\x81\x8c\x83\xbd\x2b\x6a\xa9\x7b\x70\xa8\x4b\x5b\xc4\xc0\x6d\x5a\x8e\xe5\x1b\x9a\xa6\xc7\x45\x09\x6a\x32\x03\xd4\xba\xeb\x69\x12\xe5\xc6\x14\x97\xb4\x79\x07\x6b\xe3\x03\x5a\xaa\x87\x31\xdc\x9e\x1b\x46\xf8\xb1\x56\x6d\xaf\xac\xd7\xa4\x0e\x7a\xe6\x28\x02\x34\x1a\x0c\x05\x39\xbb\xd5\xc1\xfe\xca\xb0\x1e\x36\x5e\xb2\xa8\x59\x0f\x0f\x34\x95\x37\xde\xf5\x3a\xe9\xa6\x5c\x66\xba\xd5\xd6\x5c\x01\xfe\x94\xd5\x16\x23\x38\xd7\xd9\x98\x22\xc7\x9d\xb9\xb5\x5a\xd5\xde\x06\x23\xe1\x27\xad\x36\xb5\x17\xaf\xfd\x59\x3f\x9f\xca\x83\x2b\x7d\x06\x35\xb0\xb0\x47\x4b\x01\x49\xed\xa6\xae\x06\x6c\x87\xe9\x3a\x26\x91\x5d\x67\x08\xce\xe9\x65\x2c\xd2\x3a\x6b\x90\x65\x35\xcd\x62\x1b\x95\x9b\x8a\x46\x6a\x14\x91\x2e\xea\x85\xec\xc7\x73\xf9\x30\xa8\x37\xa0\x06\x82\x39\xab\xe9\x22\xf0\x2d\xe8\x04\x30\x8b\xda\xaa\xab\x81\x0b\x6e\x75\x58\x1b\x5b\xa1\xe4\x95\x77\x98\x97\xb2\x1e\x76\xd2\xad\xb2\x03\x98\x8b\x82\x70\xf7\x05\x6c\x7c\x01\x57\xb0\x52\x93\x12\xb0\xd7\x93\x4f\x4e\x48\x6e\x22\xb9\xbb\x68\xa1\xa6\x5e\x6f\x93\x15\x41\xea\x8a\x84\xac\x2e\x5c\x22\xe9\x09\x6a\xa4\x59\x66\x7b\x00\xdb\x23\x78\xa5\x04\x26\x3f\xcf\x02\x10\x1d\x2d\xa1\x06\xef\x48\x22\x7a\x1d\xf6\x5b\xb9\xae\x02\x5d\x1f\xe0\xcb\x8a\x74\xb3\x7b\x71\x70\x89\x46\x95\x2f\x59\x01\x21\x9d\xbe\x96\x75\x16\x92\xd0\x93\x6a\xed\x4b\x8d\xff\xa7\x01\x47\x45\xc8\xba\x07\x73\x06\x4a\x04\x1b\x88\x8f\xd3\xa1\x5e\x8a\x82\xb4\x45\x0f\x4b\x0f\x90\x4b\xd2\x52\x8b\xb6\x25\x43\x29\xa7\xda\x87\x65\x4e\x1f\xb0\x09\xed\xa5\x75\x05\x35\x2c\x7f\x31\x00\x26\xcc\x12\xd0\x74\xb1\xda\x7a\x25\x09\xbc\xc2\x37\xf5\xe5\x11\x07\x3f\x79\x26\x49\x3b\xed\xb5\x05\x33\x7c\x1d\x01\x2f\x2c\x65\xf7\x40\x1e\xa6\xf2\xf7\xf4\x2a\x6b\x06\x7a\x40\xeb\xe7\x99\x81\x44\x09\xaa\x02\x4e\xa6\xac\xc0\x02\x81\x92\xd5\xa4\x3c\x03\x7e\x98\x85\x22\x77\x40\xe3\xa0\x4e\xbe\xd6\x4b\x17\x3c\xcf\x1c\x3d\x91\xb9\x28\x00\xbe\x74\x67\x97\x9f\x9e\x93\x02\x75\xfd\x0c\x18\x26\x3d\xf9\x66\xed\x0c\x0e\xa4\x6c\x84\x47\x14\x0b\xf6\xce\x82\xd5\x28\x0b\xed\xc1\x47\x2e\xe0\x47\x38\xc4\xb9\xda\xa2\x01\x1a\x1d\x6d\xb0\xa0\xe9\xb9\x0c\x98\xd0\x28\xa3\xe9\x0a\x20\x03\x67\x01\xb8\x00\x4d\xb7\x8e\x08\xc8\x8a\x41\x67\x33\xcb\xa2\xaa\x1a\x2d\xf8\x00\xd6\xd4\x31\xdc\x0f\x6b\x2c\xed\x7e\x78\x5b\x07\x31\x0d\x21\x72\x7b\x55\xbe\xe8\xaf\x1c\x36\x0d\xa8\x04\x1c\x14\x98\xc4\xa2\x15\xf3\xdd\x26\xd9\x9e\x46\x59\x76\xe7\xb2\x2e\x68\xfe\xcb\x47\x41\x54\xc1\xd4\xce\x8a\x1e\xd3\xdc\xc0\x92\x0c\x5e\xf7\x9e\x21\xf1\xce\x90\x6a\xa0\x2f\x3d\xe8\xbb\x72\xc1\xda\x2d\xd2\x4c\x14\xa6\x5d\xd0\x2a\x39\x90\xe6\x12\x97\x02\xf0\x50\xd3\xa4\xa4\xf3\xcc\x49\x45\xc3\xa9\x5b\x4b\xef\x03\x5b\xb0\xf4\x9d\x20\x2f\x88\xa1\xd5\x20\xf4\x96\xcc\xf5\x30\xde\xc1\xbe\x14\x49\x86\x98\x06\xed\x9c\x0a\x5b\xbd\x36\x68\x74\x2c\x93\xd5\xac\xe0\xe0\xa9\x16\x35\x11\xa4\x30\x6b\xb1\xbf\xce\x8c\xbb\xa1\x30\x3c\xe8\x9c\xd0\xc5\x90\xe0\x7d\x83\x8a\x3a\xd4\xac\x16\xb6\x28\x0b\x92\x5a\x60\x83\x99\x80\xb0\x18\x16\xc0\x31\xc8\x50\x91\xd0\x06\x20\x39\x3c\xe8\x41\x99\xe7\xca\x85\xb5\x09\x0e\xe4\x56\xdf\xa4\x76\x7b\x02\x55\x59\x4a\xac\xd1\x00\xb7\x5a\x6e\xb1\xae\xca\xb1\x40\x5a\x2e\x82\x09\xcd\xd0\x18\x42\x32\x68\x35\xd7\xb0\x49\x45\xe3\x37\x40\x13\x7d\x25\xa6\xde\x6c\xda\x0d\xb3\x25\xf3\x1f\xf6\xbb\xb2\x12\x90\xe9\x94\xdf\x3f\x7a\x6b\xc2\x3d\x95\xa5\x74\x8e\xd2\x05\x42\x8e\xf1\x69\xf2\x7e\x9b\xa5\x35\x28\x64\xba\x87\xdb\xd8\xe0\x42\x6d\x79\x33\x00\xb1\x80\x99\x49\xc5\x9d\xd7\x2d\x03\x0e\xe0\x7d\xd6\xf9\xe5\x73\xb1\xa7\xa6\x71\xea\xc6\x82\x08\xd8\x2a\x70\xb5\x79\x31\xcd\x76\x07\x58\xa6\x65\x41\x02\xe8\xe9\x7a\x52\x3c\xd7\xa1\xa8\xe4\x6f\xda\x57\x35\xea\x12\x7e\x42\x48\x6e\x0d\x87\x7b\xae\x32\x5b\x85\xf1\x2f\x2d\x06\x1a\xd4\xea\x2a\x0a\x50\x6d\xc5\x59\xef\x9e\xb0\xfd\x24\xde\x61\xc1\xe0\xfe\x59\xc2\x01\x3d\x38\x25\x47\x8c\xf4\x60\x78\x35\xe8\x74\xda\x19\xf0\xc4\xcb\x3e\xeb\x3b\xbd\x07\x76\xee\x02\x4f\x71\xd8\xbe\xb1\x87\xed\xb5\x8e\xdd\xee\xe2\x2f\xba\xa5\x98\x02\x6e\x77\x45\x2b\x95\xbf\x07\x1e\xdb\xd0\x93\xcf\xc2\x59\x6f\x21\x9a\x80\x38\x11\x16\x9b\xa0\x69\x90\xd8\xa4\x3b\x9d\x98\xac\x42\xf7\x42\xf6\x4f\x8e\x7d\x77\x25\x63\x0c\xbe\x78\xf4\xd3\x0a\xb8\x9a\x92\x55\x6b\xb0\x75\xd1\x8e\xe9\x41\x8c\xa3\xa0\xc5\x69\xc5\x5d\xcf\x5c\x44\xdb\xeb\xa0\x14\xb3\xe7\xb0\x3c\x56\x2d\x2d\x25\x46\x04\x68\x5e\x48\x01\x85\xd8\x23\x5f\x69\xf2\x6b\x51\xad\x01\xa4\x08\x84\x62\x73\x0c\x42\x4d\x18\x75\x8c\x24\x8d\xf0\xd1\x56\x91\xb9\x73\x9f\x3e\xd3\x13\x03\x4e\x64\xc2\xb6\x6a\x86\x03\xae\x28\x3b\x45\x6c\xe1\xbb\x03\x48\x69\x43\x64\x5f\xbc\x9d\x18\x26\xc3\xa4\x57\xcc\x6b\x27\x64\x08\xa4\xf2\x5e\x2c\x62\xe5\xf8\x1f\x7d\x18\xfb\x4a\x09\xd6\x6f\x1a\xb6\x0e\x02\xc7\x92\xf2\x0b\x46\x5c\xaf\x80\xf0\x55\xd5\xaa\xe2\x8e\x4a\x38\x98\x8d\x9b\x99\xee\x75\xb3\x83\x57\x35\xd6\x15\xe4\xb0\x2d\xd7\x7a\x99\xd2\xe2\x09\xb8\x0f\x1d\x54\x41\x55\x20\xfc\x45\x40\xce\x80\x70\x3c\x01\xb3\x10\x19\x34\x8a\xec\x5a\xb4\xc9\xc9\xe9\x47\xcf\x08\xef\xd9\xaa\x6c\xd7\xd2\x46\xac\xd2\xb1\xf5\xea\x10\x57\xaa\xb4\x4e\xc5\xe0\x03\x04\x19\x9c\x06\xaf\x99\x2c\x96\x5d\xe1\x9a\x67\x32\xbb\x10\x0a\xd3\xde\x8d\xa2\xdf\x3d\x98\x68\x2d\x15\xbb\x70\xd3\x80\x7b\x8a\x41\x94\x33\xd1\xf1\x40\xa8\x62\x37\xd8\x7a\x67\x1f\x80\x0f\x84\x22\x17\x23\x6a\x8c\x21\xc2\xb7\xcd\xe0\xf0\x8a\xfe\x7f\xa9\x5e\x0b\x5e\x07\x95\x78\xd7\x0c\xc5\xbd\x7b\xd1\x22\xaa\x27\xb8\x24\xe1\x6e\xe0\xb6\x5b\xd5\xc0\xfb\xf2\x25\xd0\x7d\x83\xa2\x65\x98\x04\x17\xb8\xab\xf2\x47\x54\xb9\x17\x40\x0d\x05\x57\x40\xcc\x0e\x8f\x67\xb8\x23\x4f\x4b\x38\xa0\xa8\x97\x48\x19\x62\x9a\xab\x6e\x18\x50\x4c\x68\xac\xd5\x3d\xf9\xd2\x41\xdf\x20\x98\x2a\x61\x8f\xb2\xb2\xb6\x2a\xda\x6b\xdc\xd8\xb4\xbe\x29\x9d\x1a\xa3\xaf\xab\xa9\x9d\x8c\xcb\x82\x8b\x18\x97\x13\x30\x00\xac\x9f\x40\x41\x26\xa5\xd8\x8c\x9c\x57\x8a\x25\xc1\x36\x1e\x21\x5f\x2f\xb6\x56\x2d\x69\x73\x0b\x76\x59\xce\x1e\xb7\xaa\x04\x7f\xe6\x7e\x2f\xf6\x53\x4e\x6b\x12\x54\x0c\xe6\xcd\xac\x31\x39\x52\x4c\xf3\x30\xe2\xff\x23\x3d\x5f\x01\x13\x85\xd2\x0f\x0b\x36\xaf\x03\x04\x58\x8d\x12\x5b\xc8\x29\xe2\x0f\x41\x33\xf2\x62\xfb\xae\xc3\x94\x45\xf9\x7a\xd1\x82\x2c\x59\xa9\xe8\xa6\xe1\x11\xf6\x49\xb4\x7b\xca\x2c\xcd\x7a\x33\x3d\xf8\x13\x82\x34\x6a\x01\x48\xb7\x17\x6c\x06\xb1\x39\xfc\x64\xfd\xbd\xe4\x22\x64\xbb\x5e\x7d\x31\x9b\xbb\xb8\x30\x2b\xdc\x5e\x31\x6c\x21\xa5\x42\x23\xdb\xe8\xe5\xc6\xa2\xab\x1b\x28\x19\x6d\x99\xb7\xdd\x98\x53\x06\x18\x19\x81\x1b\x1c\xbc\x43\xf1\xb5\x94\x42\x06\xb5\x77\x26\xf8\x85\xb2\x35\xa0\xc8\x6d\x88\x7a\x5a\xe1\x08\x2d\x6b\x4d\xde\xe9\x36\x4f\x95\xdb\x84\x5e\xd0\x6d\x1f\x1e\x11\x57\xce\xdc\xb9\x65\xc1\x0c\x79\x14\x15\x44\x66\x7e\x66\x00\xc8\x01\x7a\x19\x37\x16\xa0\x14\xcd\x41\xb7\x04\x9e\xd6\x31\x53\x27\x01\x45\x3b\xe5\xcb\xdb\x55\x27\x25\x77\x1f\x10\x07\x50\xc0\x00\x81\x4b\xcc\xc0\xd8\x72\xcf\x76\x77\xaa\xac\xfc\x3c\x7e\x7e\x7e\x7e\x26\x40\x8a\xf8\x14\x96\x13\x6e\x7e\x91\x20\x79\x05\x4c\x18\x33\xda\x41\x4d\x1d\xf7\xc3\x88\xf2\x11\x95\x94\x3d\x83\x15\xd7\x51\xff\x9c\xe7\x48\x6e\x41\x78\xb6\xf0\x67\xbe\x69\x75\x1f\x3c\x8b\x50\x6e\x5f\x13\xcc\x82\x07\x6c\xaf\xc9\xf4\x81\x03\x19\x67\xd1\x53\x14\x01\xd1\x2f\xe6\xd5\x0a\xbc\xc3\x10\x53\x78\x4c\xd1\x4c\x3a\xf8\xbb\x94\x55\xc3\x34\x48\xde\x44\x08\x4c\xf7\xc3\xce\x8c\x8b\xb9\x4e\xc1\xe2\x1c\xf2\x7c\xe0\x0e\x8d\xf5\xcc\x9e\x69\xd7\xb3\xbf\xea\x8b\x3a\x42\x65\x70\x37\x0a\x8c\xe0\xb2\x93\x67\x56\x80\xce\x3a\x26\x51\xad\x02\x88\x41\x39\x06\x90\xb8\xad\x65\x66\x04\x33\x47\xf5\xad\x83\x1b\xb4\xc9\xbe\x76\x8d\x6d\x6e\x84\x51\xfc\x86\xf9\x33\x44\xb6\x21\xef\x2f\xca\x83\x77\x0a\x00\x21\x78\x49\x80\xa7\x72\x20\x9f\x66\x31\x65\x00\xd1\x1e\xd3\xee\xfa\x43\x01\x23\x58\xed\xdb\x01\x19\x22\x24\x68\xfc\xd4\x80\x69\x0c\x1a\x6f\xd5\x16\x52\x2a\xb5\x1f\xcb\x27\x10\x69\x0a\x70\xa0\xb3\x81\xc8\x1e\x4c\xe4\x0a\xae\x9f\xf2\x35\x62\x43\x38\x9c\x75\x2c\x81\xe3\x0a\x6e\x6d\x26\xa4\x04\xfe\x87\x4b\xcf\x02\x52\x7d\x08\x51\x36\x88\x0d\x6f\x68\x2d\x29\xc6\x68\xcd\x15\xbe\x97\xcc\x5e\x80\x14\x58\x6d\xd9\x72\x7d\xe6\xa2\x67\x40\x29\x82\x41\xa1\x2b\x20\x67\xf8\xfb\xdd\x0c\x84\xfa\xfd\x36\xe5\xbb\x00\xcb\x01\x81\xcc\xd2\x82\x66\xb4\xb4\x26\x4b\xb9\xad\xf6\x62\x36\x51\x52\xaf\x8d\xdf\x49\x4a\x92\xf9\xb1\x1e\x02\x87\x62\x5b\x16\xd7\x52\xe0\x3a\xd9\x82\x8f\x96\x3d\x3a\xdf\xfc\xb4\x80\x25\x7b\x25\x1c\xcb\xb8\xf5\x0a\x6e\xd1\xf1\x60\xa0\x1e\x15\xaa\x69\xb0\x99\x83\x42\x08\x3b\x69\xc2\xb0\x60\xdf\x66\x2d\x38\x10\xb1\x23\x40\x67\x5a\xbe\xb0\x3a\x3c\x1d\xba\xc0\x4a\xb4\x54\x02\x9d\xf7\xf0\x48\x00\xd3\x5e\x2a\x90\x5c\x77\x15\x64\xc3\xfb\x63\xd1\x69\xd9\x5b\xb4\x83\x22\x5a\x4c\x2c\xd5\x95\xbd\xc6\xa4\xc7\x2a\x60\xd1\x25\x84\xcb\x64\x1f\x16\xf8\xff\x14\xb2\xcf\xfb\x0a\x0e\xdb\xca\x92\x91\x50\xf7\x55\xd6\x2e\xdc\xf5\x19\x14\x16\xa5\x0c\xc2\xdc\xf2\x71\x50\x06\xd3\x25\x34\x54\x76\xed\x56\xbc\x5e\xde\xa6\xa9\x9e\xc2\x33\x48\xdb\x1e\xe4\xcf\x2e\x06\xbe\x6e\x23\x91\x9d\x44\xa3\xc1\x6b\x0a\x4a\x67\xca\xf5\x5c\xb4\x07\x14\x36\x7e\xcc\xf4\x72\xf2\x0d\x19\xe2\xfa\xac\xd5\xca\xb0\x09\x2c\xa8\xba\x1a\x53\xd2\xfd\x28\xbf\xe4\x15\x0a\x82\x89\x09\xfa\x8d\xe3\x75\x90\x28\x4a\xea\xfc\x75\x70\x6e\xb5\xff\x3b\xfe\xf8\xf6\x22\xa5\x60\xc5\x1a\x80\xc1\xa6\x1b\xe0\xe6\x8b\xdc\xa0\xaa\xda\xa4\x9c\x69\x9f\x03\x2c\x10\x4b\xc8\x42\x48\x83\xd6\x99\x0a\x1b\x60\xb0\x2f\x20\x54\xb0\x9d\x05\x1a\x6f\x92\x82\x2b\x85\x49\xfa\x4a\xb5\x2f\xc5\xe5\xf7\x88\x5a\x0b\xaa\x02\x9f\x7d\x0b\x38\xb6\x18\xd9\x50\x40\x14\x2d\x00\xb9\x82\xa6\xd4\x85\x65\x25\x01\x08\xb4\x9b\x6e\x9a\x86\x72\x5a\x60\xc9\x01\x21\x55\xdc\xca\x60\xd5\xb6\xba\xaa\x26\x79\xbc\x66\x33\x80\x7b\x58\xc9\x22\x89\x5b\x24\x24\x7c\x30\x05\x08\x99\xaf\xac\x1c\xd0\x9a\xb1\x3a\xa4\x6f\x75\x78\xa2\x12\x9b\x06\x73\xb3\x24\xec\xe5\x93\x9d\x8e\x55\x52\xc0\x0e\xf2\xa0\x89\x83\x87\xe0\xda\xec\xa9\xf6\x0a\x37\x76\x05\x73\x67\x25\xdb\x02\x9d\x92\x4a\xd1\x09\xc5\xc0\x30\x19\x68\x31\x41\xd4\x69\x03\x1c\xc8\x5a\x09\xe1\x9f\x32\xf6\xb0\x3e\xab\x70\x05\xe2\x6b\x2d\x17\xc2\xa5\xf8\xde\x1a\x74\x54\x82\xc3\xca\x75\x8a\xc8\x33\x00\xab\x7a\xdf\xc9\x3d\x2e\x16\x9d\x6b\x29\xc9\x22\x46\xcc\x5e\xd7\xdc\x01\xa8\x5c\x17\x5a\x2b\xc4\x9d\x6b\x80\xcc\xf9\xe3\xe7\x35\x27\x2d\x44\xbd\xb3\xca\xfc\x75\x25\x8b\x0d\xae\x69\x39\x13\xaa\x49\x86\xbd\xc8\x15\x38\x76\x47\xd5\x74\x2c\xe5\x00\x32\x70\x3c\x65\x1e\xdc\x42\x70\x4f\xf0\x0d\xa6\xd8\x40\x0d\x4b\x4a\x57\x81\x27\x9b\x94\xf4\x94\xe4\x46\x14\x31\xb1\x32\x0c\x95\x49\xb4\x9c\xaa\xae\x80\x09\x10\x27\xb3\xcd\x62\xaa\x5a\xaa\x49\x8a\x10\x3f\xd6\x9b\x8f\x72\xaa\xb8\xb5\x68\x42\x15\x5b\x4f\xe2\xac\x29\xdd\x23\xac\x55\x73\x33\xb1\x14\x88\x01\x77\xd8\x0b\x32\x54\xeb\x00\xe6\xb0\x7c\x81\xb7\xa8\x05\x35\x0a\xa7\xca\xb5\x8a\x46\x80\x47\x45\x01\x1a\x30\x71\xe2\x5a\x8d\x9b\xd8\x36\x7b\x49\x24\x4d\xc2\xf4\xc4\x3c\x48\x85\xc6\x83\x2e\x80\x6c\x39\xcd\xe9\x0a\x98\x2d\x83\x75\x9b\xa7\x92\xc1\xc0\x2c\xc1\xd5\x91\xeb\xc7\xff\xca\xa3\x81\x4f\x54\xd2\x72\x3a\x09\x3e\x02\xde\x18\x73\x4b\x58\x48\xab\xbb\x69\x7f\x55\x60\x0f\x0a\xfd\x42\x1f\x84\x11\x6e\xdd\xc0\x46\x17\x21\x30\x01\x1d\x5a\xbf\x1c\x02\x10\x2c\x3d\x32\x71\x76\x05\xb3\xc0\xd6\xad\x00\xdb\x26\x4d\x19\x8d\xc1\xcd\x53\x4b\x89\xdf\xf0\x85\x10\x75\x4c\x04\x42\x66\x3d\xc3\x92\xad\x1c\x17\x1f\x4a\xd9\x4e\xac\xa7\x52\x2a\xd9\x35\xd8\xe3\x86\x57\xbd\x20\x2e\x9d\x58\x62\x13\x2e\x1e\x94\x48\x1a\xd0\x20\xbe\xa4\x10\x04\x54\x87\xb8\xb2\xdd\x5f\x5e\xc7\x62\xd5\x76\x61\x17\xfd\x00\x69\x00\x8f\x80\xe6\xaf\x0a\xec\x03\x99\x1a\x39\xdb\xbc\xf3\xa0\x85\xef\x3a\x96\xfa\x60\x49\x2b\xce\xb5\x41\x41\x5a\xc9\x5d\xa6\x4d\x07\xe4\x2c\x5a\x42\x55\xaa\xb1\x95\x2b\x94\x8f\x90\x93\x89\x50\x21\xe7\x39\xc0\x65\x63\x2e\x40\xc6\xbd\xc0\xe2\x1b\x5a\x97\x64\xc6\x96\x36\x07\x93\xb2\x02\xf7\x58\x59\x40\x3a\x13\x73\xb0\xb0\x90\x76\x58\xd4\x81\x30\x64\x99\x3c\x9c\x01\x3e\x82\x03\x34\x5d\x52\x6d\xaa\xf3\x62\x68\x04\x12\x55\xd9\x15\x7f\x99\x9f\x1b\x9e\x28\x44\x0a\x00\xc0\xc1\xa4\xb4\x41\x9c\xb8\x51\xc3\xa4\x5c\x41\x3d\xc1\xa3\x05\x87\x36\xa9\x5e\x4c\x0e\x90\x6f\x3d\x89\x2b\xed\x29\x66\xd6\x22\xf2\xa0\xb2\x82\x99\x1b\x05\x0f\x1f\x1f\x0a\xf0\x01\xc2\xd3\x98\xb0\x82\xef\xbc\xc0\x1e\x8c\xbc\xe4\x9b\xd6\x74\x86\xf8\x67\x20\x0d\xed\x95\x5b\x37\x6d\x67\x0e\x56\xb0\xd0\xff\xf2\x95\x42\x1e\x0c\x3d\x42\xf4\x5a\xb0\x7b\xe1\x23\x39\xd0\xb1\x2e\x47\x5d\xc1\xf7\x44\xff\xb4\x62\x7d\x01\x3c\xcc\x55\x2f\x88\x39\x7f\x22\xdd\x6e\xc1\x9c\x8d\x64\x5a\xb1\xf4\x2e\x36\xb8\x29\x70\x51\xf5\xc2\x22\x4e\xc4\x6f\x33\x66\x7e\x28\x1a\x72\x1d\x1a\x8c\x55\x00\x48\xdb\xb0\x52\x57\xdf\x6e\xb7\xf0\x56\x80\xdb\xb3\x90\x20\xc9\x84\x80\x20\xe6\x89\xeb\x12\xd6\xda\x99\x95\x55\x5f\xf0\xfd\x58\x4c\x3f\xee\x47\x28\x5f\xce\x06\xcb\x20\x7a\x61\x7f\x31\x00\x4e\x54\x49\x94\xc0\x5d\x0c\x66\xd2\x3c\x40\x0c\xa6\x29\x55\xd9\x4a\x66\xc2\xeb\x7b\xc9\xb5\x7b\x88\x97\x0a\x9c\x1e\xc1\x8d\x8b\xc2\x34\xb9\x7a\x40\x48\x45\xa2\x47\xb5\x96\x4d\x68\xce\x77\x49\x18\xe6\xe6\xcc\x52\xa1\x70\xc6\xe5\x55\xe8\x25\x60\x75\xca\x9b\x43\x0a\x69\xd4\xac\x68\x7e\x7d\xa0\x41\x60\x29\x27\x65\x31\xda\x48\x4a\x38\xf8\x3b\x27\xfa\x19\xb4\x8d\xf1\xdd\xa6\x1d\x1c\x94\x7d\xf7\x86\x2e\xba\xda\xb0\x52\x8b\x88\x9b\xdf\x4d\xf8\xad\x12\x6b\xf5\x1b\xf9\xc1\xb6\x42\x49\x19\xc2\xa5\xdb\x7b\x57\x90\x3f\x58\x21\xd8\x51\x4e\x1e\xf4\x4b\xca\xdc\x50\x81\x12\x22\x1c\xa5\xed\x55\xf1\xf2\x20\xa6\x2e\x00\xf8\xe4\x9c\xbe\xef\xc5\x66\xf7\x85\xa0\xb5\xbf\xe3\x25\xf3\x5d\x4f\xcb\xb5\x6c\x16\x56\x98\xaa\x82\xd7\x93\xc5\x9d\xcc\xbb\x57\xec\xea\x5e\x83\xf9\x5c\x35\x81\x83\xd6\xb8\x90\x3f\x29\xef\x90\xad\xdb\x3e\x56\x66\xd7\x98\xfe\x00\x05\x5b\x7c\x94\x64\x0c\x2e\x38\x93\x01\xb3\x38\x23\x49\x5d\x29\xc1\x93\x09\xd8\x6f\x5f\xbf\x1c\xf7\xa1\x2e\xf9\x2f\x77\xf1\x8f\x78\x3d\x16\xee\x82\xad\x8d\x17\x2b\xb9\x63\x34\x35\x98\xd2\xce\xce\x7e\xd4\x8d\xa8\x1e\x6a\x9d\x62\xeb\xd5\x31\x3c\x3a\xc3\x4a\x6c\xbb\x2f\xd2\x38\x8c\xdf\x24\x0d\x86\x2f\x8c\x49\x4a\x52\xc7\xde\x1b\xb0\x28\x09\xd3\xc9\x1d\x2d\x11\xc2\x5e\x1e\x84\x20\x12\xb8\x52\x30\x0d\x88\x92\x65\x0f\xd5\xa4\x6f\xa0\x4c\x45\xaf\xda\xa5\x8e\x18\xd6\x3a\x44\x37\xbc\x78\x1a\xac\x43\x5b\x24\xe0\x3e\xd4\x78\xf7\x41\xed\x4a\x46\xb9\x2c\x2c\x0f\x89\x33\x7b\xac\xc0\x3e\xb0\x03\xc1\x23\x4b\x30\x79\xba\xe4\x6c\x52\x72\x2b\x23\x07\x05\xb2\xa7\x58\x35\xf6\x82\xf7\x70\xe3\x1c\x09\x4c\x16\x00\x94\x62\xb5\xed\x5d\xb3\x82\x38\xda\x97\x2f\x97\x2f\x20\x47\x2c\xe9\x7e\x4d\x39\xba\x59\x7e\xfc\x1d\x07\x37\x10\x09\x81\x09\x1a\x10\x1e\xa9\x95\xbd\xd5\xc2\x97\x92\xd4\x9e\xaf\x33\x60\x2b\xc9\xc9\x98\x0b\xd1\x00\x40\x30\xc0\xc9\x5f\x0a\x00\x6f\x37\x44\x76\x1b\x61\xd3\x8b\xce\x68\xbc\x9f\x9b\x36\x47\xc8\x63\x48\x53\x45\x9e\x41\xbe\x12\xc4\x70\x2e\x0a\x7a\x23\xc9\xdd\x5b\x58\x0c\x10\x4b\x3f\x26\x18\x7f\x48\xaa\xc4\x00\x8a\x44\x31\x10\x31\x73\xd9\x91\x42\x35\x40\xb1\x9b\x76\xfb\xa8\x29\xcd\x8c\x25\x85\xc9\xf8\xf6\x50\xbb\x00\xa3\xc1\x6b\x7a\x51\xf0\x01\x42\x3a\x6e\x4a\x84\x84\x07\x4e\x8f\xd4\x40\xb4\x12\x5c\x2f\x46\x91\xb4\xae\x7a\x09\xbe\x0f\xfe\x38\xa5\x40\x1c\x6e\x21\x20\x06\x96\xb9\x89\x42\x05\x56\xca\x46\x63\x03\x71\xc2\x43\xfd\xfa\x62\x44\x4f\x69\x3c\xd5\x30\xf0\xd7\x00\xca\x2d\x41\x14\xc0\x62\xd2\xc7\x00\xd0\xd0\x6c\x94\xbb\xf1\x2b\x08\x6a\xcc\x2f\x5b\xf0\x3c\x9b\xa0\x68\x53\x1f\xeb\x00\x28\xaf\x21\x07\xf3\xc8\xbd\x5b\xf6\x83\x82\xe2\xe3\x12\x55\x15\x4b\x38\xe8\x8f\x39\xc8\xb2\xa0\x47\x80\xe6\x97\xb3\xa7\xd2\x9f\x59\xb4\x7b\xa8\xd2\xa6\xfc\x69\x2e\x73\x80\xb3\x1b\xb9\x56\x87\xcc\x35\x16\x0f\x96\x11\xd1\x45\x36\xcb\xa8\xb5\x7a\x46\x62\x1a\x73\xe2\x31\x3e\x13\x26\xaf\xf8\xac\xef\x77\xf8\xca\xf2\xb0\xe0\x19\x07\xbf\x3d\x86\xe0\x8d\x9f\x58\x7a\xfc\xe5\x15\x44\x9f\xf2\x0e\xb3\x02\x67\x0a\x3b\x32\xf9\x51\x10\x06\xd6\x6a\x69\x91\x58\x42\x10\xc5\xb7\x8a\x3d\x27\xa0\x59\x7b\xe5\xf5\x7c\x85\x21\xa1\xba\x15\xba\x54\x34\xa7\x65\xe3\x1b\x73\xa3\x0f\xa7\x05\x81\xa0\x25\x55\xdc\x91\x65\xd6\xea\x1b\x83\xed\x46\xdf\xcd\x23\x77\xdc\xd6\x5a\xaa\x0d\x1c\x1c\x59\xdd\x0d\xd0\x04\x01\xf5\x06\x11\x6f\x06\xd4\xc5\x0d\x63\xb2\x10\x3e\x48\x98\xdd\x3b\xf4\x92\xa4\xbe\x87\x4c\x8f\xc3\x2b\x4a\x42\x53\x9d\xe8\x19\xa7\x0a\x85\x1e\xfd\x73\xd9\xee\x15\xb9\x8c\x0b\xab\x74\x80\xb5\x3c\x30\xb8\x23\xd2\x5c\x0a\x19\xbc\x00\x1a\xf0\x6b\x28\x77\x98\x3e\xce\x17\x9f\xc4\x45\xf0\x44\x09\xc0\x0a\xab\x9b\x92\x37\x68\x61\xbd\x29\x4b\x0c\xef\xe6\x0f\xc1\x19\x69\xd1\xa6\x34\x62\xbf\x18\x2c\xc2\xe4\x92\x94\xea\xc0\x0e\x6f\x49\xa9\x1f\x08\x34\x5a\xd8\xb1\x6e\xee\xf1\x7d\x41\xe4\x4a\x4e\x22\xdd\xf1\x69\xc5\xf2\x7c\x4a\x5a\x24\x53\x00\x4b\x2b\xd6\x21\x41\xd7\x15\x93\x34\x62\xd9\x4b\x55\xe4\xbe\xdf\xe5\x1b\xa1\x5e\x8c\x40\xef\x2d\xcf\x50\x2f\x10\xb9\xc5\xeb\xa5\x1c\xc1\x44\xb5\x8d\xd1\xd5\x23\xfc\x58\xc4\xf4\x5a\xeb\x36\x96\xc3\xc9\xb2\xb8\xf2\x8b\x74\x8f\x54\x53\xac\x36\x03\xf0\x8b\x12\x36\x12\xd0\x12\xb2\xe6\x55\xeb\xd3\x7b\x5f\xc1\x47\x95\xb0\xd2\x6f\xd3\x0a\x28\x88\x96\x73\x55\x66\x2d\x46\x1b\xd0\x8c\x44\xa0\x90\x02\x7b\x70\xe7\x76\xb8\x18\x26\xfd\xe0\x99\x2c\xa5\xe9\x00\xfb\x0f\x80\xa6\xab\x06\xd6\x71\xa6\x00\x10\x3c\x32\x72\x79\x90\x7f\x75\x44\x2d\xba\x73\xce\x1d\x74\x23\x80\xa0\xe4\x62\x31\xbd\x3c\x61\xd2\x34\x63\x30\x70\x4d\x20\xaf\xd2\x17\x67\x36\x2a\xcc\x09\x1e\x53\x7e\x02\xb6\xde\xb2\xe8\x13\x6b\x32\x58\x85\x45\xe0\x97\x6d\xc5\xa2\x6f\x27\x2a\xad\x4c\x10\x85\x61\x48\xd7\x6d\xe1\x5b\x04\x96\xbf\x91\xd4\x61\x36\xa2\x74\x5e\xc8\x3e\x05\x0b\x07\x1f\x05\x7c\xe6\xbe\x41\xb3\x8b\x78\x75\x33\xcb\x19\x6b\x9c\x29\xe5\x2d\x4b\xba\x80\x9b\x16\xac\x53\x84\xcc\x5b\x7e\xe2\x41\xfc\x44\x5a\xbc\x81\x4e\x38\xc1\x58\x1f\x8d\x92\x94\x3f\x01\x95\x70\x33\x96\x9b\xcc\xc1\xeb\x0c\x60\x26\x67\x88\xa8\x89\x9e\xb2\xc3\xf6\x8a\x7e\xd2\xdc\x98\xe2\xa2\x31\x47\x01\x60\x4e\xa2\xb3\x64\xc4\xa2\xb0\x3d\xea\xb6\x9a\x08\x14\xcb\xe5\x02\x3d\xf4\xa2\x16\xaa\x63\x21\xd4\xf2\x58\x47\x1d\xf4\x56\xaa\x20\x60\x87\x17\x08\xde\x55\x1b\x6f\x6e\x53\x9e\xa7\xd7\x71\x53\x6d\x7d\xfd\x74\x58\x51\xcc\x3e\xac\xdc\x76\x5f\xd5\x32\x7a\x7a\x0c\x25\x27\xbd\x5a\xc5\xaa\x45\xb7\xe1\x75\x49\x80\x03\xc4\x46\x2c\x57\x9e\x62\xd8\xcc\xca\x4a\x4d\x60\x65\x6d\x20\xa3\xea\x94\xc1\x47\x70\x40\x2c\xee\xa0\x4d\x3b\x96\xde\xd8\x22\x81\x12\xcd\x2a\xe6\xfe\xd2\xfe\x9c\x5e\x98\xb9\x0c\x8d\x4c\x66\x3a\x00\xae\xaf\x07\x60\x9e\x74\x27\x96\x67\xca\xc2\x06\x8f\xc4\x82\xa3\xaf\x5f\x4b\x7c\x6c\xba\x0e\x82\xe3\x23\x8c\xb0\x4a\x05\xbe\x56\xf6\x2e\x48\x8a\x40\x2c\xde\x82\x4d\x43\x41\xa5\x02\xcc\x00\x1e\x5a\xcd\xda\x0e\xf4\x00\x10\x60\x2e\xbb\x14\xd3\x1d\xf4\x71\xa2\x5c\x5b\x24\x1e\x57\xf7\x41\xef\x54\x6f\x57\x6a\xb2\xf4\xd9\xcd\x96\x70\x2b\xf1\x7b\xe3\x32\x8b\x0d\xed\x18\x23\x6b\xc4\xac\x2a\x76\xf8\x60\x1d\x55\x12\xf4\x45\xfa\x93\x26\xc1\xb9\xea\x0d\x53\x73\x8e\xd5\x5d\x4c\xa3\xa6\x75\xd3\xf7\x72\x0f\xe8\x42\xe6\x92\x48\xbe\x42\x44\x31\x0a\xf0\x8d\x14\x53\x60\xc3\xcc\x86\xfa\x84\x90\xb4\xcd\x9c\x0f\xa5\x77\x53\xd4\xc6\x81\x24\x4d\x8c\x4a\x9e\xb8\x52\x9c\x46\xe2\x60\xf9\xa8\x17\x08\xeb\x25\x4d\x76\x0d\xe0\xe4\xaa\x87\xb4\xc6\x1d\xcc\x87\xa2\x24\x29\x84\x0c\x7e\x91\x3b\xe8\x4b\xe4\x87\xa1\xe2\x97\x85\x45\x30\x83\x22\x92\x6b\x12\x18\xcf\x36\x94\x02\x80\xa7\x09\x54\xa8\x44\x44\xc0\xdc\x81\x03\x57\x86\x65\x46\x82\x39\x33\xac\x1c\x31\xf9\x31\x99\x38\xe5\x38\x0e\xe9\x6c\xc1\x5e\x24\x4f\x2a\x1d\x06\xb9\x64\x7c\xb7\x11\xe0\x8d\x16\x2c\xff\x1c\xda\xe6\xb8\x73\x81\x08\xd8\x5b\x62\xb5\xc1\xf3\x72\xae\x09\x08\x73\xbf\x61\xe1\x83\xf2\xd5\x6b\x6b\x18\xfd\xab\x85\x98\x4e\xcc\x65\x6d\xc0\x07\xa2\x3c\x97\xd4\x3f\x28\x7d\x4a\xc0\x30\x94\x8d\x24\x5d\xac\x51\x17\x02\x4a\x68\xf0\xbb\x2a\x0a\x32\x14\xd2\x1a\xc1\x64\xdb\x99\xd7\x6a\x70\x7d\xc0\x32\x21\xc9\xe6\xe8\x36\x20\xd6\x13\x0c\x20\xdd\x20\x04\x63\x29\xa7\xc9\xce\x19\x67\x17\x8e\x02\x16\x6d\x38\xa6\x0a\xba\x7b\xc6\x5a\x23\xd5\x1e\xae\x21\xbf\x4c\xe1\xeb\x43\xbd\x09\xdd\x54\x58\xb2\xcb\x95\x91\x0a\x9d\x55\xb3\xf3\x13\xd9\x29\xbb\x16\x2f\xa3\x4f\x39\x4b\x02\x11\xaf\x0d\x4e\xe6\xb0\xcb\x65\xdf\xf4\x42\xfb\xc3\xb8\x41\x39\x1e\xf4\x08\x96\xea\x6e\xc2\x9c\x4d\x8e\x84\x52\xf3\x8a\x4e\x65\x10\xb0\x76\xc4\x74\x03\xbd\x32\xed\x8e\x5d\x1b\x10\xa3\x66\xc1\x06\x47\xb2\xbe\xac\x04\xee\x52\x7f\xc0\x81\xac\x31\x81\xb8\x76\x19\x98\x0e\xe3\x81\x7f\x75\xd7\x6d\x5b\x1e\x72\x6d\x6e\x2c\xed\xb6\x58\xb9\xed\x2b\xab\x48\x44\xaa\x27\xb1\x20\xa8\x2c\xf7\x95\xac\x1a\xf8\x90\xc9\x50\xeb\x09\xe2\xd2\x96\x95\x4e\x12\x50\x7e\x2d\x95\x41\x94\x6b\x4b\xa9\x7c\x80\xb7\x52\x50\x1a\xd4\xc2\x56\x8d\x57\x46\xcc\xbb\x0b\x73\xa7\xf5\x1e\xd0\xc5\x82\x9d\x3e\xe4\x22\x56\xa5\x90\xf0\xcc\xb8\x8b\x37\xc0\xd8\x2c\xea\x59\x35\xf6\x97\x34\xd4\xa8\xe8\x8c\x87\x67\x63\xe6\x55\x87\x4a\x58\x0e\x48\xaf\x9b\x22\x42\x04\x3e\xc0\x9d\xa5\xd5\x74\xcf\xb3\x0c\x0e\xbd\x6f\x22\x16\x20\xc9\x20\x55\xf5\xe3\x7d\xf8\xbb\xcf\x7d\x84\x83\x76\xc2\x50\x11\xdb\x5d\xc0\xa7\x2d\xe6\x4a\x46\x15\x16\x92\x76\xbe\xcf\xfd\x2f\xf9\x26\x82\x27\x79\x29\x29\x7c\xf0\xa8\xc8\x09\x89\x08\xc5\x63\xd2\x3d\xf2\x3a\x9c\xa3\x55\x00\x98\x04\x1a\x9b\xea\xc1\x61\xd3\x24\xa3\xb5\x42\x9c\x53\x51\x49\xe0\x22\x16\x35\xfb\xe4\x82\xc3\x8e\x79\x62\xb9\x81\x39\xa2\x90\xa4\x13\x2b\x89\x0f\xe2\xb1\xbb\x29\x56\xd2\x22\x98\x4a\xaa\x82\xd6\x34\xe6\x93\xd6\xe8\x02\x3a\xce\x3e\x6c\xdc\x5b\xa1\x29\x0e\x60\x38\xdd\x20\x5c\xa0\x04\x52\xa9\x06\xc1\x74\xbb\xb1\xa2\xc1\x82\x35\x97\xd0\x46\xea\xbe\xbe\x7d\x79\x2d\x69\x1e\xc4\xb0\x43\x00\xb5\xda\x6e\xe2\x00\x15\x20\x1e\x88\x31\x66\xeb\x41\x28\x91\xe1\x59\xa4\x62\xd3\xa0\xea\x09\xf4\x6f\x48\x13\xe9\x55\x45\x35\xe3\x00\xa2\xa2\xda\xe4\xd0\x9d\x8a\x92\xd9\x45\xf4\x58\x93\x2a\x96\xef\xb6\x0a\x07\xbb\xac\x48\x0b\x53\x4e\xea\xc0\x3e\x7e\x60\x9a\x51\x10\x11\x92\x20\xe1\x8b\xa8\x92\x9a\x88\x36\xee\x3c\x98\xc9\xc0\xd7\xcb\xc1\x8f\x1c\x44\xb2\x2f\x9a\x3f\x7e\x15\xf6\xd8\x42\x24\x2d\x7a\x71\xc8\x3e\x22\x7c\xa8\xce\x94\x45\xb2\x92\x75\x51\xa5\x1b\x11\xd1\xb4\x6a\xca\xcc\x1c\x9b\x8f\x43\x23\x94\x53\x47\x02\xd2\x18\x90\x36\xc5\x95\x10\x4d\x08\x09\xc1\x0f\xc4\x6b\xa0\x6d\xe4\x23\x64\xab\x7b\xc6\x94\x2b\x48\x80\x3c\x97\x95\x0b\xf9\x35\x2c\xc8\x40\x10\x09\xbb\xe7\xf4\x45\xbb\x9d\x5b\x7f\x34\x4f\x10\x71\x5f\xa9\xd1\x04\x2c\x98\x5e\x1d\x2f\x71\xdc\xfc\x7d\xf0\x86\xe5\x76\x31\x6a\xc5\xa5\x09\x3f\x90\x43\xdf\xc4\x6e\x78\x6f\x07\x3c\xed\x87\x3e\xa8\x76\x46\x87\x7e\x48\x0f\x84\x2c\xa7\xa4\xa6\x73\x2b\xf4\x7b\x92\xab\x41\xf9\xa5\x1f\x31\xe0\xd5\x56\x7a\x02\xf2\x7d\x8f\x9d\xe1\x65\xef\x3c\x76\x1d\xf5\xde\x9c\xf5\x01\x45\x9f\xa8\xb7\x09\x43\x01\xbd\x32\xc4\x8a\xe9\xcc\x44\x88\x14\x9b\xe3\xbd\x59\x00\xad\x31\x98\x01\xd1\x9d\x11\x1a\x93\xf4\x02\xd2\xec\x04\x47\xfa\xe0\x67\x9b\x7d\x40\x22\xf8\x83\xa5\xee\x45\xcb\x72\xc2\x52\x49\x99\x6b\x75\x08\x8a\xa8\xd0\x95\x93\xb4\x00\xe6\x3b\xc4\xaa\x11\x0b\xdd\xec\xb8\x5f\xc5\xc4\xa2\xfa\x15\xeb\xd7\x25\xfa\x92\x5d\xe3\x75\xe0\x08\x67\xa8\xb8\x19\x69\xd6\xdd\x69\xca\x53\xe5\x46\x83\x80\x8c\x2d\x60\x0a\x58\x1e\x82\x96\x9a\x7b\x17\xf4\xa8\xd8\xf7\x75\x20\x5f\xb5\xf9\x55\xae\xda\x02\xc6\x7c\xf7\xd8\x26\xa7\xa7\x0b\x63\x72\x19\x99\xc9\x54\x57\xa3\x58\xe0\x6c\xcc\x6a\x0f\xf8\xbf\x35\x1e\x10\xb9\xe8\xc8\x64\x2d\x7e\xd9\x0b\x9c\x3d\x36\x73\xcf\xeb\x27\x00\xe3\x91\xf9\xf0\xd6\x50\xca\xf8\x7d\x3c\x44\xb7\xa8\xe5\x07\x48\xbd\x2a\x9e\x17\xb0\x6b\xc8\x0c\x06\xb3\x2e\x97\x97\x1a\xf9\x16\xca\xc5\x64\x48\xbe\xab\xa9\x46\x6b\x57\x9a\x37\xd6\x78\xfd\x94\x7d\x1f\xd4\x05\xec\xd7\x75\x2d\x65\x36\x24\x65\xa1\xa9\xa3\x6c\x44\xfe\x21\xfd\x81\x10\x14\xdc\x09\x72\x33\xa6\x00\xf2\x24\xd6\x30\x97\x98\xb5\xcb\xb9\x63\x71\xcb\x0d\xcd\x60\xfe\x85\xf2\x10\xa3\x51\x9a\xe2\xf4\x19\x1c\x55\xa3\xef\xb5\x8b\xcf\xad\x51\x3f\x53\xcf\x9a\xf3\xb0\x7f\x47\x36\x47\xca\x5c\x5a\x6a\xd1\x03\x7b\x91\x69\xde\xd8\x08\x0a\xd1\x4d\xba\x15\x6c\xe4\x3d\x56\x4c\x1a\x5b\x83\xc0\xa1\xab\x29\x9b\x31\x71\xbd\x06\x8b\xdc\x29\x3d\xaa\x30\x5f\xe1\xf4\xf2\x19\x07\x2c\xc4\x7a\x55\x52\x0c\xb0\x05\x93\xd8\xce\xe4\xcf\x01\x4a\xc0\x32\x8e\x64\x2e\x40\x17\x03\x29\x88\x55\xf7\x50\x53\xf4\x3b\x6d\x3f\x6c\xd5\x4c\x81\x5d\xc3\x8c\xc4\x45\xc0\x87\x0f\xc4\x9e\x29\x90\xf8\xf2\x9e\x0c\x7b\xab\x5b\xb8\x7c\xe3\x77\x6c\xe4\x65\x9d\xb4\x1a\x99\x59\xd4\x28\x0b\xeb\xd0\x63\xd9\x8d\x12\x19\xf6\x90\xf3\x43\x5b\x85\x59\x3d\xf6\xdd\x93\x30\xb3\xf6\x0c\x4d\x8f\xd8\x23\x4c\x34\x04\xec\x4e\x0e\x39\x56\x00\xec\x9a\x48\x75\xee\xcb\x97\x12\x16\x04\x48\xf3\x32\x1f\x8a\xa2\xb6\x76\x62\x5e\x45\x43\x94\x11\x64\x96\xc1\xad\xa4\x24\x25\xf9\x83\x69\xba\x32\xd6\x77\xc6\xf2\xc4\xe1\x0f\x6b\x3b\x17\xfe\x6e\x1f\x66\xc1\x6c\xc8\x68\xa3\x46\xec\x87\xa6\x2c\xe1\xdc\x4a\x58\xf3\x6a\xae\xd5\xc4\x46\x5e\xe0\xbe\x30\x14\xb5\xb5\xa9\x3f\x60\xbc\x73\x2c\x85\xf1\x35\xe0\x6a\x6c\x76\xaf\x3c\xd1\xeb\x60\x17\x6e\xd4\x4c\x87\x28\x75\xa7\xfb\x08\x32\xd3\x68\x37\xb1\x3e\xa0\x6f\xe9\x24\xe0\x53\xf8\xd6\x03\x3a\x7d\x60\xdc\xdd\x87\x63\xd8\x0d\x5f\x80\xeb\x1b\x14\x7d\x21\xc7\xe7\x27\xd8\x1f\xdc\xdf\x98\xcb\x3a\xbd\x34\x64\x93\xef\x58\x99\x02\xab\x37\x08\x9d\xac\x6e\xc8\xf3\x4f\x28\xe7\x89\x93\xb2\x88\x69\xbf\xab\xea\x42\xe2\xe1\x9b\x4d\xb3\x0e\x00\x0c\x9c\xfc\xd6\x88\x05\x91\x70\xb0\xfb\x64\x3f\x21\xca\x09\xd6\x33\x72\x19\xd5\x7d\x65\xa5\xd1\x97\xe7\xe7\xe7\x8e\xd2\xe0\xcd\xb2\x21\x82\x27\x16\x72\x57\xc3\xfa\x8b\xd4\xa0\x7a\x4d\x59\x8d\xa4\x36\xfb\xfd\x3e\x52\xe0\xb2\x8c\xea\x35\x26\x48\x72\x27\x3c\x8f\x98\xea\x31\x3c\x37\x40\xe4\xc4\xdd\x2a\xb7\x77\xfa\xe9\xb5\x3e\xbd\xbe\xd4\xa7\xe7\xcf\xf5\xe9\xf3\xa7\xe0\x74\x60\x2d\xbc\xe0\x61\x95\x83\x10\x68\x65\x60\x24\x7c\xd1\x95\x02\x80\xf3\x7e\x3f\xd7\x57\xf1\xce\x26\x15\x80\x7f\x10\xcc\xe2\x83\x94\xec\xa9\x81\x5b\xc8\x01\x5f\x52\xbc\x80\xc1\x46\xe8\x04\x0a\xf7\xfd\xf6\xfc\x7c\xfa\x89\x92\x90\x20\x57\xd7\x60\xdc\x53\xc9\x76\xc0\xc1\x09\x0e\xac\xd4\x6c\x2e\x9a\xa7\x07\x20\xb8\x66\x6d\xc9\xb0\x81\x2c\x8f\x3e\x22\x95\x7b\x91\xa6\xd4\x23\x47\xbf\xb9\x19\xf0\x04\xdd\x85\xee\x01\x0e\x09\x06\xd2\x3d\xbc\x8a\x0d\xf0\x37\x2e\x70\xef\xde\xea\xaf\x44\x27\xa8\x56\xca\xf2\xae\xd7\x85\x72\x98\x8b\xdf\x7a\xf3\x81\xb3\x6a\xb0\x8c\x02\x1b\xfc\xc8\x70\x0d\x2d\x36\x6f\x25\x35\xde\x6a\x6c\x81\xd0\x63\xa0\x36\x27\x99\xb5\x6b\x9a\x93\x12\xbe\xd8\x02\xe6\xcc\x86\x09\xe2\xe4\x5b\x05\x2a\xa9\xb1\x3d\xba\x40\x7e\xec\xf8\xad\x00\x0d\x94\x40\xf1\x32\x88\x23\x26\x3a\x92\x89\x72\x32\xe3\xfe\x50\x8d\x43\x92\x83\x1c\x43\xab\x01\x97\xf6\xe0\x38\x5e\xa9\x2d\x03\x6b\x22\x51\x15\x19\x02\xfa\x46\x74\x89\xbb\x0d\x1e\x01\xa9\xe9\x14\xc2\xb6\xea\x00\x1f\x07\x11\xe9\x13\xa5\x5a\x86\x61\x7a\x41\x8d\xad\x64\x60\x04\xb0\xfc\x6e\x21\x20\xd5\x2e\xb8\x78\x2a\xe0\xc9\x8e\x80\x2d\xdc\x45\x15\xea\xf5\x05\xc5\xc6\xd2\x6e\x97\x8d\x4c\x74\xc7\x1f\x6f\x36\xc5\x1c\x71\xe6\x4e\xa1\x8a\x60\x4e\xe5\x9e\x6d\x4c\x80\xf8\xb1\x0a\xd0\x0b\x75\xa4\x7d\x28\x35\x56\x6c\x4a\x0d\x18\x43\xc0\xfd\x6e\x9f\x18\xe1\xf9\x6e\xb7\x81\x92\xd7\x60\x95\x3e\x7d\xa1\x8e\x33\xd4\xf8\x62\x72\x00\xc2\x59\x37\x63\x12\xbe\x44\x9d\xa1\x84\xc6\x11\x7c\x66\xe2\xf6\x0e\xaf\x29\xe2\x4d\xe4\x84\x63\x52\x20\xd6\xaf\x13\x83\xe9\xdc\xdd\x34\x10\x60\x9f\x4d\xdb\x21\x2f\xbb\xa4\x3d\xcf\xa6\xb1\xb9\xd2\x23\x34\xef\xe4\x82\xa0\x3b\x12\x9c\x21\x90\x47\xee\x36\xaa\x27\x11\xe8\x83\x9c\x4d\xe2\x1a\xbc\x23\x9f\x7f\xe2\x17\xdc\xb9\x71\x41\xca\x43\xb1\x2a\x12\x6e\xac\x67\xad\xaf\xa1\xb6\x86\xbe\x94\x43\x6a\xe6\xd5\x37\x74\x2a\x4b\x47\x53\x65\x10\x6b\xac\xa4\x5b\x29\xcd\x04\xf8\xbe\x30\x1f\x07\x64\x36\x6d\xec\x46\x00\x2f\x41\xd0\x80\x8d\x1d\x59\x71\x60\x29\x08\x52\x88\x66\xe2\xe9\x73\x25\x63\x17\x03\xb1\x7e\x20\x92\x80\x28\xd0\x72\xbe\x22\x88\x52\xd7\xb2\xc8\xb9\xc7\x92\x58\xe0\x42\x64\x6a\x31\x48\x2b\x07\xaf\x5a\x9a\x25\xe4\x5b\x4b\x79\x09\x31\xf6\xd7\xe7\x64\x7f\x90\xde\xe6\x21\xd2\x65\x39\x99\xd2\x3d\x1a\x81\xf1\xbd\x4d\xac\xba\x5c\xd2\xc7\x91\x5f\x28\x3d\x61\x8e\xd2\xc2\x59\xb3\x6e\x5f\xac\x29\xb2\x3c\x0e\x51\x13\xe8\xa3\xe9\x0e\xa3\x83\xde\xda\xd0\x7b\xc6\xea\x1f\xad\x68\x32\x53\x73\xd0\xda\x51\x5f\x20\x88\x66\x2f\xee\x8c\x0c\x73\xf7\x00\x79\x83\x08\x22\xff\xa0\x09\xdb\x1a\x13\x22\x7b\xc8\x9c\x30\x0c\x54\x8f\xb0\xc4\x94\x72\x1f\xbd\x2e\x04\x28\xb5\x07\x26\x45\xea\x60\xf6\x8d\x96\x93\x3a\x15\x10\xbe\xef\x5a\xb3\xc8\x87\x99\x8c\xc1\xcc\xc2\x42\x2c\x09\x5e\x9d\x1a\x58\x2e\xf1\xf6\xc2\x0a\x1f\xba\x44\x73\xbb\x7e\xc1\x4e\x68\x3c\x03\x4a\x1c\xa8\xbd\x66\xf6\xc4\x37\x13\xd3\x05\x05\x5b\xdd\x04\xef\x09\xed\xde\x43\x6c\x4e\x9e\x6d\x46\x9d\x2d\x37\x65\x47\x3d\x73\x57\x82\x29\x94\x79\xe7\xac\xc5\x49\x2d\xa1\x91\xad\x88\x1b\xf9\xf0\xd8\xbe\x17\x7a\xca\x2b\xd6\x83\xd1\x20\xff\x17\x41\xe7\x62\x75\xcb\x7d\x6a\xdb\xe5\xd6\x2d\x49\xe3\x4d\x42\x95\xca\x62\x8a\x28\x73\x53\x94\x3b\x2b\x47\x8d\x56\xe0\xcd\x3c\x13\x86\xc0\x7c\xcd\xcd\x13\xaf\x69\xdf\x01\x29\xdb\x99\xf2\xca\x41\xe0\x89\xa0\x0b\xc1\xc9\x87\xdc\x09\xe0\xde\x09\xf9\x36\xef\x1e\x85\x3e\x97\x4f\x62\xf3\x87\xea\x3a\x70\x40\x45\x81\x57\x5c\x41\xc9\x3a\xf5\xe0\x0e\xed\x1c\xc2\xf2\x85\x32\x3c\xf6\x53\xa5\x7c\x85\x6d\xcd\xd5\xa9\x16\xb3\xc0\xb0\x89\x0d\x9e\x41\x9e\xc4\x92\x68\xf2\x45\x3e\x21\xf0\x0d\x8a\x58\x17\x8a\x8d\x68\xb0\x2b\x86\xab\xf7\x59\x60\x55\x70\x0c\x4d\x76\x33\x95\x23\x53\x33\x73\x0e\xf0\x70\xca\x4c\x57\xa6\xee\x34\xd8\xc9\x45\x26\xeb\xe3\x78\x36\xf0\x2f\x7f\xa7\x7f\x0b\x2d\x13\xba\xb6\x63\x47\x87\x76\x2f\x2d\xad\x26\x58\x74\xc7\x46\x9c\xcc\x52\xb4\x71\xf2\x49\xb9\x3b\xf8\x6b\x2f\xd8\xf8\x39\x4d\x64\x64\x29\xa9\x1e\x89\xb7\x3d\xe9\x9b\x66\x45\xea\x60\x9d\x7b\x66\x0c\x01\x0a\x2f\x0d\xcd\xdc\x68\x14\x26\xcb\xc5\x34\x32\x03\x30\x80\x34\x22\x17\x3e\x03\x29\x33\xaf\xe6\x4e\xb0\x03\x6b\x2a\x4d\x43\xd7\xab\xb0\x43\xc5\x63\xdf\x18\xe7\x09\xec\xa1\x25\xae\x58\x79\x4c\x4a\xc9\x22\xa4\x5f\x7e\xaa\x5f\x5e\x9e\xeb\xb7\x53\xfd\x12\xe0\xa8\x59\xe7\xa9\x0e\x94\x29\x1e\xe4\x9f\x1b\xbc\x31\x9b\xdc\x70\x4a\xa1\x1d\x86\x1a\x4d\x62\xf2\xf8\xbf\xb2\x50\xf0\x47\xc6\x5e\x0f\xe1\xd1\x59\x0f\xa2\x3d\x66\x49\x45\xfb\x48\x0f\x59\x3c\x16\x7c\x1f\x35\x0e\x3b\xfd\xca\x23\xd8\xe1\xed\x44\x80\x29\x98\x8f\xf2\x38\x5f\xbe\x8c\x65\x41\x86\xed\x4a\xac\xa3\x76\xe7\x43\xa6\xc4\x59\xdc\xd6\xc9\x20\x1f\xa3\xa4\xa9\x85\x09\xfd\xc4\xf9\x35\x40\xd8\x0d\xf4\x0a\x5d\x4b\xc4\x84\xb8\xd9\x0d\xa5\xf3\xb5\xf5\x0b\x25\x19\x31\x76\x23\xf9\x47\x22\x3c\x42\x6f\xa4\x31\xf0\x36\x18\x24\xda\xec\xc5\xae\x81\xb9\xab\x58\x9c\x76\xdb\xa9\x7d\x57\xcc\x56\xdb\xe2\x69\xe1\x3e\xb8\x29\xc3\xee\x17\xc0\x15\x16\x93\x8f\xc7\xc6\x12\x87\x55\x76\x41\x75\xdb\x30\x2d\xf0\x68\x41\x68\xaa\x63\x0a\x75\x6e\xa1\x84\x91\x78\xd6\x88\x57\x18\xde\x98\xdb\x8a\x18\x51\x48\x70\x53\xf5\x07\x02\xdb\x6c\x31\x25\x29\x07\x9a\x67\xa3\xb6\x09\xe3\x08\xaa\x63\x29\xec\xc5\x22\x4e\xce\x15\xd0\xa7\xe2\x96\x20\xba\x25\x1a\xe4\x93\x38\x71\x53\x92\xc0\xdc\x57\xa7\x6e\x34\xba\xee\x79\xbb\x67\x1f\x3a\x1e\x3a\x7f\x60\xdd\x20\xa6\x49\x16\xf7\xeb\x6b\x6c\x5e\x0d\xfb\x6b\xa2\x17\xca\xea\x08\x38\x0d\x42\xeb\x49\x14\x1e\x26\xf6\x26\x04\xc3\x27\xa6\x95\x9e\xc6\x08\x4b\x05\xe5\x8b\x58\xb1\x55\x30\x1b\x63\x90\x77\x55\xb5\x61\x8e\x5d\x89\x58\x0c\x65\x87\x54\xe4\x98\x52\xbe\x06\x04\x4c\x73\x58\xff\x77\xfb\x53\x57\xc0\x77\x87\xf0\xbd\x15\xbb\x39\x0c\x06\x9b\x59\x26\x19\xc7\xc6\x31\x03\xd5\x5e\xf5\x84\x7d\xcb\x97\xa0\xac\xf7\x4c\xef\xc8\xfa\xb5\x8a\xba\xcf\x3e\x7e\x80\x50\x00\xc0\x4d\x11\x97\xb4\x94\x2e\x55\x0c\x7b\xd3\xf2\xa2\x04\x98\xc6\xa5\xb5\x8c\xa6\xdd\x4e\x15\xac\xdf\x4e\xd1\xa2\x85\x8d\x86\xa8\x4b\x70\xd0\x1d\x27\xf7\x14\x3a\x8d\x5f\x53\x62\x63\xc7\xa1\x53\xc4\x6d\xbd\x1e\x49\x7b\x88\x2b\x75\xf8\xa7\x3c\xf1\xed\xed\xeb\xe9\x60\x4c\x71\xbe\x4b\xdc\xe7\x5b\x37\xd7\xb0\x36\x31\x32\x08\x58\x4c\x96\xbb\x0e\x79\x4c\x9a\x6b\x34\x69\x29\x9c\x66\x31\x80\x80\x6b\xa8\xb3\xa7\x06\xaa\xd3\xeb\x09\xc1\xc8\xc8\x6c\x77\x83\x44\x1a\xd8\x79\x65\x31\xcc\x38\x26\x7b\x43\x9f\x6e\x3f\x09\xdf\x53\x4f\xd7\xba\x1a\x56\x7f\x50\x08\x0d\xe9\x62\x3f\x49\xb1\x06\x3a\x53\x46\xe7\xc3\x56\x1a\xfc\x77\x30\xd6\x3b\x0f\x8c\x65\x49\x5c\xae\x36\x4c\x9e\xa8\x71\x30\x05\xe8\x22\xa5\xc2\xdc\x21\x44\x83\x1b\x7a\xe5\x19\x65\x70\x1f\x6c\x24\x54\xa7\x30\x27\x19\xda\xb2\xcb\xae\x06\xde\x49\x65\x69\x5c\x0b\x4e\x58\x80\x80\xe4\x81\x4c\xe8\x2e\x54\x54\x9e\xbf\x7e\xfd\x40\xf9\x4a\xab\x9f\x20\xb9\xb9\x65\xd3\x5d\x7c\x48\x1d\x87\x3c\x5d\xc2\xbc\x44\x1e\xf4\x62\x9f\x89\xaa\x2c\x2c\x0b\xd1\xa9\xe5\xcb\x81\x80\x12\x66\x9a\x04\x14\x1f\x85\x27\x84\x1d\xb3\xe1\x0d\x3e\x18\x77\x7e\x9a\x3c\x8e\x35\xcb\x9a\x23\xf9\xca\xe0\xa2\x24\xbe\x55\xfa\x0d\xdd\x09\x0f\xeb\x14\x0e\xd5\x02\x40\x83\xed\xa1\x8c\x96\xb8\x02\xb6\x9c\xd4\x6b\x11\xd7\xd5\x1c\xb2\xb9\x08\x0c\x40\xec\xcb\xeb\xcb\xba\x04\x5f\x9f\x6d\xfb\xe9\xed\xf5\x8d\x75\x6e\x87\x81\x49\xf6\x10\x46\xac\x22\x0c\x20\x34\xf4\x44\x58\xb1\x36\xd2\x7e\xc3\x28\xcd\xc3\x81\xd5\x7c\x55\x4d\x9c\xd3\x76\xe8\x0d\x68\xc1\x07\xe7\x22\x01\xe8\x66\x08\x2e\xb8\x64\xec\x17\x14\xe7\x49\xed\x94\x48\xc2\x29\x82\x48\x0c\x5d\x57\x4e\x41\x9a\x40\xf1\x89\x92\x69\x16\x07\x10\xd3\x15\x26\xe3\xbc\x83\x79\x41\x66\xc5\x37\x1a\x0d\x0f\xdd\x25\x89\x2f\x02\xe2\x9b\x9a\xc3\x63\xc4\x88\xcf\x9b\x2c\x15\x58\x8e\x07\x34\x25\xe2\xca\x80\x8a\x29\xf6\xb0\x2f\x00\x5c\xcf\x08\x64\xb3\x65\xd5\x4c\x7a\xc1\x4b\x5d\x54\xc7\x82\x44\xfb\xe9\x23\x6a\x05\xcd\xba\x05\xfe\x57\x49\xd1\x00\x16\x67\x53\x56\xfc\x10\x98\x94\x45\xfd\x91\x3d\x9b\x20\x55\x9e\xc8\x38\xc7\xbc\x5a\xba\x00\x96\xea\x74\xa8\xe7\xe6\xb9\x3f\xb0\xd2\x09\x3b\x1e\xa8\xe6\x67\x83\x63\x94\xa6\x99\x85\x97\x3b\xdc\x19\x32\x74\xca\x1a\xcb\xe5\xdf\xa0\xf7\x14\x2c\x96\x74\xd1\xbf\x96\x1c\x46\x2b\x40\xfb\x2c\x93\x65\x77\xa9\xc0\x46\x6d\x86\xb5\x27\x75\x82\xa5\x40\x52\xfd\xc2\xd1\x10\x69\xcc\x9a\x3f\x0c\xca\x61\x2a\x1e\x33\xb6\x61\x04\x00\xac\x6f\xaa\xd6\xf8\x61\x14\xb2\xac\x64\x17\x90\x2f\xd6\x3d\x32\x90\xb2\xa2\x45\xaa\x9f\x48\xef\x37\xf7\xdd\xec\x84\xca\xc5\x32\x79\xea\x61\xc7\xae\x56\x08\x05\x3d\xdc\xc6\x4c\x42\xd4\xa9\x43\x8d\x27\xce\x49\x21\xa4\x19\x92\xdd\xf9\xdb\x02\x99\x44\x12\x22\x6c\x9d\x46\x88\xf9\x42\xf8\x27\x4e\xf1\x4e\xf3\x01\x66\x6c\x6c\xa7\xd4\xf2\x6d\xb9\x1d\x06\x80\xb2\x1e\x3e\xbf\x4a\x13\x48\x30\x87\xa8\x62\xd1\x32\x36\x1c\x26\x27\x78\x57\x9e\xc5\xe9\xd8\x12\x31\x50\x6d\x6c\xda\xba\x3b\x37\x18\x99\xa9\x95\xd7\xa2\xa1\xbe\xd8\x94\xe0\x3b\xc2\xbb\x33\x18\xe6\x33\x49\x02\x18\x59\x46\x7b\x15\x58\xcc\x85\x85\x69\x34\x0f\xe7\xaa\x56\xf7\x1a\x04\xa6\x42\x48\x5a\x34\x22\x34\x0a\xc4\x7a\x7e\x1a\xfd\xfa\xe5\xad\xfe\xf2\xb9\x3e\xbd\xe1\x90\xf6\xf8\xf0\xc7\xe7\x04\x37\xc2\x17\x26\xf7\x96\xc6\x85\x41\x0e\x8d\x6a\x73\xef\x84\x98\x66\x03\xe0\x9f\x3b\x44\x87\x54\xbf\x99\x93\xf9\x83\x75\x6f\x18\x72\xc7\x4a\x28\x58\x1d\x12\xa3\x23\x6c\x49\x34\xc6\x56\x1f\x93\x23\x45\xad\x5a\xb0\x87\x9f\xbe\x3c\x93\xcc\xb0\x6f\x33\xb3\xa5\x36\x34\xc0\x2b\x39\x7d\x93\xe6\xa1\x14\xb2\x7d\x75\xe8\x31\x87\xbd\xdb\xc1\xa8\x1e\x68\x50\x7a\x33\x0b\x9f\x0c\xba\xee\x48\x6e\x2c\x0c\xef\xdb\x70\x3e\xa0\xb6\xde\x60\x3c\xcf\xae\x38\x63\x1f\xce\x4b\xfd\x5e\x5c\xab\xbf\x06\xe6\x5b\x4e\xe0\xf9\x29\x00\x83\xd4\xef\x0c\xbb\x83\x48\x45\x44\x93\x04\x88\x2a\xe6\xfe\x82\x0d\x47\x4e\x90\x26\xf4\x8f\xd9\x0d\xce\x64\x0a\x22\xd9\x50\x4d\x00\xdb\x8b\x42\x20\x53\x32\xdc\xf7\x51\xd0\x5a\x46\xd3\x0d\x58\xce\x32\xff\x05\x46\x96\xb5\x8f\x4e\x22\xac\x44\xac\xa2\xa5\x17\x84\x29\x4b\x4f\x2c\x16\x64\xe3\x00\x8e\xec\x28\xdf\xf7\x96\x72\xcb\x61\xd0\xc6\xf4\x60\x55\x60\x13\x93\x97\x32\x20\xb1\x2c\xa5\xe3\xea\x11\x27\xf0\x65\x98\xb2\x2a\x8d\x34\x56\xe5\x18\x17\x6a\xcb\xae\x80\x73\x2b\x57\x3d\x82\x41\xa7\x79\x9c\x60\x48\x0d\x63\x5b\xc6\xa7\xb5\xfb\xd2\x06\x2c\xc8\x19\x5a\xea\x7c\x50\xb8\x68\xef\x46\xdc\x6e\x33\xf9\xc0\x0e\x2c\x0a\x31\xcf\x34\xc2\x33\xe6\xff\xf3\xc8\x4f\x66\xa7\x26\xd9\x57\x43\xa1\xf8\xce\xe6\x22\x9a\x49\x39\x36\x95\x03\xc7\x5d\x51\x82\x5b\xcf\xb3\xee\xb7\x78\x4f\xc4\x6c\x29\xf3\x8c\xda\x54\x20\x93\x83\xd9\xf8\x28\xd6\xde\x80\xe7\x9e\xc0\x1b\x09\x62\xf0\xe0\xd8\x52\x66\x5b\x90\x27\xd6\x6e\x20\xf3\x6b\x5f\xeb\xe0\x1f\xea\x26\xc8\xc1\xb1\x2f\x3e\x86\x81\xed\x19\xc7\xd2\xd9\x05\x70\x80\x22\x1f\x39\x08\x2b\x0c\x7f\xbe\x90\xf8\xdc\xcc\x4d\x77\x9a\xed\x19\x06\x59\xf8\x2e\x3e\xac\x80\xd8\x37\x06\xd6\x3c\xb4\x55\xb1\x41\x6c\x58\xbe\x82\x7b\x97\x7c\x2b\x20\x98\x56\xe3\x60\x7d\x3d\x80\xa9\xd7\x2b\x8b\xee\x4a\x97\xec\x1d\x80\xc7\x9e\xa4\xa3\xf1\xf7\x3b\xca\x56\x8a\x44\xb0\x59\x14\x49\xeb\x91\x59\x26\x44\xc5\xe8\xa6\xc7\x73\x1f\x82\xf8\xee\x25\x8b\x9f\x9e\xc1\xdf\xf2\xd0\xa6\xf2\x10\x68\x8c\x58\x63\xa7\xc6\x16\xdc\x92\xc6\x86\x88\x76\x47\xe4\xc9\x26\x8d\x7e\x8a\xa3\x18\xb9\xcd\x0b\xd1\xf5\x87\xca\xde\x24\x90\xec\xbf\x22\x4c\x3a\x4e\x0b\x05\xd4\x21\xe1\x21\x22\xe0\x0d\x3c\xdd\x0f\x03\xf7\x6e\xfb\xfe\xa5\xd4\xb1\xec\x1d\x29\x05\xa5\xf8\x8c\xad\xe6\x33\x0b\x78\x71\xde\x63\x40\xf6\x1b\xe7\x97\xe0\xf2\xd6\xd6\x54\xa9\x60\x5f\x3a\x59\x19\xfa\x28\x7c\x27\xe4\xab\x3f\xd0\x3c\xbf\x39\xea\x36\xa3\xb2\x4b\xdb\xe4\xc1\xa9\x60\x5a\x8c\xa7\xc4\x63\x48\xe5\x82\xef\x9a\xb5\xe5\x5d\x12\x91\xd2\x59\x38\x60\x58\xa0\x78\x2b\xa1\xb7\xda\x76\x32\x4f\x08\x74\xd4\xec\xcb\x40\x5f\x4c\x9b\x59\x9a\x6a\x62\x87\x38\xdc\xbb\x5c\x79\x17\xd6\x8f\x48\x36\x34\xa5\x0f\x0c\xfc\xb2\xc5\x2a\x4c\x36\x1c\x10\x4d\xfa\x2c\x3f\xa0\xa0\xac\x54\xdf\x0b\x4c\x15\x17\x34\x88\x6c\x44\x51\xe1\x72\xfa\x12\xb7\x0d\x0a\xc2\xa5\x12\x81\xce\x60\xc7\x75\x74\x8b\x6c\xa1\x0e\xac\xec\xb0\xc3\x28\x95\xc9\x6e\x6e\x06\xe2\x25\x17\x92\xcb\x76\x24\x53\x25\x97\x6f\x55\xea\x71\x06\x96\x56\xbc\x19\x16\x7c\x3f\xbc\x4c\x2c\x28\xae\xa3\x33\xc8\x15\x4b\xab\x0f\xa1\x0c\x6b\xb1\xdc\x89\x79\x53\x78\xe6\xb2\xde\xb1\x43\xe0\x3a\x08\x4a\x58\x2d\x48\xda\xa8\x31\xf3\xde\xb2\xf8\xf5\xf4\x3c\x7b\xeb\x67\x46\x6e\xbc\xe2\x8c\x7b\x5e\x92\x48\x69\x8c\x79\xe7\x13\x8b\x20\x1e\x62\x85\xe8\xbe\x5f\xa9\x18\x15\xda\x2e\x68\x32\x2e\x04\x6e\xc7\x59\xe6\x56\x59\x80\xc8\x54\x40\x58\x28\x0f\x32\xbc\x96\x2d\xbc\x7a\x2c\x6d\x6c\xbe\x24\xd8\xce\x0a\xd3\x6d\xf3\x41\xdd\xd2\x40\x6b\xe2\x84\xda\x00\xf2\xd2\xc6\x89\x5b\xaf\x3b\xc2\x1c\x37\x35\xbd\x53\xbd\xeb\x8c\xe1\x5e\x1c\x58\xd7\xb3\x30\x4d\x36\x96\x87\x55\xba\x3b\xd3\x74\xb6\x69\xaa\xb0\x86\x56\x69\x5b\xb1\x64\x41\xb9\xa2\xf1\xb3\x61\xb4\x0d\xc6\xc4\xba\xad\xbe\xa5\x6c\xa0\xa0\x71\x16\x19\x2e\x81\x4a\x11\xf0\x50\xa9\xcd\xf9\x8c\x48\x67\x18\x7d\x82\x36\x58\x03\x44\x29\x39\xb4\x87\x9c\x78\xf1\x37\x9e\x7a\x82\x75\x9e\x99\x4f\x8c\xab\x02\x0d\x9d\x05\x6d\xa7\xcf\x2f\x2f\xf5\xcc\x66\x8e\xe0\xcc\x4a\x00\x70\x6c\xc8\x4f\x18\x68\xf5\xad\x4e\xc9\x0c\x20\x1d\x1b\xdc\x31\x8a\x91\x6c\xe5\xe6\x77\xc0\xe8\x94\xe0\x7d\x0f\x53\xbe\x4a\x3b\xc6\xba\xb3\xaa\x52\x36\xb3\xa5\xb7\x7d\x92\xc9\x2d\x22\x36\x3b\xe2\x31\xe4\xfb\x61\x6b\xe7\x26\x2a\x2c\x24\x52\x39\x49\xd8\x46\x6c\x38\xc8\xae\x15\xf4\x3d\x93\x07\xb0\x67\xd6\x7a\x9e\xdb\x34\x28\xa3\xb8\x33\x3e\x6c\x3a\x4b\x26\x2c\xe2\x81\x6c\xd8\x6a\x9b\x87\xea\x08\xec\xc0\xc7\x16\x59\x16\x89\x01\x08\x38\xe0\xe9\xd3\xcb\x27\x4a\xda\x35\xa2\x65\x48\x5b\xcd\x9b\x3e\x3b\x37\x33\x87\x75\x9c\x7b\x71\x53\x6d\x40\xaa\x1c\xaf\x21\x6b\x72\x56\x13\xef\xe8\x5e\x00\x6d\x14\xe5\x1e\x20\xec\x9b\x41\x10\x15\x46\x67\x14\x1b\xef\xaa\x9f\x8e\x44\xa1\x32\x5a\xaa\x8c\xf8\x44\xe5\xe6\x8e\xc9\xb5\x64\x93\x97\xd0\x5b\x74\x55\x64\x70\x60\xbf\x90\x95\x16\xd3\x00\xb9\x4e\x81\x7c\x06\x88\x1e\x43\xd2\x86\xd2\x3e\xe2\xc2\x79\xe3\x98\x0c\x34\x37\x43\xb1\xcb\x82\xf4\x55\x56\xf9\x5a\x16\xad\x28\xdf\x2e\x97\x22\x04\xb1\xd4\x15\xb2\x48\x04\x1f\x3a\x01\x46\xb8\x8e\x49\xeb\xc0\x6c\xdf\xa8\x44\x84\x09\x74\x8a\xca\x2e\x46\xa4\x01\x5a\xe5\xce\x56\x05\x70\x9a\xa7\x5b\x71\x1f\x93\xad\x0b\xe3\x6f\x52\x46\x7a\x18\xed\xba\x95\x96\x92\x98\xd6\x99\xf7\xb7\xe7\x4f\xf4\x50\xa8\xb6\x52\x4c\x87\xe4\xa6\x90\xf5\x59\x6f\x2c\xec\x45\x35\xbc\x0b\xbf\xf9\x48\x39\x4a\x0d\xa4\xf0\xd9\xf0\x0e\xf0\xaf\x87\xd8\x89\x5a\x02\x62\x9d\x52\xb4\xf0\xa8\xd8\x85\x48\xc3\x07\xd6\xd3\xdb\xdb\x57\xd6\x00\x8d\x23\x2d\x18\x65\xff\xeb\xd7\x13\xbb\xc9\xb0\xef\x00\x88\xe1\x3d\x5f\x3f\x3f\xb3\x48\x1a\x20\x4e\xe6\x2d\x78\x8d\x9d\xcd\xc8\x8c\xcc\xf9\x8d\x18\xe5\x66\x88\x6a\xce\x94\x03\xd7\x0d\x88\x3e\x65\xa7\x3a\x31\x8e\x9e\xfa\xa9\x04\x05\x44\xe1\x8b\xdf\x8d\xb9\x8b\xcc\xb2\x1a\xe4\x95\x6a\xfd\xa0\xca\x3a\x17\x5f\x62\xdc\x91\x55\x28\x9f\x5c\x72\x98\x85\x96\xd3\x7c\x8e\xb7\x5e\xf5\x1e\x3d\x22\x18\x0d\xf0\xf6\x66\x23\xc6\xd0\xd0\x62\x3e\x93\x5a\x1e\x57\xf5\x58\x88\x82\xeb\x9e\xea\xbe\x16\xed\x72\x23\xce\x63\x58\xa4\xe5\x6a\xa6\x0b\xbd\x11\x9c\x61\xa9\x8c\xe5\x1c\x6d\x88\xd9\x42\xcf\x1a\x51\xc8\xae\xe2\x30\xb3\x2b\xd8\xb8\x47\xfe\x8f\xea\x66\xa3\x38\x84\x5f\xdb\x8e\x22\xb0\xcd\xf6\x2a\x14\x3b\x8b\xd7\x77\xe2\x31\x8d\x18\x07\xca\x12\x94\xbb\xf7\x77\x79\x3e\x33\xfa\xd2\x18\x2c\x73\xf1\x40\x9d\x53\xc9\x2d\xe7\x51\x37\x83\x7f\xf7\x46\x3e\x2e\x47\xbb\xf5\x24\xaf\xab\x7c\xe0\x85\xe3\x00\x85\x59\xd0\xd9\x1f\xa2\x91\x04\x87\x1a\xef\x7b\x41\x33\xb6\x90\x41\xe5\x0c\x63\x0f\x44\x34\x7e\x1d\x20\xa8\x8d\xe2\x8c\x31\x82\x6a\x9d\x91\xa6\xc3\x36\x90\x68\xdf\xb1\x3f\x94\xb2\xd6\x98\x87\x57\xdb\xc0\xf2\x40\x01\x8a\x32\x0a\x16\x4e\x1d\xc2\x19\x0e\xaa\x3a\x4c\x25\x4b\x99\xbf\xf2\xa9\x50\x99\xac\x0f\xa3\x6a\x00\x45\x64\xd2\x6a\x11\x17\xcc\x9e\x7c\xcc\xc3\x96\xef\x4c\x29\x5c\xa2\x08\x4e\x96\x31\x0b\xe4\x66\xac\x85\xe7\x0c\xc0\xac\xf0\x3d\x99\x93\x0b\xf3\xdc\xf4\x99\xea\xc7\x78\x64\x50\x9e\x14\x1c\xb4\x30\xd5\x6b\xde\x41\xb5\x8b\x5e\x21\xa4\x8c\x1d\x07\xc5\xc9\x9c\xcb\x5f\x2d\x4e\x37\xd6\xeb\x43\x3f\xfe\x37\x0e\x92\xc3\x19\x68\xce\x56\xbb\x00\xff\xc1\xc8\x14\x82\x75\x2e\x81\xb9\xac\xd8\x6c\x93\x38\x78\xb3\x9e\x63\xab\x05\x36\xca\x84\xfe\x97\x9e\x0a\x43\x6b\x06\x3b\xc5\x24\x3c\x76\x5e\xef\xb7\xcf\xa7\x72\xbd\xbd\x85\xe8\x3c\x9e\xc6\xc6\x2c\x83\x9d\x71\xc4\x3d\x4f\xc9\x18\x70\x1f\x00\xd6\xe2\x89\x67\x19\xf4\x0d\x18\xa5\xb6\xac\xd9\xd8\x89\x0e\x42\x2a\x9a\x4d\x1b\xaa\xfa\x62\x25\x82\x84\x98\xfc\x82\x4c\xb1\x4c\x57\x5d\x90\x31\xb0\x44\xff\x51\x4c\x26\xb8\x7d\xa4\x51\xe5\x8b\x9c\xf7\x5b\x19\xe2\x12\x72\xef\x97\x45\x96\xd5\xf2\xfe\xc0\x30\xa2\x36\xec\x36\xcf\x15\x92\xe3\x86\xbd\x72\x33\x76\xed\x65\x1d\xf2\xd3\xd4\x19\xce\x66\x88\x95\xcb\x7c\x11\xc0\xa9\x20\x5b\x9e\x6a\x20\x73\x3e\xb1\x21\xa2\xe9\x15\x1e\xf9\x44\x7f\xeb\xc5\x66\x58\x03\x62\xab\x2e\xa7\xe7\x92\xf4\x44\x0e\x31\x18\xe8\x9d\x18\xd4\x38\xa9\x65\x13\xd3\x72\xc8\x7f\xe4\x8e\xec\x44\x3d\x9b\x75\x98\x67\xa4\x5a\xf4\xcc\x8c\xc1\x8b\x88\xb2\x9f\x3c\xeb\x39\x69\xf0\x60\x86\xd8\x15\xca\x38\x8f\x2a\xd6\x1b\x58\x12\x69\x31\xa3\xe9\xf5\xa6\x99\x67\x42\xf3\xcc\xdb\xd1\xc1\xb6\x76\xe2\x76\x90\x68\x3c\xa9\x03\x1b\xe7\xa3\x19\x55\xd1\xda\xc7\x55\xd0\x9d\xa4\x5e\x6d\x89\xfe\x57\x9a\x69\x52\x3d\x0b\x95\xfc\xdc\xdc\x79\xdf\xf1\x00\xd1\x1b\x4e\x59\xe7\xbe\xf7\x1a\xe2\x86\xc8\x4e\xc9\xbc\x28\x03\x4f\x4d\x11\x49\x43\x8e\x26\x18\x31\x85\x24\x4a\x3f\xb2\x15\x75\x38\x91\x95\xb2\xde\x0d\x96\xb3\x53\x03\x1d\x32\xc1\x1d\xe3\x60\x46\x69\xf9\xd6\xa1\x21\x3d\xb6\x59\x57\xa1\xe9\xbb\x5c\xe9\xf4\xf6\xf5\x95\x03\x62\x85\x1d\xd5\xde\x32\x1e\x13\x4e\x3b\x5b\x52\x47\x5d\x18\x27\x0e\xc8\xc9\xd3\xad\xc4\x48\xf4\xe1\x44\x44\x6c\xab\x09\x93\x33\x98\x83\x82\x20\x82\x86\x37\x0b\xe7\x08\x94\xfa\x11\x99\x53\xf9\xc8\x9e\x4c\xe9\xb1\xe3\xbe\x14\x40\x40\x27\xad\xa4\x99\x23\x0e\x47\xfb\xc7\xf8\x3f\x04\xc4\x07\xce\x18\x26\xf4\x91\x9c\x8b\xb7\x74\x57\x6c\xd0\xab\x43\xd5\xa3\xe6\x61\xbf\xa4\xdc\x57\xa6\xc0\x28\x14\x3e\x22\x97\x06\x5c\x15\x46\x54\xa7\x6a\xc4\x26\x10\xba\x11\x7c\xef\x5c\x31\x89\x77\x3c\xc1\x29\x03\xe4\x70\x47\x94\x03\x82\xdd\xf2\xac\x26\x83\x60\x94\x53\x2e\xf0\x78\x54\x02\x38\x29\x6b\x9b\xef\x31\x1c\xb3\x15\xcf\x2b\xf3\xb3\x07\x1c\x79\xa7\x93\xf7\x02\x93\xd7\x3b\x9c\x0a\x12\xee\xaf\xc5\xd2\x48\xf8\xa7\xbc\xa5\x09\x26\x34\x03\x00\x4a\x8b\x46\xe0\xa3\xa9\x6b\x09\xd9\xbc\xe1\x3d\x13\xd8\x76\x70\xc0\x2c\xa5\xc9\x12\xbb\xa7\x86\x06\x9b\xab\x9b\x43\xa6\x1d\x7f\x92\x5d\xa9\x89\x14\x77\x18\x3f\xbd\xe0\x71\xbb\xd9\x04\x5d\x75\xe7\x92\xbf\xcc\x0b\xa5\x36\x0d\xbf\x7a\x98\x46\x92\x59\x24\x69\xd0\xd2\xa0\x44\xcb\xd3\x11\x51\xe2\x5e\xa9\x25\xc1\x1b\x6a\x0f\x20\xf3\x68\x1e\x27\x1f\x1c\x63\xac\x95\x06\xf8\x6b\x87\x07\xda\xb1\x36\x08\x9c\x77\x75\x1d\x76\xd0\x21\x50\xa5\x8e\x58\xaa\xf3\x2c\x04\xab\x54\x0c\x2a\x37\x64\x01\x06\x1e\x38\x2d\x21\xcc\xef\x56\x3c\x23\x11\xca\x4a\x9a\xa6\x90\xa1\x25\xc1\x81\xcc\x62\x9a\xb1\xe7\x9c\x1d\x9c\x12\x47\xe5\xa4\x83\x79\x94\xb5\x92\xcf\x8b\x68\xe6\x87\x94\x34\x4e\x68\xda\xc4\xca\xc7\x66\x2e\x02\x6d\x71\x09\x0e\x5f\xe2\x10\xf9\x83\x4c\x87\x84\x51\xac\x93\x16\x62\xdf\xa6\x0c\xf8\x92\x46\xb1\x5f\x6a\x3c\xcf\x91\x9c\xf9\xac\xc0\xba\xe2\x69\x04\x3c\x17\xb9\xc5\x26\x8e\x08\xc6\xc0\x2d\xaf\x1e\x8c\x13\xb5\xc8\x62\xeb\x45\xc9\x40\x07\xb6\x39\x26\x9c\x32\x13\x28\xfa\x58\xe1\x92\x2b\xa0\xa0\x49\xe6\x8a\xa8\x8e\x67\x2b\x3e\x90\xef\x07\x77\x73\xa9\xc9\x20\x51\xbd\x56\xea\x27\xcb\xdc\x00\x12\xab\xdc\x91\x05\xb1\x94\x21\x46\x5c\x38\x17\x0d\xec\x5f\xc9\x71\x62\xa3\x66\x6b\x66\x40\x61\x17\xc1\xd3\x21\x97\x75\x39\x56\x8b\xb0\x59\x3d\xde\x87\x6f\x71\xfa\xb7\x7e\xaf\xd1\x85\xe8\x36\x28\xa1\xe7\x39\xb1\xc6\x5c\x61\xa5\x79\x81\x31\x31\xab\xae\x78\x60\x89\x98\x71\x02\x6c\xd1\x33\x83\x29\x82\x43\xbc\x1c\x67\xde\x2d\x05\x35\xa4\xc4\x60\xb1\x2b\x17\xc5\x07\x65\x31\x16\x05\xa6\x9e\x02\x4a\xa3\xbe\x19\xdc\x8a\x74\xf1\x4f\x5f\x3e\x63\x56\x7a\xca\xed\xb4\x41\xb7\xc1\x91\xa8\x46\x4d\xf8\x29\xf2\xd9\xa6\x5a\x29\x21\x02\xf1\x78\x03\xe1\x28\x1d\xc9\xa2\x3d\x8e\xc8\xb2\x84\x9c\x01\x65\x71\x16\x2c\x28\x11\xf2\x9f\x37\xee\x17\xd8\x0c\x82\xc9\xb6\xcc\x43\xe4\x96\x45\x4c\x21\x10\x93\x49\xad\xc4\x9a\x8b\x04\x49\xa7\x30\x33\xf2\x0d\x42\x00\x00\x4f\x36\x53\x14\x87\xc2\xd0\x24\x95\x38\xe1\x94\x10\x90\xdc\xb1\xa9\x5f\x93\xe9\x0a\x12\x68\x1d\x25\x8a\x5a\x04\x3c\x89\xe1\x00\xe8\xc7\x91\xdf\xb8\x55\x6c\x18\x6e\x1c\x66\xc2\xa2\xac\x1e\xc0\x2d\xb7\x89\x08\xff\x58\x8a\xec\xcb\x2b\xb7\xc8\xa1\xf1\xcd\x5c\x58\x78\x0b\x4e\x70\x4e\xb3\x65\x98\x43\xbd\x54\x71\x2c\x70\xb1\x1e\xd2\x88\x1a\xdc\x79\x43\x09\x96\x9c\xcb\x2a\xc2\x84\xf9\x02\xac\x48\x56\x49\xae\x0b\xd0\xd5\x23\xf1\x87\x76\x37\xd0\xf4\x21\x6c\x4c\x3b\xf0\xfd\x07\x4f\xd9\x1c\x05\x36\x34\xb4\x67\x50\x0c\x2f\xc2\x86\x35\xd4\xfe\x08\xc2\x4e\xf0\x0b\x24\x03\x14\x81\x9f\x8c\xb4\x61\x25\x03\xfe\x79\xf7\x84\x6c\x16\x63\x1a\x46\x03\x03\xc3\x3c\xf0\xb9\x3d\x26\x4c\xd2\x9a\xc2\xd0\x0f\x62\xbb\xb6\x14\x8a\x20\x79\x07\x41\x4c\xf9\xc4\x3d\x0f\xdf\x3e\x4c\xe7\xfa\xfc\x8c\x13\x71\xcb\xb7\xa0\x49\x6c\xa8\xc6\x15\x62\xc4\x43\xc0\x5f\x96\x20\x95\xb8\xa3\x31\xc9\x7a\x20\xfa\x2b\x33\xb8\xd1\xa4\xb2\x81\x92\x66\xc2\xf2\xc0\x02\xba\xc4\x93\x94\x60\x08\x6e\x5e\xf1\x23\xb3\x2e\xca\x94\xe5\xc5\x24\xf8\x1e\x17\x94\x9d\x65\x0d\xae\xfc\x1e\xfa\xd5\x1b\x4d\x0f\xbf\xe2\x5d\x6a\x29\xd8\xc0\x2b\xac\xc5\x90\x5d\x18\xc6\x6f\x1e\x26\x8b\x47\x76\xd3\xf0\x29\x1c\x9b\x8b\xba\xca\xa6\x46\x9d\x01\x88\xc4\x83\x1f\xaa\x97\x67\x4a\x8f\x8b\x95\x8d\xd0\xc6\x52\xcc\x12\xc2\x6c\x5e\xf0\x2d\xc7\x83\xe7\x6c\x04\x18\x1b\x4f\x78\x77\xb9\xf0\x2d\x47\x99\x01\xc1\x62\xd3\x4b\xaa\x43\xb7\x0d\xd5\x65\xed\x80\x67\x2e\x0e\xa5\xa3\x14\xae\xef\x07\x00\xd8\x34\x13\xb6\x0b\xf1\x4f\xd9\xd7\x09\xe7\xbf\xb1\xc2\x48\x08\xc0\x5b\x35\xf1\xe6\x14\x2c\x92\x85\xac\x16\xd9\x4a\x3c\x10\x4a\xd0\xe1\x87\x7b\x11\xe2\x09\x50\x2c\x4b\x03\xa0\x5e\x7f\xfd\x06\x9a\xd2\xc1\x5b\x9b\x75\xd1\x6c\xb6\xeb\xd6\x7b\x84\x37\xaa\xb6\x69\xb2\x18\x38\x02\xc3\x89\x7f\x2f\xd5\x80\xd9\xcf\xac\x4e\x2d\x40\x21\xe4\xe9\x44\x2c\xf7\xf2\xfc\xfc\x15\x7c\xed\x82\x44\x6e\x6a\xd1\x1c\x8b\x2b\x24\x22\xe8\x2c\xa4\xe5\x47\xe4\xa4\x2c\x2c\x26\x6d\xf1\xc7\x98\x60\x4d\xa8\xfa\x1d\xfd\x37\xc6\x57\x39\xc4\x99\xfb\x54\xf8\x77\xb0\x41\x77\xaa\xe7\xe9\x4c\x08\x98\xc5\x88\x9c\x99\x1a\xbf\x33\x44\x06\x9f\x3e\x7d\xe2\x40\x76\x20\x92\x48\x8c\x3c\xc0\x9a\x91\xf7\x67\x9e\x50\x8e\xac\x73\x9f\x60\x5a\x6c\x7b\x09\x15\xbe\x10\x08\x96\x82\x51\xce\x1d\x95\x68\x4e\xac\x78\x2a\xbc\x52\x2b\xf9\x93\x0d\xc4\x88\xa9\x31\xb6\xcc\x83\x3b\xa6\x51\x35\x48\x29\xe5\x99\x5b\xac\x63\x1c\x06\x43\xc3\xd7\x1e\xba\x0f\x91\x16\x33\x93\xc6\x24\x24\x4a\xbc\x19\x76\x66\x7d\x83\x09\x7e\x4b\x67\x5a\x05\x56\x72\xc7\x33\x68\xb9\x1d\x6b\x17\xa3\xba\xe9\x23\x37\xfd\x72\x48\x94\x0d\xe6\x40\x8a\x83\x67\x60\xd5\xf5\xf7\x8b\x69\xe9\x6c\x22\x6c\xf9\x50\x99\xd5\xa6\x2d\xe2\xc1\x2e\xb6\x2a\x8b\x92\xfe\xe9\x39\x29\x7f\xf0\x7c\x5e\xef\x97\x95\xf5\x70\x63\x38\xf7\x3e\xdf\xdb\x96\x99\xa8\x44\x9e\xce\xd0\x47\x4d\xa3\x08\x82\x2e\x6a\x1b\xbd\xf4\x9e\xba\xe9\x23\x55\xb9\x8c\x3c\xc4\x8e\x65\x76\x24\x73\x38\xfd\x45\xed\x7c\x60\x8d\xdb\xfc\xb4\xab\x0d\x4c\xba\x61\x94\x71\xe1\x16\x76\xc2\x50\x9c\x9b\x76\x3c\x7c\x6b\x91\x94\x94\xc9\x11\x42\xd0\xeb\x19\xa4\xd1\xcf\x06\x35\x74\xf6\xbc\x53\x3b\x9e\xc1\x80\x65\xf7\xa1\xb8\xc7\x77\xb1\xb0\xe1\xcc\x41\xe5\x87\x8d\x85\xd7\x58\xbc\xdc\xf6\xd6\xb7\xac\x09\x1d\xa7\xf1\x19\xaa\xe4\xc3\x7d\xba\xc3\x51\x50\x94\xa6\x4c\x76\xcf\xdb\xb9\x60\x86\x90\x26\xba\x62\x61\x61\xe9\xbb\xb3\x61\x07\xbe\xdc\x3d\x3b\xff\xf4\xaa\xdc\x0a\x17\xd9\x71\xa8\x3e\xbd\xe3\x70\xdc\x6d\x1c\x24\xb8\x84\x3c\x13\x13\xfe\xaf\x6f\x15\x4d\x7b\x99\x02\x70\xc2\x63\x31\x0b\x92\x98\x7b\x2a\xba\x9d\xfd\x5d\xef\x94\x01\x04\x78\xfd\x30\xb0\x0e\x7e\xc3\x4f\x50\x13\x7a\x2d\x4c\x57\x08\xc4\x59\x8f\x35\x40\xe3\xb2\x33\xd8\x82\x17\x8e\x54\xa0\x08\xa1\x17\x07\xc9\x86\x60\x85\x88\x0f\x88\xfa\x3b\x76\x44\xf3\x82\x8b\xe2\x36\x33\x7f\x30\xc4\x71\x67\x89\xae\x7d\xc6\x1c\x18\x80\x99\xfd\xca\xd7\x71\x95\x39\x45\xcb\xe6\xdf\xd6\x46\xe0\x4c\x82\xec\xe4\xc2\xc6\x96\x57\x69\xcc\x77\x09\x94\x46\x4d\x13\x46\xbb\xfb\xf0\xf6\x56\x18\x01\x32\x77\xc0\xe6\x48\xb5\x5e\xa7\xc3\xa0\xb6\xa6\x4c\x8f\x0b\x65\xce\x0f\xd9\xdb\xeb\xfb\x34\x94\x92\x08\x62\xe0\x49\xac\x94\xd3\xcb\x67\x2e\x81\xa7\xfe\x30\x47\x6e\xe5\xe7\x9c\xbb\xe0\x02\xa8\x0a\x2d\x22\x6d\xa2\xec\xd3\x14\xc9\x0f\x0f\x89\x63\x40\xe1\xa6\x7f\x29\xfe\x15\x3b\x39\x23\x2c\x8f\x8d\xa4\xba\x0f\xf5\xb0\x5c\x97\x46\x03\xd8\x51\x7e\xd5\x5d\x1d\x1d\x07\xb6\x75\x8a\x66\x1e\xda\xe1\x8a\xd4\x27\x39\x14\x0f\x60\x77\x0b\x06\x53\x2f\xa7\xd3\xa7\xd2\x4a\x8d\x09\xb7\xfb\x21\x67\xb9\xd9\xae\x7a\x98\x58\x36\xc5\x89\xff\xe0\x9e\x42\xf2\x5a\x38\x6a\x27\x63\x74\xdb\xc2\xfe\xcb\x94\x94\x70\xaf\x8c\x98\x86\x80\xf3\xc0\x16\xb3\xcd\x31\xeb\x88\x5f\x8b\x13\x6d\x98\xdb\xbd\x0d\x62\xe6\x53\xd8\xcb\x89\xe7\xdc\xad\x05\x0e\x22\xa3\x7d\xf1\x72\x2b\x40\x12\x86\x73\x8f\xb1\x74\x66\x7f\x8b\xe5\xcb\x5b\x41\x0a\xe3\x4b\x7d\xdb\x14\xe5\x5a\x27\xde\x36\x69\xe6\x9e\x35\x22\xb0\xbe\xd7\x68\x52\xce\x5a\x85\xb3\xca\xee\x51\xcb\x2f\x47\xcf\x01\xe0\x6d\x12\x7d\x71\x78\xcb\xf4\x70\xb6\x91\xc2\xc3\x60\xf3\x8c\x36\xd0\x37\xb0\x8a\xac\x3c\xb3\x86\x71\xb7\x39\xd8\x4f\xb9\x0c\x88\x23\x32\x55\xb0\xbc\x11\x24\xaa\xdd\x53\x4e\x22\x06\x33\x3c\xa3\xa2\x5c\x38\xb9\xb4\x24\x29\xac\x23\xf2\x1a\x04\x1b\x86\xe7\x76\x3e\x9d\x3e\x4f\x85\xa6\x3a\x29\x67\xa5\xd9\x56\x0a\x61\xae\x38\x74\x96\x75\x35\xc1\xa3\xf0\xc3\x48\x3f\x9f\xf2\x34\x6a\x1e\xa3\xe7\x53\xda\x3b\x9d\xd0\x68\x8f\x27\x9e\x90\x0c\xc0\x4b\x40\x41\xf8\x74\xe5\x59\x5f\x36\x1c\xd1\x44\x2d\x55\xd8\xb7\x1e\x86\x69\x33\xd3\x3f\xef\xd2\x78\xe2\xbc\xf5\x72\x3d\xb4\x3e\xa4\x52\x0f\x4d\xd8\xe8\x18\xa3\x1f\x63\xf5\x43\xa3\x7d\x6c\xf7\x7c\x79\xfd\x89\x94\x34\x91\x9b\x8a\x1c\x81\x22\x87\xc5\x38\x60\x0f\x0c\x6f\x48\x96\xb1\xa1\xb9\xf8\x2c\x0c\xc6\xf3\xf8\xe9\xfc\x19\xed\x90\x6b\xfe\xb1\x0e\x13\xff\x70\x7c\xc5\x2a\xd0\xa5\xe3\x25\x1d\x54\x31\x68\x4b\x33\xbf\x50\xa4\x2a\x36\x38\x13\x41\xf0\x95\x8d\x68\x20\xf7\xc4\x6b\x30\xb7\x7e\xde\x69\xed\x62\x70\x54\x7b\x91\xbf\x0d\x4c\x01\x05\x5d\x6c\xd1\x0d\x9f\x3d\x78\x61\x91\xf2\x35\x9d\x63\x3f\xb0\x33\x03\xb0\xab\xfc\xdd\x43\x20\x47\xa7\x71\x78\xe7\x76\x39\xf3\xd0\xa4\x13\x17\xb3\x69\x43\x03\x08\xed\x26\xbb\xda\x4b\x41\x07\x35\x0f\x87\xbd\x07\x7b\x64\x0e\x35\x4c\x84\xbe\x0d\x0e\x19\xa3\x40\x03\x22\x65\x8f\x98\xeb\x91\xcd\xde\x8d\x7c\xc6\x73\x1c\x27\x15\x2b\x34\x7c\x35\x41\x66\xaa\x43\xcd\x3e\xce\x24\xe7\x76\xec\xe5\x04\xb8\x7d\xff\x20\x2c\xa1\xc8\x97\xd8\xe5\xda\x4e\x82\x1f\xdd\x8b\x67\xbf\xb7\x0e\x1b\xa4\x8a\xe1\xd7\xdb\x66\xb6\x38\x9a\x92\xad\xb1\xd3\x1d\xf2\xda\xc5\x36\x33\x09\x89\x41\x6c\x68\x40\x2a\xd1\x3c\xf2\x16\x35\xef\xa5\x8b\xe3\xbf\xb2\xd2\x00\xbe\x01\x5b\xcd\x73\x1d\xb3\xc0\x73\x60\x34\x4e\x94\x2f\x1b\xf7\xda\x4c\x5e\x85\x54\x94\xcd\x77\x1e\x86\x7a\x1c\x8e\x45\x44\x39\xc6\x93\x76\x18\xb4\x07\x09\xc6\xd1\xf8\x51\xcb\x0f\xc7\xef\x6e\xea\x32\x29\x5a\x65\xc4\xfc\x8d\xda\x2a\xf8\x35\x9e\x73\x52\x72\x97\x7e\x11\x1d\x1e\xf5\xcc\x26\xe6\x03\xb0\x34\x37\x26\xf7\x77\xb8\x4f\x3e\x0f\xb1\xd5\xe0\x70\x66\x36\x96\x00\x91\x35\x75\xff\x2d\xd8\x5b\x55\x8c\x97\x30\x3b\x0f\xcd\xf2\xac\xba\x12\x79\x30\x26\x63\x3a\x98\x8b\xda\x0e\x70\xfc\xdc\x44\x71\x2a\xce\x8a\x04\x68\x54\x7c\xc2\xae\x14\x9b\xa2\x55\x63\xce\xb5\x4d\xd5\x8e\xd4\xbe\x78\x3c\xa3\x4d\x0e\x58\xa1\x31\x96\xba\x4c\xf0\x40\x9e\x86\xb0\x6b\xea\x5f\xff\xbb\x4c\xd5\x3c\xbf\x09\xc0\x9c\xf6\x45\x02\xf4\x3c\x12\x29\x06\xf3\x27\x10\xcd\xae\x7e\x62\xd5\xa1\x00\xa8\xb8\x72\x99\x34\xad\x2b\x73\x2e\x8c\xc7\x4d\x64\x0e\x19\xd3\x0e\x98\x9a\xd3\xd4\xaa\x4e\x07\x62\xd4\xa0\x05\x82\x20\xd9\x36\x5d\xa7\x2b\x17\x11\x29\x58\x69\xea\xa8\x57\xf0\xb5\xa8\xe9\xc1\x1a\x96\x7e\xc0\x14\x43\x56\x38\x19\x8d\xe7\x05\xae\x28\xd6\x8c\x57\x8c\x0f\xf6\x56\x32\x1e\xa0\xfe\x2e\x8e\xb7\xcb\x0e\x9f\x69\x42\xc9\x9e\xa0\x6d\x9e\x1f\x78\x41\x13\x58\x95\x95\xe1\xe6\x0b\xe0\x61\xd6\x1e\x93\x46\x92\xe6\x66\xb6\x75\x01\x5f\x9b\xff\xfa\xe9\xf9\x74\x38\x64\x97\x7a\xa7\x4f\x5f\x8b\x7e\x2a\x6c\x55\x9e\x0e\x67\xf3\xdc\x43\x42\xbd\x08\x8e\xf1\x2b\x3b\x0e\x6c\x55\xce\x51\x62\x1c\x85\x90\x8d\x77\x9c\xf7\xc8\x5f\x2b\xe0\xc1\x8c\x2c\x2e\x01\xcf\x4c\x9d\x95\x0e\x95\xa9\x17\x9b\x67\x13\x5e\xd3\xfc\xe9\x6f\x38\x1b\x3c\x15\x74\xdb\x2d\x3b\x42\x2f\x4c\x1f\x2f\x7c\xda\x1e\xcf\x11\x6c\x3d\xf5\xec\xb6\x87\x0c\xe9\x70\x0e\x5d\xf4\x85\x19\x7c\x5a\x4e\x07\x63\x89\xc4\x52\x99\x58\xa8\x54\x31\x3a\x1c\x84\xaf\x74\x68\xa6\x29\x97\x30\x61\xf2\xe1\x71\x0a\x65\x86\x3f\x5c\x45\x43\x8f\xd3\x5d\x23\x6f\xad\x48\x91\x30\xf1\xd8\x8a\x44\x3b\x4d\x87\xaa\x0f\x61\x78\xda\xc1\x55\xb3\xfb\xd1\x2f\xeb\x4b\xe6\xf7\xa3\xd3\x0a\x89\x78\xfa\xf3\x37\x78\xed\x9a\x25\x70\x70\xf6\xa0\x4b\xc7\x53\xe4\x51\x97\xd1\xcf\xf3\xb8\x7e\x53\xdd\x14\x07\xfe\x70\x8c\x80\xd2\x00\x38\x81\x6a\x0a\xf8\x89\xa4\xdc\xee\xf4\x4c\x84\xee\x57\xfb\x5e\x52\x12\x98\xd6\x65\x93\x51\x6e\x11\x75\x97\x15\xc8\xed\x86\xa9\x09\x09\xb4\xe3\x71\xea\xc7\xd9\xa8\x7d\x43\x23\xec\x88\x0b\x2c\x6e\x7a\xa9\xd7\x50\x36\x9e\x15\xd8\xf6\x2a\x34\xad\x17\x19\x51\x8b\xd4\xa9\x17\x98\x2d\x5f\x9a\x77\x3d\xa2\x9d\x2d\xdc\x69\xc0\xeb\xfe\x50\xc7\x16\x89\x4c\x92\xc3\x3e\x88\x40\x57\xec\x6f\x6c\x03\xa9\x96\xf9\x09\xc0\x53\xed\x90\x82\xbe\x52\xb5\x8c\x77\x1c\xb9\xd8\x69\x90\x45\x1a\x9f\x63\x6e\x8e\x37\x8e\x6f\x5e\x2a\xae\xf3\xe1\x83\x78\x46\x05\x3c\x0f\xa3\x9b\x07\xf6\x70\xf6\x99\x53\xfd\xf7\xf5\xa2\xc7\x8e\xee\x34\x62\x12\xe7\x5e\xdc\x02\x87\x68\xaa\xbe\xd1\x42\x78\xd1\x8b\x24\x79\x56\xad\x31\xe4\xc6\x2c\x96\xf6\x1f\x26\x39\xaa\x94\xec\xe6\x5c\x5c\x9c\x72\x7d\x00\x36\x9e\xc2\xca\xf4\x63\x66\xe4\xb7\x0b\x1f\xe0\x2a\xe6\x9f\x3e\x3d\xb3\xed\xb8\x2a\x74\x40\x94\xe6\xf7\xd8\x97\xd6\xf2\xb4\x58\xdd\xc7\x63\x6f\xda\xfa\x3e\xa8\x34\x2b\x06\xac\x71\xe8\x93\xe8\x12\x32\xd8\x35\xfe\xaf\xec\x3f\x2c\xd4\xf3\x9d\x4d\xde\xf0\xb3\x0a\x44\xc2\x82\xed\xc4\x3c\xef\x34\x7e\xb0\x1c\x6d\xe9\x4c\x08\x2d\xc3\x38\x9c\xa2\xef\x78\x30\x18\x38\xae\x89\x97\x1a\x2e\x97\x83\x7e\xe1\xe4\xde\xc3\x49\x6e\x91\x92\x1e\xcf\xde\x24\x17\xd7\xb0\xfd\x4e\x5d\xfc\xf6\xd0\x0e\x0b\x68\xd0\xe7\xf1\x79\x33\xc8\x02\xf6\xdc\xa4\x97\x11\x45\x27\xcc\xc1\x92\x03\xb3\xb9\xbb\x82\x4b\x62\x0a\x27\x8e\x6d\xc4\x99\x49\xec\x3c\x54\xab\xce\x94\x89\xc4\x41\xe1\xb0\xe9\x43\x98\x15\x77\x3c\x9c\xaa\x13\x7b\xcb\xfa\x49\x10\xf1\x99\x4e\x4a\xe2\x6e\xd1\xd4\x8e\xf9\xca\xab\x87\xf3\x8e\x03\xb0\xd3\x8e\x60\xd3\x4e\x1d\xfe\xc1\x03\x88\xbf\x51\x4e\x33\xf3\xa2\x79\x5d\x79\xf6\xc6\x9f\x09\xfe\xc4\x52\xf1\x63\xed\x1e\x10\x14\x2b\x70\xe4\xe9\xcc\xf9\xd9\x77\x3c\xa1\x31\xd2\xa8\x69\x5f\x43\xe3\x23\x38\x47\xfa\xe6\x86\x38\xfa\x59\x24\xbb\x9c\x56\x5b\xf5\x52\x4e\x01\xc3\x95\x3b\x9e\x3a\xd2\xcf\xa6\xf5\xec\x4a\x1d\x9b\xd0\x27\x2e\x42\x1c\xce\x44\xaf\xc7\x09\x34\x2f\x3a\x78\x1c\xb3\x10\x78\x78\x89\xec\x4e\xd6\xcd\xcc\x00\xb9\x90\xa2\x51\x14\x0b\xdb\x58\xf7\x96\xa5\x91\x42\xbd\x9d\xf7\xde\xa4\x67\xcc\x1f\x00\x5c\xbe\x18\xc6\x66\xc7\xdc\x12\x3b\x9d\x43\x62\x12\x04\x79\xbc\x00\x89\x5d\x3a\xee\x3e\xf3\x54\x31\x72\xa2\x5c\x16\x56\xfb\xc3\x59\x55\x71\x08\x60\x23\xc0\x7e\x84\x31\x3f\x29\xbd\x8f\xdc\xb4\x70\x2a\x3a\x3b\x18\xfd\x56\x85\xa0\xa1\xe4\xd2\x0c\xe3\xf6\x47\xab\xe1\xed\xc1\xc2\xad\xe0\x8b\x1c\x6f\x15\xb7\x77\xe5\xcc\x55\xf0\x41\x9f\xa9\x42\x59\x3f\x9e\xef\x11\x97\x15\x27\xca\xe2\x81\xa1\xb4\xf6\x58\x05\x9c\x59\x1a\xba\x0f\x87\xab\x2f\x5e\x90\xb5\xc6\xa4\x1f\xb6\x69\x86\xd3\xf8\xcb\x7e\xa6\xe9\x1f\x3c\x4e\x4a\xd7\x2a\x59\xc4\x5e\x64\xd9\xe8\xb8\x1b\xcb\x13\xe4\x0b\x0f\x04\xcf\xaf\x6e\xf3\xdc\xef\x34\xb3\x08\x42\xa6\x22\xdf\x66\xe1\x0f\x8d\x1d\x6b\x80\xa6\xbe\x32\x13\x12\x1a\x12\x12\x5f\x54\xec\x61\x54\x45\xc8\xc2\x37\xae\xd5\xfd\xf1\x00\x06\x34\x65\x1d\x35\x29\x63\xa5\xf9\x10\x0c\x70\xce\xbd\xdc\xcc\xb5\x14\x24\x36\xc7\xc7\x81\xe7\xb6\x97\x0f\x44\xe4\xce\x06\xbe\x44\xc6\x79\x75\x1c\xc0\x54\x7a\x13\x22\x9e\xca\x66\x0c\xdc\x23\x1e\xf8\x55\xf8\x2d\x1b\xf8\x8f\x58\x8b\x49\x8e\x6f\x11\x97\xbd\x34\xf3\x07\x6e\x46\xee\x20\x8d\xad\x5a\x87\x91\x2c\x0d\x9e\x98\x53\xf6\xf1\x32\xf3\xbb\xc2\xc3\xc4\x0e\x99\xbf\x70\x1f\xc1\xc8\x29\x8a\x92\x87\xb1\x29\x17\x48\x43\x40\xab\xc5\x84\x33\x40\x19\xcd\x11\xe7\x67\x59\x1c\x6b\xc8\x7a\xbd\x2f\x47\xea\x88\x35\x43\xb7\x65\x9e\x59\x52\x11\x44\x2a\x36\xf6\x16\x95\x7b\x70\xd2\x4c\xec\x04\xb0\x11\x11\x12\xe1\xde\x70\x04\x1a\xc3\x25\x78\xcb\xb1\x2a\x75\xbb\x45\xb6\x4f\x02\x61\xc3\x31\x68\xbf\x18\xc1\xfd\x6d\x28\x61\x6e\x2c\xc8\x90\xc8\x3a\xcd\x63\x87\xdb\x75\xe5\xf5\x8b\xb6\x29\x6b\x3c\x2c\xe7\xe1\x4c\x31\xe8\x71\x3e\xe3\x24\xaa\xc3\xe1\x85\x80\x6c\xb1\x92\xca\x4b\x34\x3a\xd7\xbb\xcd\x28\xf6\x70\x2e\x81\xe1\x63\xf6\xe6\x86\x98\xf9\x52\x86\x2c\x70\x29\x19\xe0\x49\xfe\xcc\x58\x0e\x23\x92\x64\xd3\x6d\x65\x62\x60\xfa\x5b\xa0\x9e\x2b\x2c\x3e\x31\x22\x15\x5a\x3d\x82\x1b\x93\xb9\x9b\x6d\x33\xec\xa0\x75\x70\x4d\x96\x75\x3e\xac\xb3\x60\xdb\x02\x01\x58\x0f\xa8\x82\x72\x97\x74\xf2\x59\xa8\xce\xa6\xd6\x69\xcd\xc7\xb2\x7d\x60\x4d\x15\x3a\x76\xf5\xae\xe7\x59\x0c\x2c\x1d\xe7\xf2\xd8\xb0\xdc\xaf\x95\x46\x1b\xc4\x31\x9d\x41\xa4\x79\x6a\x31\x26\xa1\xfd\x5c\x35\xa0\x2e\x9a\x70\x02\x25\xe7\x13\x66\xc4\x98\x17\x40\x9d\x79\x18\x5f\xfb\xe9\xcb\xc6\x26\xf5\x1c\x4e\x4c\x15\x38\x88\xec\x81\x11\x5a\x1d\x0e\x02\x42\x61\x30\x93\xca\x9c\xb7\x30\x8c\x88\x21\xd1\x10\x3f\xc4\x02\x51\xd6\xf0\xfb\x5d\x7d\x74\x36\x99\xa5\x3f\x6c\xc6\xf7\x43\x98\xc8\x68\xf9\x33\x02\x44\x42\x24\xe7\x58\x32\x3c\xfa\x6f\x74\xb6\x34\xf0\x62\x73\xb6\x88\xc1\x8c\x53\x9f\x99\xeb\xea\x17\x7f\x80\x43\x3f\xbd\xd4\x9f\x5f\xea\xaf\x27\x08\x56\xc3\x67\x6f\x7e\xc3\xde\x3f\x76\xda\xe3\x97\x57\xac\x61\x1c\xb2\x48\x48\x9b\xcd\xbd\xa7\x79\xc2\x11\x86\x7c\x29\x78\xc2\xd1\x76\x10\xdb\x50\x29\x25\x12\x68\xed\x40\xcd\x77\x2d\x3c\x60\x1a\x69\x4f\xa1\x6f\xaf\xc3\x3c\x84\x6c\xa7\x54\x3c\xe8\x31\x15\x5d\x97\x7e\x4e\x19\x14\x4c\x21\xee\x1e\x27\xa6\xe2\x8d\x82\xc7\xc0\x07\xa6\x12\x07\x04\x76\x3b\x46\x5a\xb3\x66\xfe\x7b\x14\xfa\xce\x18\x60\x2a\x50\x6a\x30\x1f\x98\x6d\x01\xeb\x48\xe3\xa7\x3c\x03\x08\x2f\xae\x77\xc4\x33\xf5\xd0\x8d\xca\x32\x2c\xb6\x01\x58\x57\xb4\x53\xb8\x33\x33\x11\x42\x2d\x03\x9a\x36\xc2\xfd\x87\x16\x38\x04\x86\xd8\x30\x43\xa7\xf0\xe5\xcc\x7f\xec\x80\x4f\x67\x09\x07\xad\x25\xfe\x42\x24\xef\x81\x55\x5f\xe8\xa0\x03\x2c\xd7\xd8\x3a\x9c\x61\xe3\xf9\x49\xb2\xc2\xb2\x59\x5f\xb7\x3d\x35\xe7\xe7\x28\x8a\x0e\x63\x6b\xe8\x9e\xf0\xf0\xca\x2d\x65\x37\xf6\x9d\x9d\x27\x19\x71\x06\x3f\xbd\x12\x8c\x1c\xd1\x45\x4a\x86\x32\x9b\xc7\x1d\x4f\x95\x00\x67\x8e\xdf\xa2\x18\x2a\x64\x87\x68\x77\x46\x7a\xcb\x2a\x1d\xa7\xe7\x97\xd7\x1a\xe9\x13\x7d\xb2\xb7\xd4\x19\xbf\xa8\xd1\x58\x80\x4d\x62\xa4\xa6\x6a\x88\x78\x5b\xed\x67\x29\xc8\x2f\x62\x02\x9f\x2a\x74\xdb\xd4\xe0\x66\x64\xec\x1b\x66\x8b\x96\xc6\x8d\x52\x58\x9b\x1f\xe6\x00\x02\xf4\x8e\xc9\x15\x2d\xf5\x99\x11\xd1\x31\xfd\x71\x98\xfd\x11\x0e\xec\xdb\x28\x4e\x0f\x8c\x1a\x40\x55\x9d\x2e\x86\x5d\x3a\xbe\x26\x36\x82\x1e\xc9\x9a\xe7\x88\x8f\x0f\x3a\x2e\x1d\x96\x2c\xf9\x61\xe2\xb0\x5f\x33\x4b\x52\x84\xc1\xd9\xd4\x6d\x34\x80\x75\x88\xb1\x2c\xeb\x81\x88\x0d\xdb\x8c\x02\x75\x0c\x2b\x73\xb1\x1f\xb6\x31\x8d\x61\x4f\x93\xdd\xae\xa2\xb6\x43\x7c\xbf\x54\x33\x91\xc9\x37\xb9\xe7\x26\xed\x55\xf7\x64\x11\x71\x6a\xaa\x6e\x59\x9b\x5c\x29\x05\x07\xe5\xa7\x18\x08\xc5\x92\x6e\x4f\x05\xb3\x11\xae\x27\xa7\x86\xc3\xd1\x41\x60\x39\x84\xcd\x3f\xc4\x8b\x2d\x3d\x9f\x51\x80\xb3\xa9\xaf\x48\x89\xb1\x61\xfe\x4b\xea\x9e\x40\xb1\x9f\xb4\x2b\x25\xa9\xc6\xf7\xc1\x14\x65\xf0\x7c\x81\xef\x67\x23\xf9\x57\xcd\x5d\xfe\xed\xd3\x97\xcf\x37\x86\x37\x21\x36\xa5\x9b\x45\xab\x07\x68\x25\x88\x76\x76\x53\x8d\x76\x0b\x1e\x1f\xb0\xac\x0c\xe9\x40\x94\xe7\x1c\x96\x29\x35\x1e\xe4\xc2\x9e\x61\x34\x78\xa2\x1d\x2d\x12\x52\x14\x2a\x1a\xe0\xe5\xae\xbb\x90\x64\xb7\x74\x98\x7e\x9e\x8d\x9f\x6f\x05\xb2\xc3\x72\xca\x4c\x06\x03\x9f\x6a\x67\x66\xc8\xc1\x1a\x7e\x59\x3a\x91\x1c\xa7\xaf\xbb\x78\x38\x7b\xb6\x72\x18\xa9\x56\x5b\x98\x9f\xce\x87\xc1\x9f\xf5\x9d\x06\xcd\x88\x2d\xd4\xfb\xf8\x38\x2d\xbd\xdc\xf8\x09\x5d\x01\xe9\x87\x04\x55\xd2\xdc\xdb\xad\x69\x6e\x47\x01\x73\x7c\x2e\xe0\x80\x29\x99\x7c\x58\xb2\xbb\x36\xc7\xc3\xe4\x70\x8a\xd4\x43\x5b\x1b\x76\xef\x03\x72\xa9\xa4\xd8\x18\xc8\x34\x8e\x9f\x3a\x86\x19\x5e\x27\xfa\x8c\xc6\xb0\xaf\xa9\xc0\xca\xe3\x7c\x86\x6f\x0c\xd4\xce\xb1\x93\x9a\x40\xad\x59\xb2\xdb\xe1\xe4\x8f\x89\xa5\x0b\x76\x1a\xc3\xa5\x5d\x08\x27\xb5\x7b\xe0\x28\xf8\x87\x0a\xad\xf5\x1d\x3f\x05\x5b\x29\x90\xb1\x23\x2c\x69\xe0\xc7\x75\xa2\xa2\xf8\x62\xf6\xb5\x14\xdf\x62\xbb\x10\xa6\x94\x04\x21\xd3\x48\xfd\x3b\x8c\xed\x47\xb0\xd6\xd2\x11\xfa\x0e\xbc\xee\x3e\xb2\xea\xe4\xb2\xef\x14\x16\x7a\xd9\x4a\xde\xe3\x39\x8c\x67\xc9\x5d\xc8\x4c\xad\xbf\xa6\xea\x8b\x4d\xb5\xa0\xe4\xa3\xc0\x3e\xba\x9c\x57\x14\x52\x77\x5a\xae\x66\x7c\x38\x4d\xcf\xae\xb0\x55\xe6\x8a\xe9\x4c\x56\x8d\x01\x9f\x6c\xa8\xb4\xe7\x54\x83\x60\xf2\x9a\x3e\x24\xd9\x80\x8c\x75\x13\x78\x22\xea\x61\x20\x44\x3f\x0f\x77\x9e\x74\xa1\x5a\x4c\xef\x17\x8b\x19\x43\x2d\x8f\xbd\xa1\x29\x13\x58\x80\x5d\xa0\xfb\xa8\xde\x6c\xab\x42\xe6\x87\xe2\x13\xbb\xd2\x31\x1d\xee\xea\xb6\x17\xbb\x81\xd6\x1d\xce\x38\x82\x4d\x8a\xb4\x49\xb5\xab\xd2\x94\x62\x55\x1e\x74\x9b\x81\xa3\xb2\x06\xdc\x63\x9a\x04\xbd\x41\x30\x03\x6f\x80\x07\x23\x27\x00\x08\x27\x1c\x38\xe0\x8c\x64\x58\x03\x4c\x2a\xaf\x13\x2f\x77\x36\xbf\xa0\x5e\x62\x29\xf0\x16\x8e\x71\x21\xd9\xc7\x53\xb2\xc0\x3b\x53\xf5\x54\x74\x80\xa8\x4e\x6f\x34\x98\x3e\x52\xa8\xc2\xe4\x87\x9c\x5e\xca\x32\xd8\xf1\x64\xeb\xe0\x27\x4f\x53\x0e\x90\x80\xf0\xd0\xeb\x93\xc2\xe6\x32\x43\xa5\xc6\xc3\xf3\x73\x1f\xaf\x58\xee\x2c\x53\x95\xa7\x9b\xf9\x45\x5f\x68\xba\x09\x3f\xfc\x51\x9a\x63\x2f\x33\x22\x5f\x9c\x19\xac\x0f\xa9\x4d\x1c\x29\xe6\xd7\x36\xb4\x3d\x89\x43\x92\xcf\x1c\xce\xd7\x57\x76\x62\x55\x1c\x3c\x4b\x26\x24\xd0\xf3\x42\xee\x10\x17\x16\x43\x66\xba\xa2\x84\xef\xb0\x39\x32\x85\x80\x21\xaa\x5b\x19\x1c\x6e\xc3\xfd\x37\xb0\x51\x86\x9d\x11\x10\x8f\x29\x28\x97\xd6\xf7\x49\xb1\x69\x29\xd2\x1d\xa0\xca\x83\x45\x1b\x40\x12\x4a\x9a\x61\xde\x23\x95\x6e\xe7\xdd\xa7\x61\x38\x8a\x23\x96\x1c\xa0\xeb\x9d\x0f\x2c\xc3\x38\x19\x4f\x74\x95\x25\x0f\x33\xef\x87\xca\x94\x3c\x97\xde\x24\x30\x03\x60\xb4\xc3\x91\x0f\xd4\xd0\xfd\xf2\xfc\xfc\xa5\xb8\xbc\x30\xf6\x25\xdb\x8d\x3d\x26\x9b\xdd\x01\xf4\xe3\x30\xd9\xf6\x30\x7f\xd8\x89\xb5\x31\x84\xf7\xce\x7e\x11\xfe\x4e\x84\x17\x5d\xce\xd7\xb4\xce\xe3\xe4\x29\x4e\xd8\x92\xcb\x81\xc3\x80\x83\x8d\xc4\x75\x47\xe0\x9b\x88\x2b\x1a\x2c\x5e\x45\xa7\x53\x4f\x7a\x0d\xa7\x74\x16\x49\xd6\x80\xd5\xf0\xd4\x8c\x72\x89\xff\x50\x55\xda\x0a\x21\x67\x92\x3d\x6f\x05\xb7\x1f\x61\xc6\x70\x66\xbc\x08\x29\x59\x7f\x46\xca\xf2\xcf\x28\xab\x2d\x9e\x77\x97\x37\xa1\xb7\x8a\x48\x11\xa2\x05\xaf\xe3\x3a\x7e\x12\x65\xce\x82\x87\xd3\xf9\x53\x9a\x9f\x4a\x81\xf1\xc8\x36\x7e\x24\x15\xca\xcf\xdf\xff\x65\x86\x76\xb1\x9a\xc0\x7e\xfc\x46\x61\x61\xad\x6d\x77\xab\xe7\xe8\xda\xcc\x35\x1c\xcb\x5b\x34\xdf\x9e\xf9\x8f\x59\x3e\xaa\xd6\xb8\x0a\x5b\xbc\xed\x8a\x18\xf1\x61\x0c\x44\x0b\xb1\x98\xfb\xbb\x16\x49\xb3\xb3\x74\x40\x8e\x30\xdf\x5d\xbe\xa2\xdb\x18\x79\x8d\xf1\xfa\x71\xde\x10\x7d\xea\x03\x5b\xe4\x71\xd2\x5d\x38\x54\x2d\x0d\xb0\x27\x9c\x7b\x98\x75\x78\x0c\x07\x1e\x46\x07\x85\x71\xe7\x78\x8e\xfc\x07\x80\xf9\x6f\x23\xce\x6f\x9c\x16\x74\x0c\x64\x1e\x67\xe3\x4f\x17\x9a\xfe\x77\x25\x0a\x1d\x1b\x6f\x8d\x26\xe7\xc8\x3a\xb8\x9c\x0b\xe8\x2e\x63\xe2\x94\x4a\xf3\x1c\xc9\xa5\x48\x4d\x69\x1f\x3e\xa3\xf1\x30\x3f\x29\x05\x4c\x0f\x27\x29\x3f\xf4\xed\x0c\x3a\x4d\x69\x60\xc2\x1a\x2b\x6e\x87\x17\xa9\x81\x29\x72\xf1\xe3\x6f\xef\x34\x1d\xeb\x63\xa3\x55\xdc\xb0\x23\x2b\x9a\xd5\xf2\x52\x58\x8a\xd5\xcc\x50\x65\xc3\x57\xbc\xea\xf8\x30\xc5\xfb\xef\xb5\x90\x7f\x28\xae\xc6\x33\x08\x27\x96\x65\xbc\x98\x1b\xe7\x4c\x3e\x72\x00\x61\x2d\x41\x59\x69\x93\x3f\x54\xdb\xd2\x57\xa4\xd3\x59\x42\x03\x7a\x38\x9a\x9b\x50\xce\x87\x51\xf0\xf9\xae\x62\xbe\x0a\x8f\x73\x19\x26\x33\x1d\xb2\x3c\xca\x4f\x8c\x0e\x05\xb0\x69\x50\x37\xcd\x66\x9a\xdf\xf1\x50\x72\xb1\x4d\x7b\x39\xb8\x21\x9a\xbf\xe0\xdc\x63\xae\x8a\xb2\xea\x66\x46\x8e\x04\xd9\x40\x65\x87\x86\xf4\x75\x08\x75\x82\x2c\x86\x68\x54\x67\xa3\x7a\x76\x5a\xb5\x91\x76\xad\xb1\x95\x05\x27\xb0\x92\x33\x87\x98\x33\xb2\x66\xca\xa3\x9e\x35\x5c\x59\x37\xa5\xdd\x36\x7c\x7d\x67\x87\x1c\xa9\x77\x0b\x9b\xa2\x08\xe0\x16\x49\x33\xa6\x7f\x18\x04\xc0\xcb\xd4\x60\x2d\x34\xc4\x22\xf9\x51\x42\xe2\xe0\xc8\x62\x08\xfc\xb2\x9e\x75\x37\xe1\x2f\xc0\xdf\xb1\x19\xdf\x69\x52\x7d\x31\x03\x78\xd2\xee\xd6\x30\xe2\x71\x4c\xe6\x53\x80\xb9\x75\xe2\x10\x93\x84\x9c\xb7\x5c\x8e\x1b\x9e\x0e\x83\xc9\x06\x01\x56\x41\x2c\x92\x5a\x4b\x30\xe1\xf6\xd3\xa7\x32\xff\x54\x86\xbe\xc7\xa5\x7f\x67\x35\x06\x9c\xe1\x18\x92\xa4\x69\x8c\x62\x83\xa9\x49\x16\x97\xce\xdb\x71\x2c\xb8\x59\xd8\x74\x52\xbc\xfe\x9d\xc9\xf5\xbb\xae\x30\x4d\x95\xcf\x66\x70\xb0\x08\x15\x96\xae\x29\x9b\xeb\xb7\xc5\x85\x64\x5c\x97\x2e\xe7\xe5\xd0\x1e\x3a\x7c\x91\x12\x10\xda\x52\x07\xb5\xe2\x4f\x99\x10\x19\x9e\xb7\x0a\xa7\x5f\x81\x5b\xe2\x24\x46\x80\x56\x12\x07\x6b\xb3\xe9\x83\x5d\x2b\xbf\xbe\x25\xeb\x8b\xcc\xd8\x1d\x1b\x8d\x0a\x18\x60\x82\xa1\xb4\x64\x67\x83\xe2\x18\x63\xca\xb1\xef\x66\x18\x89\xd7\x49\xd1\x5a\x9c\x07\x83\xa9\x49\xe6\x1d\x90\xef\x44\x84\xff\x56\x59\x3c\x4b\x76\x23\x92\x41\x39\x75\x61\x63\x0d\x69\xf7\x36\x4e\x97\x28\x77\x83\xb1\xb6\x62\xac\xd5\xea\xc0\xd7\x84\x08\xd7\x97\xb0\x09\x8f\xf0\x45\x60\xc7\xbb\xc6\x21\x5c\x13\x8c\x2c\xa3\xb1\x57\x7e\x5d\x0f\xbf\xa8\x17\x81\x55\xe5\x84\xe7\x4d\xaf\xdf\xcb\x23\xb4\x70\xa5\x9e\xb2\x8f\x56\xec\x2f\xd4\xd9\xa3\xbc\xb3\xb0\x1b\x15\x32\x15\x72\x09\x29\x9e\xbf\x92\x78\xdc\x12\x63\xef\x97\x97\x92\xde\x8c\x4d\x67\x0b\xa0\x90\xd6\x3e\x74\xfc\x06\x96\x24\xc6\x3e\x2d\x4b\xcf\xaf\xb2\xbd\xb4\xdc\x46\x42\xc8\x08\xd1\x6f\x18\x8e\x4f\x5a\x84\x19\x97\x0a\x91\x0b\x9d\x7a\x8c\x8c\x7d\x3e\x29\x7d\x30\xbe\x17\x9a\xd9\x4a\x64\x97\x33\xe3\x02\xff\x47\x61\x67\xd1\xf1\x48\x7e\x25\x9c\x65\xa3\xdd\x3a\xbf\x74\x19\xe1\x61\x7f\x3d\x8e\x37\x5f\x28\xcc\x31\x93\x9f\xe9\x14\x86\x0e\x82\x20\xca\xd2\xdf\x8d\xb9\x53\x4f\xa3\xc2\xd1\x61\x20\xf3\xdb\x6e\x8a\x85\xba\xc8\xae\x3a\x06\x2c\x87\x53\x03\x60\x49\xe3\x92\x57\x56\x96\x1a\x28\xa0\xce\xae\xd3\x33\xd6\x5d\x59\x11\x32\xb4\x54\xb2\x06\xf5\xd4\x01\x90\xc3\x85\x15\x6c\xe0\xc6\x85\x1b\x0b\x5b\xbc\x0b\x39\xc4\xb5\x18\x81\x70\xec\x98\x0d\x75\x88\x02\xdf\xf1\x2b\xd8\xd1\xe4\xe8\xd9\xb0\xc1\x05\xf9\x3e\x44\x3c\x0c\xc7\xd1\x14\x1d\x9f\x9b\x69\xab\xc5\x15\x1c\x9c\xe5\xfd\xe3\x1f\x9c\x1e\x3a\x3c\x00\xb2\x4b\x2a\x94\x90\x3d\x5d\x43\xb5\x8d\xa5\x29\x02\xce\xc5\x4a\x6d\x1c\x7e\x73\x18\x84\x09\x41\xa8\x09\x45\x36\x76\x62\x66\x61\x03\xf3\xa2\x6e\x4c\xd8\x0a\x32\xca\x68\xa5\x53\x0d\x24\xaa\x51\x59\x55\xb3\xe1\x14\x64\xd0\xbd\xe9\x30\x70\x35\xc2\x85\x43\xba\xb4\xf7\xda\x0e\x6c\x6e\x08\x86\x32\xb0\x5e\x1b\x71\xc5\xec\xbe\x48\x6c\x7a\x3e\x18\xfa\x03\xfc\x4f\xa9\xf7\xc2\x7e\x88\x07\xb3\x01\xbe\x25\xef\x00\x5a\x8b\xc3\x58\xf3\x4b\x08\x83\xeb\xcf\x6f\xf5\xe9\xf5\x6b\xfd\x92\xe6\x11\x87\x08\x04\xec\xe9\x72\x24\x53\x83\x0c\xcd\xdc\x56\x0a\x4f\x6b\x1a\x24\x8e\x6c\x9c\xc0\x03\x66\x89\x89\x1f\x69\x92\x87\x87\x75\x82\xfb\x82\x67\x67\x5e\x3e\xd3\x70\xed\x43\x93\x96\x1b\xa5\xad\xb0\xd6\xa5\xc9\x28\x83\x07\x83\x6d\x6d\xa9\x0f\xb4\x75\x87\x19\x70\xf3\x2e\x3c\x84\x68\x9c\xd2\x86\x4b\x50\x2c\x1e\x65\xec\x1d\x9d\x94\x87\x0c\x62\xcd\x3b\x52\x43\xa4\x99\x86\x13\xa7\x5c\xd9\x09\x90\x34\x3d\x02\x6b\xa9\xcd\x70\x43\xe5\xe1\xe4\x79\x17\xad\x0f\x75\x98\x34\x62\x48\x0c\x44\x81\xec\x3c\x08\xec\x48\x41\x93\x69\xc2\xf4\xb4\x43\x22\x27\xbc\x48\xfd\xb3\x39\x68\xdc\x2d\x9e\x7e\x49\x07\xcb\x48\x29\x2e\xa0\x91\xa7\x67\x32\xce\xce\x4d\xaa\xd1\x7d\x8b\x4c\x28\x22\x15\xb4\xf3\x7c\xfc\x31\x13\xbf\x0e\xa5\x8e\x90\x05\x39\x4c\xe4\x38\x86\xb3\xba\x73\x29\x1e\x02\xa5\xab\x36\x85\x55\xc9\x82\x34\x0e\xaf\x30\x6a\x0f\xb1\x75\x51\x30\x39\x2e\xf1\x50\xeb\x62\xd3\x2e\x38\x2d\x8d\xd2\x12\x4d\x0a\x15\xb3\xdb\xb2\x0f\x85\x45\xac\x7a\xa3\xc5\x2d\xfa\xc5\x5f\x68\x6b\xd8\xa8\x81\x58\xa1\x4a\x96\x77\xf4\x57\x08\x09\xf0\x0c\x8d\x22\x02\x0e\x16\xf2\xf0\x65\xf8\x1b\xda\xc7\x30\x93\xa8\x63\xd3\x95\xe9\x37\xf9\xde\x5b\xed\xe6\x9d\x4f\x9f\x2a\x1d\x5f\xc7\xce\x9c\x5b\x2b\x2a\x66\x5c\x0a\xe6\x1d\x01\x5d\x1a\x4c\x29\x0b\xca\x0e\xe8\x57\x7f\xc4\x65\x10\x1a\x62\xf4\xcd\x93\xbe\x18\x0e\xa6\x54\xe0\x37\x27\xcd\x36\xcd\x58\xc8\x64\xeb\xb0\xf0\x06\x2a\x9c\x9c\xb3\xf4\xbb\x5f\x72\x73\xc3\x61\xda\x4a\x8f\x3d\x1c\xc7\xee\x88\x0b\xc2\xb8\xf2\xf1\x78\xd8\xd4\x9c\x3f\x9c\x5f\x96\x26\x8c\x60\xae\xf3\x72\xca\xd0\xdb\x68\x1f\x1a\x00\xaa\x0f\xbf\xef\x2d\xba\x82\x1b\xef\x1e\x39\xa6\x40\x42\x0c\x50\xcd\x88\xbe\x18\x9f\x5f\x86\x73\x03\xb2\xd7\x43\xcf\x8a\x56\x96\xba\x6a\xcf\x38\xd9\x5d\x1f\x47\x9d\x88\x8a\x1f\x6f\x84\x53\xdf\xe0\xab\xa5\x5a\x69\x30\x38\xc0\x10\x1b\x23\x5d\x90\x2c\x5e\xf1\xc2\xe9\xfd\xe5\x52\x5b\x1b\xc6\xf8\x16\x87\xaa\xc4\x1c\xda\x75\x28\x07\xc6\x28\x51\x91\xa5\x51\x5c\xe7\x56\x63\xa1\xb3\x0f\xff\x60\x2c\xd8\x52\x7e\x50\x3b\x5d\x34\x43\x1e\x26\x67\xf4\xb6\x7a\x3c\x1f\x3c\x48\x07\x42\x1a\xea\x9a\x47\x96\x8e\xd7\x61\x8c\x24\x71\xee\xcc\x3d\x97\x9d\xe6\x1d\x09\xe9\x94\x17\x40\x8a\x03\xeb\x3a\xc9\x9d\x13\x93\x5a\x96\x41\x4f\x8e\xf7\x8d\xa1\x2b\xc2\xe1\x16\xce\xb7\x87\x50\x05\x5b\xa4\x8e\x88\x2c\x00\xcf\xc8\x36\x38\xb8\x29\xd5\x1e\x5a\x3a\x7a\x61\x38\x05\xaa\x5d\xec\x4b\xcf\x88\x1e\x2b\x18\x34\x4c\x73\xf3\x53\xbc\xdf\xc1\xa7\x8c\x5e\xb1\x90\x6b\xe9\xd5\xcc\x02\x90\x0b\x84\x45\xfc\x8c\x35\x55\x61\xa6\x0e\x9b\x0d\x69\x16\x18\x26\xad\xf9\x98\x4d\x1c\x2e\x2d\xbf\x39\x20\x4a\xd8\x31\xe7\xe7\xf2\x03\x07\xf2\x09\x76\x2e\xc0\x1a\xb3\xc1\x1e\xbb\x66\xa7\xa5\x82\x6b\xba\x28\xe6\xe6\x9a\x20\xcb\xd1\xd0\x22\x3f\x67\x9c\x24\x99\x14\xc0\x96\x3d\x76\xd3\xd4\x81\xb7\x17\x99\x41\x64\x68\x43\x91\x19\x5c\x42\x55\xe6\xa1\xf1\x79\x79\x29\x25\x8b\xf9\x9a\x46\x3f\x9c\x26\xdc\x60\xca\x8a\x77\xe7\x06\xe7\x78\xe3\xa7\xd4\xc7\xa4\x10\x81\x66\xb0\x66\x72\x3f\x50\x1e\xb1\xf3\x74\x61\xbe\xed\xf4\xd3\x27\x5a\xeb\x3c\x81\x73\x66\x87\x1a\xe1\x5e\x30\xae\xdd\x63\xd6\x11\x39\x06\x20\x28\x6c\x34\x73\x68\x36\x14\x74\x8a\x25\xb6\x30\x51\x69\xee\xbc\x9a\xf5\x78\xe0\x94\x69\x14\xbb\x3d\xf8\x70\x34\x96\x69\x64\x4b\x83\xd3\x63\xc2\xf2\xd0\x40\x44\xb3\x0b\x76\x16\x8c\x0f\xc0\xaf\x5e\xf0\x88\x9b\xc5\x18\x76\xc4\x53\xb3\xa7\x63\x0f\xca\xad\xe0\xf1\xde\x80\x19\xd6\x41\xb3\xd2\x07\xb2\xe6\x0a\x85\x46\xcf\x26\x76\x3a\xe6\xfd\x92\xbe\x7a\x50\x86\xe5\x2c\xa4\x6b\x58\xe9\xbb\xef\x75\xf9\x23\x2a\x23\xee\x00\xcf\xd1\xa3\x3a\x83\x4d\x18\x91\x29\xd5\x97\x51\xff\x2d\x4e\x79\xcd\x9c\xb4\x7e\x36\x77\x03\x1e\x40\xdf\x35\xe7\xcb\x5e\x5d\x77\x3c\x03\xed\x54\x95\xe6\x41\x14\x82\xbf\xfc\xfa\xbb\x27\xf8\x0f\x16\xf4\xe9\x07\xfd\xf4\x9b\xa7\xe7\x5f\x3f\xe9\xa7\xff\xfc\x24\x6a\x50\xf7\xde\x0d\xf0\xea\x97\xbf\xfc\xf1\xe9\x5f\xc3\x9b\xf0\x3f\xdd\x3d\xfd\x00\x5a\xf9\xdb\xc0\x48\xf9\x9d\xfd\x61\xf8\xd5\x93\xf8\x93\xfe\xcb\x8f\xfc\x3d\xf8\xdf\xa6\x10\x3e\x3c\x7d\xff\xf3\xcf\xff\xf4\xc7\x3f\xfc\xf3\xbf\xfc\xfc\xf3\xf7\xe5\xcf\x7f\xfb\x8e\xfe\xcd\x6f\xfb\xed\xef\xfe\xf8\x8f\xff\xf0\x3f\xbf\xff\xee\x6f\xdf\xfd\xbf\x01\x00\x00\xff\xff\x5d\x73\xb6\x7a\x94\xc3\x00\x00")
+var _pacTpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x55\x6d\x6f\xe2\x38\x10\xfe\x9e\x5f\x31\x8b\x4e\x95\xb3\x40\x02\x77\xfd\x70\x82\xcd\xad\x56\x2d\xbd\xe3\xae\xbb\x20\x68\x75\x5d\x01\x45\x6e\x32\x69\x7c\x6b\xec\xc8\x76\x78\xb9\x2b\xff\xfd\xe4\xbc\x40\x8a\xba\xfd\xd4\x95\x11\x49\x3c\x9e\x99\xc7\xcf\x33\x63\xaf\xa9\x02\x95\x71\xd4\x10\xc0\x72\x39\xb9\xbd\x1e\x4c\x97\xcb\xbe\xe3\xf8\x3e\x5c\x8e\x3e\x7f\x1a\x7e\x69\x4f\x06\xbf\x0f\xee\x60\x4d\x79\x86\x1a\xa8\x42\x98\x0c\x7e\x6e\x81\x49\x10\xa4\x40\x0d\x7f\xd2\x35\x9d\x86\x8a\xa5\x06\x42\x2a\x84\x34\x10\xca\x55\xca\x38\xe6\x6b\x39\xc6\x06\x64\x66\x1c\x12\x67\x22\x34\x4c\x0a\xe2\xc2\x7f\x0e\x00\x80\xcd\xcc\x5a\xa0\xfa\xf9\x57\x2c\x15\x10\x06\x01\x74\xfa\xc0\xe0\x43\x81\xc9\xe3\x28\x1e\x4d\xd2\x07\xd6\x6c\x56\x6e\x76\x28\x08\x8a\x05\x33\xb6\xe8\x1f\x66\x59\x0c\x44\x79\x06\x82\x20\x80\x46\x1d\x7c\xa3\xee\x6b\x87\x51\xbb\x93\x19\xfb\x53\x9e\x42\x08\x40\xe0\x06\x26\xf8\x38\xd8\xa6\x44\x79\xeb\x59\x67\xe1\x1e\x53\xd8\xb1\x87\x90\x9a\x30\x01\x82\xee\x2b\x41\x32\xce\x4f\xdc\x0e\x5f\xc5\xdb\xde\xd9\xbb\xc4\x2d\x98\x66\x62\x9a\xc5\x31\xdb\xa2\x06\x83\x9c\x6b\xd8\x24\x68\x12\x54\x90\x80\x54\x96\x66\x90\x31\x30\xa3\x21\xa5\x0a\x85\x81\x48\xae\x28\x13\x1a\x98\x06\x0a\xdf\x70\x67\xcd\xda\xa9\x45\x09\xe0\x40\x77\xd2\x02\x5d\x01\xcd\x29\xaf\xf1\xdd\xef\xd7\xb7\x60\xf9\x1b\x3d\xfc\x83\xa1\xf1\x52\x25\x8d\x34\xbb\x14\xbd\x84\xea\xd1\x46\x8c\x95\x4c\x51\x99\x9d\x17\x52\xce\x89\x6e\x41\xe2\xd6\x3d\xed\x50\x68\x32\x25\xc0\xa8\x0c\x0f\xf3\xfb\xc3\x9b\x55\x36\xf1\x98\x88\x70\x3b\x8a\x49\xc3\x6b\xd4\x48\xb5\x79\xad\xe4\x9d\xef\x84\x8c\x29\xd7\x2f\xc5\x4c\xf2\x98\x3a\x7b\xd0\x46\x31\xf1\x48\x18\x34\xa1\x5b\xc6\xdd\x3b\x7b\xc7\xc9\x14\x1f\x4b\x65\xea\x6c\x64\x55\x0e\x4b\xc5\x0a\x02\xf0\xef\xc9\x8c\xb6\xff\x5d\x34\xdd\xde\xdc\x9f\xfb\x64\x3e\x9b\xdd\xcf\x17\x8b\xf7\xf3\xc5\xd3\xec\x7e\xee\xf7\x16\xef\x5d\xd2\x23\xf3\xa8\xe9\xba\x1f\x7d\xe6\xe1\x16\x43\x92\x95\x49\x2c\xf0\x15\x9c\x9d\xc1\x6a\x76\xbe\xa8\x83\x2f\x81\xa7\x54\x69\x1c\x0a\x43\xac\xbd\x05\xdd\x8e\x5b\x62\xab\xad\x29\xfd\xbb\x0b\xcf\xc8\x6b\xb9\x41\x75\x41\x35\x12\xb7\x28\xe2\xc4\x98\x54\x37\xe0\x23\x9c\x9f\xff\x02\x3d\xf8\xb5\x63\x77\x55\xed\x05\xae\x98\x88\xc6\x4a\x6e\x77\x57\x52\xdd\x4e\xae\x49\x66\x65\x29\x51\x14\xdc\x3c\x0b\xd9\x3f\x96\x80\x1e\x8e\xd7\xe7\xf9\xe6\xe7\x51\x73\xee\x3d\xfb\xfb\xc9\xf7\x0c\x6a\x43\x92\x13\x07\x08\x2a\xbf\xa7\xa7\xba\x94\xbd\x86\x0b\xef\x82\x00\xda\xdd\xe3\xfa\xb4\x60\xbd\xe4\xff\xc0\xd7\x9b\x77\xbc\xde\xb0\xbc\x13\x95\x67\xea\x8b\x43\xaa\xb1\x3a\x01\x1a\xbd\xc3\x74\x25\xd9\x3b\xbb\x0f\x4b\x7b\x92\xb3\x5c\x36\x79\xcd\xff\x44\x46\xe5\xd1\x67\xa6\x63\x05\xda\xf1\xa0\x90\x7e\xeb\xbf\x98\xbb\x3d\xbd\xbd\xba\x1a\xde\xbd\x06\xe1\xd8\xb3\x24\x69\x81\xf2\xb4\xfb\x43\x80\xfc\x35\xf8\xfa\xf7\x68\x72\xf9\x2a\x19\x07\x49\x2b\x42\x0a\x55\x7f\x08\x9e\xfc\x4e\x79\x0d\x4d\x7e\x8c\x96\xcf\xaa\x1e\xdf\x16\xc9\x70\xdc\xbe\x18\x5e\x4e\x4e\x40\xf8\xbe\xad\x72\xf1\x05\x0d\x6c\x64\xc6\x23\x50\xa8\x25\x5f\x23\x08\xba\x42\xdd\x02\x29\xf8\x0e\x38\x33\xa8\x28\x07\x1a\x45\x0a\xb5\x2e\xaf\xc5\x95\xbd\x15\x30\x7a\x16\xce\x96\x5b\xd9\x35\x67\x67\x55\xe4\x42\x69\xcb\xb1\x7d\xae\xde\x5a\xf1\xe9\x4d\x7b\x3c\x9a\xdc\xbc\xc0\x6e\xde\x96\xbf\x05\xc7\x73\xa9\x42\xd1\xed\xb8\x96\xf4\xdc\xfe\xe1\xc4\xde\x2d\xec\x6f\x0b\xf2\xf3\xa7\x9b\x8b\x3f\x4e\x10\xbe\x10\xad\xba\x29\x6b\xe6\xc6\x72\x39\x9e\x8c\xee\xbe\x2e\x97\x0d\x67\xef\xfc\x3f\x00\x41\x47\x00\xde\xbe\x08\x00\x00")
 
 func pacTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -164,7 +165,27 @@ func pacTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "pac.tpl", size: 50068, mode: os.FileMode(420), modTime: time.Unix(1484189894, 0)}
+	info := bindataFileInfo{name: "pac.tpl", size: 2238, mode: os.FileMode(420), modTime: time.Unix(1792213224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _rulesTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\xbd\x6d\xaf\x1c\xcb\xae\x1e\xf6\xdd\xbf\x62\x03\x0e\x90\xc4\xd8\xd3\x59\x6b\x49\x5b\x5b\xca\x97\xe0\xc6\xbe\x0e\x0e\x90\xd8\x86\x63\x07\x0e\x72\x12\x80\x5d\xc5\xee\xe2\xea\x7a\x53\xbd\x4c\x4f\x4f\x9c\xff\x1e\xb0\x66\x96\x74\x8e\xef\x90\xa3\xe4\x5e\xe8\x5e\x6d\x75\x4d\x77\x75\x35\x8b\x45\x3e\x7c\x48\xfe\xf3\xdf\xfe\xc7\x4e\xbe\x9d\x28\xfe\x56\x52\x6f\x14\xd7\xdf\x4a\xf7\x58\x7f\xff\x0d\x96\x86\xe5\xb7\xe6\xf0\x37\x9f\x0c\xf8\xdf\x22\xb6\x3d\x95\xad\xfe\x3e\xfe\xad\x57\x2c\xff\x75\xbd\x8d\xfd\x0d\xa2\xe5\x7f\xfc\x67\xff\xfc\x37\xd3\x6b\x4b\xe1\x37\x9b\x02\x50\xac\xd3\x6f\xff\xe1\x7f\xff\x77\xff\xf8\xfb\xff\xf6\x0f\xff\xf3\x7f\xfc\xc7\xdf\xff\xe1\x5f\xfe\x87\xbf\xfc\xdb\x7f\xf3\x5b\xc6\xf2\x9b\xa7\x88\xb7\xdb\x2c\x54\x6a\xfb\x2d\x40\x33\xee\xb7\x9d\x7f\xf1\xcf\xfe\xd5\xbf\xfd\x5f\xfe\xe1\x2f\xff\xe6\x77\x4a\x15\x72\xae\x13\xb5\x1e\xb1\x4e\x90\xb3\xc7\xc9\xa4\xf0\xbb\xa5\x82\xa6\xfd\xb3\xbf\xfc\xbb\xd3\xbf\xfc\xcb\xbf\xfa\xf7\xbf\x7f\x7b\x9d\x5e\x5f\xbe\x4e\x2f\xd3\xcb\x7f\xf7\xfa\xe5\xf7\x5c\xd2\xe5\xf8\x71\xed\xf5\xe5\xdb\xf4\xf6\xe9\xdb\xf4\xfa\x99\x2f\xbf\x7d\xfe\x2f\x2f\x7f\xfe\x36\xbd\xfe\xf1\x79\x7a\xfd\xf2\x77\x97\x6f\x13\x38\xfd\xaf\xff\xf1\x5f\xff\xeb\xbf\xfc\xa7\xdf\xf7\x7d\xbf\x3d\xdc\x02\xf9\x83\x67\x30\xb5\xfd\xe1\xc8\x4f\x5f\xa7\x80\x96\x60\x6a\x3d\xcc\xbe\xf0\xd0\x87\xe3\x0a\xe6\x34\xad\x09\x56\x8c\x6d\x4a\x65\x7d\x38\xa8\xa5\x52\x30\xb6\x8d\x5a\x3b\x4c\x14\xef\xc5\xb3\xcb\xc6\x9e\xad\x36\x31\x8b\x67\x82\xd8\xa0\xb4\x29\x62\x7b\x36\x44\x7b\x94\x6d\x41\xbe\x8e\xb3\x01\xe3\x70\x5a\x53\x5a\x3d\xb2\x7c\x98\x14\x1b\x46\xf9\x96\xd9\xc3\x91\x0d\xd6\x24\x2f\xd6\xec\xd3\x5a\x73\x92\xef\xc1\x03\xf8\x8f\x3a\x60\x45\xf9\x09\xcb\xf7\xb4\x89\x9f\xc1\xb7\xa8\xae\xac\x3f\xd5\xea\xef\x6f\x2c\x3e\xa1\x94\xa3\xbe\xbe\x7d\x12\xaf\xd7\x9e\xb1\x6c\xb4\xd1\x9f\x7f\x4e\xfc\x2a\xdf\xbe\x4d\x8b\x79\x13\x87\x0f\x21\x3b\x8d\xb5\x3e\xc1\xf6\x32\x65\x8a\x14\xe4\xf7\xe7\xbd\x36\x05\x7c\x78\x0d\xf2\x26\xbd\xda\x25\xd2\x82\xa2\xbc\x1c\xa9\xbf\xd3\xf5\xea\xe9\x8c\xe2\x83\xcb\x54\x11\x8a\x71\xd3\x01\x2e\x25\x71\x58\xdb\xa7\x95\x56\x30\x54\x8c\xb2\x86\x2c\x2a\xb5\x41\xa3\x24\x6f\x06\x3c\x39\x8c\x0d\x48\xfc\x9a\x2b\x92\xa7\x96\x2c\x1c\xe2\x10\x96\x72\x9f\xe2\x7a\xa6\x4a\xb2\xd0\xd5\xd4\x8b\xc1\x25\x95\x55\x5e\x22\xe3\x3a\xc4\xd6\xa7\x99\xae\x0f\xaf\xbb\x0d\xda\x39\xe2\x5e\xc5\xa7\x74\x33\x75\xab\xca\xdf\x8e\x34\xa7\x71\x8f\x1d\x71\xf6\x87\x78\xab\x4c\x17\x3a\x8b\x53\xa5\xb7\x49\x1f\xb0\x7a\xa8\xf5\xeb\x84\xfd\xe1\x55\x63\xe3\x04\x1b\x04\xa0\xa9\x36\x84\x30\xbe\x93\x11\xe7\xb2\xb3\xb2\x9f\x72\x2a\xf1\xf4\xf1\x45\xdb\xf9\xe1\xc8\x0a\xbd\xd0\x26\xde\x28\x4c\x4b\x41\x0c\xc7\x38\x27\xa4\x41\x0b\x42\xeb\xac\x72\x29\x36\x79\x14\xab\x18\x5a\xe4\xf5\xfb\x05\x15\x5a\xa3\xe5\x85\x90\xee\xf0\xbe\x67\xed\x72\x83\xf5\x82\x56\xde\x25\x3b\xce\xa5\x99\x7f\x2a\xb6\xff\xfe\x1f\xff\xa7\x7f\xfc\x4f\xbf\xff\x75\xfa\x1f\x3e\x14\xe5\x5f\xa7\xbf\xee\xff\xf7\xdb\xff\xf3\x5f\x3d\x1e\x76\xd3\x54\x7f\xe5\xc7\x68\x03\xff\x9b\x8f\x71\xb7\x21\xff\xf9\xe7\xcf\x3e\xfe\xe9\xbf\x7d\xf4\xb3\x62\x29\xfd\x1f\xff\xd7\x5f\xa7\xff\xf3\x5f\xfc\xf5\x2e\x10\xce\xfe\xf5\x9f\x2e\xd8\x6d\xf0\xf4\x2f\x78\xb8\xbc\x20\xdd\xa6\xfe\xb7\x07\xfe\xdf\x5f\x1e\x92\x2f\x5f\xbe\xf4\xe8\x91\xe4\xeb\x39\xb7\xb3\x7c\xb5\x26\xa7\x3c\xba\x41\x9a\x21\xc9\xd7\xbf\x7f\x97\xaf\xbd\x7e\xf9\x24\x5f\xac\x14\x61\x32\xf1\xf1\x45\xf0\x94\xe1\x90\x7f\x7c\xa4\xbe\x29\x93\x5e\x29\xe4\xea\x52\x16\xd7\x7b\x4e\x73\xf7\xd0\x64\x0d\xdc\xb0\x95\x14\xc9\x68\x9b\x08\xd1\x66\xdf\x6b\xfd\xa7\x72\x7a\x1f\x12\x28\xae\x19\xd2\x19\xe4\x9d\x50\x5b\xda\x71\x4e\x87\x15\x47\xb4\x7d\x0a\x07\xcb\xfb\x93\x93\xe5\x3d\x81\x71\x14\x94\xd9\x00\x51\x12\x37\x74\x98\xaa\x87\x68\x8b\x38\x80\xec\x59\x52\xcc\x01\xc8\x9f\xf8\xf0\xd3\x0e\xc8\xda\xcb\x82\x50\xc7\x47\x9d\xe0\xb1\x6e\x75\xe4\xa8\xb6\x54\x0e\x71\x16\x33\x35\x93\x28\x36\xf0\xb2\x19\xd3\x76\xc4\xa6\x1c\x55\x71\xb2\x70\xc0\x9c\x92\xac\x6c\xc1\xe3\xc5\xf7\x22\x3e\x62\xa1\x08\x9e\x75\xf9\x7b\x7e\x78\x3d\x96\x6d\x8a\xe9\xf1\xa5\x43\x3e\x69\x5b\x81\x58\x33\x14\x8c\x46\x3e\xb4\xfb\x8c\x65\xfc\xbb\xf8\x86\x18\x66\xb4\xca\xf9\x78\x4e\x97\x93\xa6\x9e\x4d\xe8\x8a\x71\xb2\x42\xce\x59\x9c\xdd\x4a\x67\x0c\x58\x53\xc0\xe6\x28\xae\x2d\x15\x04\x59\xb8\x87\xf6\x98\xae\xf0\xf0\x62\x73\xe8\x69\xc1\x23\x75\x03\xb1\x82\x22\x5b\x19\xcb\xa2\xda\xcd\x7d\x95\x37\x0e\x94\x70\x4c\x81\xfc\xe3\x8b\x21\x11\x55\xe5\xc6\x16\xcb\x35\x4d\xf4\xf8\x43\x34\x8a\x87\x71\x20\xff\x3c\x7b\x30\x18\xe8\x22\x0e\x68\x3b\xb5\xda\x94\x3b\x60\x81\x19\x4a\x17\x65\xe1\xea\xa6\x9c\x36\x2c\xb5\x15\x68\xb8\xca\x42\x71\x75\x29\xae\x01\xe3\x2a\x7e\x5a\x7e\x9b\x0c\x55\xd3\x9a\x40\x19\x69\x5a\x93\xa8\x2a\x8e\xd4\xd9\x4a\x7c\x6c\xa0\x9f\x29\x22\x64\x59\x6b\x37\x82\x08\x31\x60\xec\x91\xce\xe2\xa8\x61\x26\xa5\x82\xaa\xb5\x99\x31\x1a\x47\x11\xab\xac\x27\x58\x8a\xdb\x2e\xdf\xa1\xd0\x19\xcc\x31\xa7\xcb\x64\x1f\xbf\x8e\x27\x48\x3b\xc4\xf5\x42\x57\x88\xab\xf8\x9c\x01\x0a\x2c\x74\xc6\x25\x79\x9f\x76\x2c\xf2\xac\xcb\x02\x21\xcd\xe4\x51\xfc\x46\x17\x88\x2b\x88\xbf\x5f\x93\x5d\x52\x6a\xb5\x61\x96\xcf\x09\x0b\x1e\xc8\x43\x90\x6f\x33\xd3\x5a\x53\x8f\x56\xbc\xc5\xda\x0a\x99\x4d\x7e\x8f\x79\x5e\x59\x48\x1e\x5e\xa3\x00\x2b\x5e\xc9\x7b\x90\x57\x0c\x55\xf5\x16\x8e\x25\x95\x3e\x7c\xf7\xc9\x6d\x0f\x87\x94\xe6\xb6\xc9\x6d\x13\xda\x15\x6b\x27\xe5\xb4\xa0\xa0\xba\x7d\x3b\xce\xd5\x25\xc5\xd6\xce\xad\x4d\xc6\x3c\xbc\xe4\xeb\x6d\x9e\xd2\xa3\x67\x5a\x97\x94\xbc\x7c\xef\x2b\x65\x4f\xb3\x78\x79\xf7\x97\xa9\xa6\x9d\x36\x12\x1f\x11\x60\xc3\x70\x84\x94\x64\x15\xbd\xa4\x8b\x25\x9c\x7a\x7d\x78\xf5\xbd\x7b\xc2\x82\x87\xec\x00\xfd\x41\x2f\xaf\xe2\xc5\x19\xe9\x9d\xe2\x5a\x73\xa1\x28\x3b\xf5\xb6\xe0\x9e\x3c\xc4\xb4\x2c\xe2\x98\xb6\xd3\xb2\xc8\x4a\x7e\xf6\x14\x37\x59\xcd\x06\x32\x0e\xd0\x07\x28\x1b\x36\x2f\x0e\x3b\x13\xeb\x94\xe5\xf1\x45\x30\xeb\xf6\x2e\xfe\x74\x2e\x10\x8d\x13\x2f\xd7\xd4\x73\x5a\x06\xd8\x21\x8e\xc9\x94\x22\x62\x39\xed\xa9\x6c\x58\xa6\x21\x3c\xf5\xc4\xea\x4e\xfe\x49\x7a\x93\xe5\xa3\x7a\xb2\x58\x1d\x14\x59\xfa\xed\x11\x6d\x94\x55\x05\xcb\x96\x47\xd8\xea\xe4\x1f\x1b\x74\x35\x39\x53\x40\xf3\x7c\xc0\xfb\x95\x8a\xaf\x30\x74\x9f\xac\x52\x72\x1b\xd3\x90\xce\x15\x3e\x27\x53\x0c\x55\x36\x6a\x18\xeb\xd8\x3c\x65\x09\x1d\xfa\x23\x40\xb2\xa0\x48\x21\xd9\xd4\x15\xdd\xba\xbb\xe3\x22\xcf\x1e\xc1\xa0\x03\x2f\x8b\x96\xee\x1e\x42\x5c\x67\x94\xdf\x2d\xf7\x82\xaf\x5f\xc5\xcb\x01\xe4\xed\xb9\x20\xda\xfa\x36\xf1\xff\x9b\x7b\x89\x0a\x74\xb8\x16\x84\xb6\x50\xc1\x9d\xbf\xd5\xc2\xc7\x27\x88\x2f\xec\x61\x2d\x10\x31\x27\x23\xaf\x58\x06\x6b\x15\x3b\xd3\xf8\xa9\x0f\xe1\x96\x9e\x51\xef\xd0\x8b\x24\xbc\x65\x91\xe7\x57\xd3\x06\x21\xc5\x01\x17\x4a\x13\x68\x85\xb2\xa2\x18\xf7\x02\x71\x95\x3f\xa8\xeb\xbe\xcb\x1f\x74\xa7\x43\xd9\xe9\x15\x9b\xdb\x37\x8f\x14\xe5\x9d\xd9\x73\xf2\xf2\x1d\xee\x97\xa5\x5f\x0f\x7f\xb2\xf6\x10\x34\x18\xc8\xa4\xe4\xc1\x2b\x12\x11\x57\x8c\x95\x94\xf3\xc9\xf1\xf4\x35\xb8\xfa\xea\x30\x7a\x9a\xfb\x44\x71\x79\xec\x2e\x05\xc1\xc1\x4a\x67\xf4\x50\x95\x35\x3c\xff\xf9\x22\x1d\x5a\xcb\x1a\xda\x59\x14\x8d\x52\x6b\xc0\x20\x8b\xc5\xd2\x5b\x2f\xec\xe3\x54\x58\x65\xe1\xf4\x3d\x36\x88\xd3\xf5\x27\x7c\xf2\x68\xd4\x5e\xe5\x13\x83\xd8\x5b\x1e\x46\xac\xf4\x15\x53\xc6\x78\x53\xbf\xd2\x2c\x66\x8c\xef\x10\x28\xb2\xd1\x4e\xf1\xe1\x10\xa8\xe0\xe8\xc3\x16\x96\xe6\x32\xe4\x85\x6d\x77\xb7\xc3\x2d\xc4\xa1\x28\x62\x53\x65\x99\x1a\x37\x5a\xe8\x7a\x55\x90\xce\xba\x63\x97\x8f\x6a\xe3\x0a\xd5\x8c\x16\xd9\xc4\x14\x47\x41\xb8\x56\x49\xd3\xfb\xcd\xc8\xfb\xea\x9c\x63\x48\x45\x5e\x09\x0e\x4f\x81\x87\x80\xca\x47\x4d\x17\x8a\xab\xeb\xb4\x76\x65\x10\x2e\x49\x39\x6c\xd8\xdd\xd5\xad\x01\x6c\xc3\x36\x8c\x26\xc9\x3a\xc8\xd3\x99\xad\xab\x56\x10\xe4\x50\x16\xc5\x86\xde\xa3\x69\x5d\xf3\xbd\x86\xb5\x31\x91\xad\xca\x9d\xaa\x83\xb8\x2e\xa0\xb8\x90\x1c\x6c\x70\x40\x45\xb2\xcb\xdd\x76\x4d\x51\xd1\xf8\x10\x2a\x1b\x81\xf8\x78\x5f\xe7\x99\x4d\x23\x59\x1f\xb5\x5d\x3e\xfe\xaf\x10\x23\xca\x2b\xb9\x42\xca\xb2\x19\xdd\xd2\x76\x24\x25\x70\xc9\xdb\x0b\x80\x64\xeb\x86\xbf\x41\x89\xd8\xf8\xbc\xb3\x29\x88\xe3\x0a\xc6\x40\x51\xd3\x28\x5a\x2c\xad\x41\xf2\x3d\xca\xba\x76\xc6\xda\x96\x54\xf4\x93\x3d\x9c\x38\xea\x21\xf9\x34\x97\xe9\x62\xa8\x1d\x12\x2e\xf6\xa1\x67\x6a\xa2\x85\xa2\x72\xb6\xee\xfb\xb4\x63\xd3\xe2\x88\x26\x82\xa6\x87\xdc\x9c\x14\xcc\x87\x0d\xd7\x3c\xb6\x97\xf4\x9a\x35\x15\xa6\x07\x9c\xc0\xaf\xa9\x50\x73\x41\x16\x2b\x86\x79\x5d\xa7\x53\x35\x2e\x25\x2f\x2e\xdc\xd6\x6b\x1a\x6b\x23\xdd\xa7\xed\xad\xc9\x87\xed\x0c\x66\xe3\xe5\x93\x35\x82\x4f\xd1\x26\xd6\x6c\xb4\xba\x39\x15\x97\x92\x95\x6f\xd7\x1c\x0e\x04\x5b\x39\xde\x5b\x27\xab\x01\x17\xc6\x41\x0e\x6f\x7f\x88\xbf\x2f\x69\x6e\x28\x2b\xf2\x4a\x31\x65\x6a\x4d\x96\xc7\x54\xae\x54\x1b\x3d\x08\x04\xdd\x07\xac\xc9\x6b\xda\x66\xc6\xb6\xb0\xb2\x91\x66\xd0\x8e\x8c\x1b\xc9\x41\xae\xd4\x4b\xd5\xa4\x08\x4a\x3b\xa5\x72\xe2\xe0\x9e\x38\x26\x10\x1b\xb9\xa7\x1d\x64\x4c\xdb\xc2\x3b\x1d\x5d\x09\x12\x78\xf8\x4e\x71\xb5\x20\x9f\x5a\x47\xea\xec\xca\xcb\xaf\xba\x24\xe6\xaa\x00\xed\x10\xa5\x1d\x03\x31\xc5\x23\xd0\x55\xb9\x8b\x89\x70\xa6\xda\xf4\x8d\xb7\xad\xd3\x8e\xb5\x69\x0b\x1b\xfb\x99\xe4\xcd\x5f\x39\xf2\x98\xd9\xb8\x92\x46\xf0\x19\x93\x94\x0f\x1f\xa0\xd5\x5e\x1d\x05\xd8\x00\xad\x7c\x9f\xec\x87\xaa\x95\x4e\xa3\x05\x7c\x45\xf6\x78\xe4\x89\x74\xb3\xad\x8b\x0c\x12\x9a\x64\xb1\x6e\xdd\xe7\x96\xe4\x60\x42\x4b\x79\x98\x79\x82\x81\x46\x0e\xb6\x4d\x36\xe5\x2d\x58\xb8\x92\x7c\x28\xb3\xdf\x6b\x77\x87\xa8\x59\xf2\x47\xea\x15\xa3\x55\x48\x00\x90\x69\x1a\xd4\x8d\x11\x5d\x11\x67\xe3\x7b\x96\x4f\x2f\x47\xab\x33\x0e\x8a\x02\x97\x31\xf0\x47\x29\xca\x86\xdd\x78\xbc\x45\xc5\xf4\x6b\x9f\x64\x95\x94\x7d\x8f\x56\x59\x87\x96\x49\x43\x19\x76\x56\x47\xb2\x02\xbe\x73\xc6\xd0\xb2\x95\x2b\xbf\xa3\x03\xe7\x65\xa5\xd2\x1c\x42\xc8\x0b\x98\x11\x07\x93\x46\xd5\x08\x59\x8d\x2c\xb0\xf3\xa6\xbd\xa9\xa1\x33\x79\x57\x96\x92\x14\x5e\x16\xbf\xc7\x44\xd9\xa5\x88\x27\x8b\xb2\xcb\xf4\x23\x32\x29\xda\x75\xc9\x5f\xa7\xf9\xc0\xe6\x52\x6d\x32\x50\x51\xe2\x3e\x45\x21\x10\x93\x09\xca\x31\xd1\xe3\x93\x02\x4f\x6b\xf2\xb2\x46\x79\xa7\x96\xba\x82\xbe\xb1\x67\xd3\x67\x54\x2c\xb8\x9b\x25\x7d\x07\x0c\x04\xb7\xf2\x48\xbd\x9c\x3e\x2c\x38\x69\x83\x64\xd3\x34\x09\x5b\x18\x08\xc5\xbb\x20\x89\xb3\x61\x5c\xc5\x35\x0a\x58\x25\x43\xab\x66\x8a\xf8\x2e\xcb\x60\x3b\x83\xc6\x10\xd9\x60\x3d\x7a\x5a\x16\x32\xa8\xcd\x76\x9e\xeb\x14\x6e\x11\xae\xf6\xf8\xc3\xec\x29\x60\xac\x85\x56\xd7\xea\x33\xcc\xa8\xed\xd3\x39\xc1\xc4\x11\x8e\x87\xd7\x6b\x70\x45\x31\x06\xbe\x9c\x3e\x8b\x6a\x89\x16\x88\xdf\x49\x43\xf7\xbe\x77\xb0\x68\x95\x33\x27\x83\xd9\x60\xc5\x3a\x59\x9c\x09\xa2\x38\x0d\x76\xca\xc9\x8a\x33\x61\xd0\xe8\x8a\xf2\xcf\xf9\xdc\x7b\x2b\x55\xfe\x34\xe7\x04\x06\x62\x4b\xaa\xbf\x7e\x97\x42\xc7\x6a\x5d\x7c\x14\x87\x82\x76\x9a\x35\x54\xf2\x8e\xb0\x59\x5a\x15\x57\xd5\xd1\x85\x20\x89\x8f\xb1\x58\xcd\x54\x1f\x7b\x5d\x75\xe0\x6b\x92\x10\xdf\x99\x86\x42\x78\x6d\x4f\x53\x7b\xec\x84\xb4\x9d\x05\x53\x90\x59\xe3\x4a\x0a\x08\xac\xa3\x95\x43\xa4\x24\x13\xe4\x20\x77\xa3\x19\xdb\x13\xf4\x70\x85\x62\x31\xde\x77\xb2\x8c\xd0\xc4\xf7\x4e\x46\xbe\x4b\x2e\xe9\x7a\x15\x85\x29\x19\x9f\x25\x95\xcb\xff\x96\x02\x31\x8d\x45\xb6\xb0\xdd\xc6\xff\x2b\x3e\x7d\x50\x81\xc1\x30\x0f\x51\x0b\xa6\xee\x8e\x1a\xce\x08\x65\x50\xd4\xf8\x2f\xe3\xe0\x90\x5e\x39\xf7\x65\x61\x96\x87\xfc\xd6\x60\xcf\x10\xab\x41\x65\x79\x53\xf4\x87\x07\x7b\x30\x85\xe8\xd1\xf5\x40\x25\xad\x9d\x14\xe3\xcf\x60\x6c\xbd\x1c\x32\xbc\x96\x3d\x44\x6c\xb5\x5f\x65\x2e\x86\xb1\x79\xef\xe2\xd5\x70\xc0\x59\x33\x95\xed\xce\xb1\x1c\xe5\xdc\x62\x3b\x77\xa6\x78\xd1\x82\x63\x97\x6e\x9c\xb2\xfb\x7a\x5d\x36\x91\xdd\xc0\x41\x29\x9b\xa2\xeb\x8d\x35\x73\x55\xd8\xa6\xd9\xa5\x96\x86\x13\x21\x0e\x29\x90\xc9\x8e\x70\x92\x85\x26\x1b\x49\x96\x48\x71\xa9\x2e\xc1\x9f\x5a\x01\x8a\xec\x7b\xeb\xdf\x8f\x65\x2d\x5d\xc5\x17\x67\x11\x64\xa6\xb0\xa0\x5b\x18\x5c\xeb\xb2\xd5\xcc\xf1\xa4\x86\x65\x53\x4c\x45\x88\xf2\x59\xc4\xbe\x54\xc3\x8b\x6c\x9e\x35\x87\x26\x9d\xb1\x61\x97\x9f\x80\x8b\x31\xaa\x7b\xd2\x7d\x97\x97\xa7\xf5\xc2\x8b\xd8\x52\xa9\xf2\x72\xd7\xa3\xa1\xbc\x01\xc2\xf6\xc7\xcb\xcb\x8b\xf8\x63\x08\x8c\xc1\x42\x5c\x0b\x62\x34\x50\x64\x39\x66\xae\x55\x75\xe8\x17\xd5\x55\xe6\x60\x00\xc3\xa6\xe2\xaa\x1a\x48\x13\x3d\xfe\xed\xb9\x75\x15\xbf\x8b\x8c\xb0\x45\xf5\x93\x5f\x08\xaf\xae\xcb\x82\x5b\xf1\xf2\x55\x82\xbd\x9a\x43\xbb\x4b\xe1\x86\x1d\x71\x0b\xb0\xca\x5a\x98\xc3\xce\xcc\xa1\x13\x5f\x7c\x84\x50\xa1\x33\x87\xc7\xd3\x70\x05\x25\x06\x1d\xd3\x51\xa4\x17\x28\xe4\xf1\xd0\xf7\xd4\x16\xd3\xee\x87\x19\xa4\x93\x0d\x3d\x96\x54\xbb\x66\xca\x51\x5b\xb5\xdf\xd3\x19\x9f\xc0\xd2\x0b\x70\xae\xc4\x12\x0f\xc5\xbd\x46\xe3\xd2\xa2\x69\x2d\x8b\x0d\x48\x61\x50\x34\xe3\x8a\x8c\xca\x5a\xac\xcc\x79\x9a\x2e\x0b\x79\xac\x92\x89\xbb\xcc\x75\x96\x17\x7d\x49\xbd\x30\x13\x8e\xf1\xdd\x41\xff\x06\xf9\x33\x2f\x18\x28\x52\x6d\x0d\xc1\x38\xc5\x77\xab\x9e\xe2\x56\x51\xd6\x2e\x0c\xe4\x3f\x67\xdc\xb3\xce\x87\x8b\x8e\x29\x32\x7a\x30\x77\x3f\xa7\x5e\xc7\xe1\x5e\xb1\x0c\x36\xcc\xd4\x1f\xeb\xa4\x71\x37\xc6\x39\xc1\x5b\x0c\xc9\x14\xb6\x1f\x33\x14\x05\xf6\x0c\x50\x18\x14\x16\x0f\xa9\x3b\x66\x7c\x72\x58\x82\x82\x29\x65\x8a\xaa\x76\x89\x78\x69\x7a\x44\xa5\xa0\x87\x0b\x5b\x8e\xd2\x80\xea\xc0\xa6\xbd\x26\xa3\x44\xdc\x06\x3e\xc1\x69\x0a\x45\xcc\x85\x38\x53\xc8\x58\x40\x83\x84\xf6\x42\x0d\xcb\x74\x4d\x4e\xde\x43\x4b\x2f\x5e\x5c\x8d\x3d\x15\x5b\x21\xda\xd6\x8b\x55\x24\x20\xca\xeb\x65\xcf\xa9\x80\x4c\xb2\x9d\xfb\xf1\x93\x90\x2c\x19\x38\xb5\x27\xdf\x65\xdb\x24\x36\xab\xc4\x61\x7d\x95\x91\xa4\x78\xdc\xbc\x5f\x69\xf2\x8c\x53\xae\x08\x4d\x23\xd3\xad\x98\x9c\x42\x19\xe5\x10\xc1\xb4\x61\x4d\x92\x85\xe9\x90\x51\x59\x4f\x0a\x2c\x7b\xa1\x18\x08\x54\x64\xa4\x6e\x47\x96\xf5\x71\xc1\x21\x4f\x2a\x03\x35\x52\x43\xfb\x33\x5f\x2f\x1c\x0f\x87\xcd\xe4\xfd\xdb\xa9\xa6\xa5\xed\xa0\x58\xde\xeb\xdd\xe3\x93\xae\xc7\xc3\xf6\x3a\x99\xc7\x84\xdd\xb9\x70\x96\x94\xbc\xd3\x99\x38\x92\x21\x63\x31\x50\xe5\x6d\x38\xa7\x38\xa7\xa8\x04\xe0\xc3\x91\x53\xc6\x6a\x64\x7a\x05\x5e\xb3\x99\xda\xe3\x05\x7f\xef\x75\x84\xd9\xce\x59\x96\x7d\x0e\x84\xa5\xe5\x8e\x0a\x89\xa3\x96\xcb\x0f\xef\x4e\x1a\x62\xfc\xe4\x1f\x7f\x0f\x53\xad\xae\x16\x6b\x4c\x29\x63\x51\x74\xad\x87\x99\x30\x46\x50\xe8\xa0\x26\xbf\x8b\xd7\xf8\x5c\x15\x1e\x9d\x56\xe3\xbb\x4c\x1c\x6b\xfb\xf4\x4e\x98\x35\x38\x65\x47\xc4\x3d\x25\x39\xdf\x80\x75\xf5\x4f\x8f\x41\xbc\xcf\xf7\x21\xff\xca\x59\xb8\x74\xb3\x99\x18\x15\x1a\xd0\x7f\x76\xad\xe5\xfa\xdf\x3f\xbc\xf6\xed\xcd\x18\x90\xa9\xc4\x83\xe5\x9a\x16\x97\xb2\xbc\xc4\x03\xba\xaf\xf7\x33\x54\xda\xe5\x03\x30\x80\xc8\x12\xe5\x4c\x62\x8b\x59\x49\x1b\xc1\x42\x67\x2c\xbc\x55\xc5\x89\xb9\x62\x94\xb8\x16\x32\x7f\xff\x84\xf6\x4d\xf6\xec\x57\x06\x62\xc5\x19\x70\x0c\xfe\x32\xb0\x5a\xd9\x68\x1d\x79\x68\x60\x43\x92\xe5\xe4\x8c\x67\xf9\xf8\x62\xff\x6c\xb2\x85\xe6\x79\x56\x48\x60\xae\xc3\x85\xe0\xa4\xda\x31\x9c\x50\x79\xda\x71\x96\x5c\xbd\xb9\x24\xb0\x6a\xba\xc7\x5d\xe9\x0d\xb8\x63\x7a\x6e\x28\x30\xd6\xbc\x6b\xf9\x08\x36\x6d\x27\x9d\xdb\x4b\xe3\xeb\x48\x57\xef\x26\x4f\x94\x98\xe5\xfd\x6e\x3b\x0b\x99\x84\xe1\x00\xa3\x9f\x8f\xae\xaf\xa9\xa4\x43\xca\x71\x9c\x4b\xda\x2b\x96\x6a\x34\xc1\xa7\x3b\xb7\x49\xf0\x7a\x6e\xe1\x44\xc9\x68\x06\xbf\xc3\x51\x07\x50\x20\x2d\xc2\xe5\x4c\x16\x93\xfc\x0e\x43\x80\x32\x34\x19\xd6\x27\x32\x51\xfe\xfd\xb8\xfd\xd4\xa8\x1a\xf0\x24\x66\x70\xdc\x32\xd3\x4d\x81\xab\xac\xaa\x2f\x14\x2b\xfb\xae\xe2\xd7\xae\x0c\x26\x4a\xbf\xbe\xa6\x6b\x6a\x69\x97\x4f\xa3\x1b\x0c\xca\xc0\xb0\xfc\x32\x97\xf7\x2c\x52\x32\x60\x3a\xb4\x94\xe9\x5d\x9e\x5a\x80\x06\x14\xdf\xe5\x9d\xf0\x61\xe3\xcb\xca\x6c\x75\x5d\xd6\x33\x2b\x72\x9e\x50\x21\xd3\x54\x9e\x3b\x6f\x07\x18\x2e\x14\x05\xce\x2d\x91\x6e\x47\x73\x49\xca\x4c\x30\x36\x8f\xa6\x2b\x24\x72\xc6\x28\xbc\x96\x8e\xa3\x64\xb6\x90\xf1\xa7\x45\xc6\x2e\xe0\x14\x53\x09\xe0\x4f\xf6\x9e\xd7\xf8\x70\xd0\x88\x5c\x4e\xe5\xf1\xae\x84\x8c\xaa\x3d\xc2\x61\x09\x76\x77\xe1\xfd\x6e\x73\x3e\xbe\x4b\xad\x18\x66\x85\x3a\xea\x53\xda\xa0\xad\xa0\x58\x60\xd9\x91\x57\xec\xbc\x5e\xf9\xd4\x98\x58\xab\xa2\x98\xe7\x31\x43\xc5\xa1\xa4\x1c\x72\x74\xc8\x12\xef\x47\x3b\x1f\x32\x7d\xc9\x43\x5a\x81\x9e\x65\x5b\xea\x47\x45\xca\xd8\x48\xcd\xab\x67\x8a\x5a\xc3\x12\xbc\x4c\xd8\x58\x53\x5c\x77\xf9\x70\x5e\x12\xff\x8f\x78\x99\x3d\x4c\x34\x55\x2d\x3f\xc1\xab\xcf\x0b\xa4\x39\x57\x9c\x1b\x37\x2d\xfe\xf8\x8c\x67\x2c\x12\xad\x8d\xc3\xf6\xaa\x8e\x3c\x93\x4d\x18\xe4\xd9\x86\x34\x60\xc4\xda\xe5\x90\xd5\xcd\xb8\xf1\xf2\xf1\xca\x9a\xba\xc9\x30\x8b\x43\x9f\xaf\xae\x7b\x52\x18\x1d\xae\x05\x6f\x15\x22\xf1\x78\xcf\x92\x2e\x5e\x41\x45\x02\x05\x3a\x93\xac\xed\xd8\x2b\xf8\x99\x69\xfd\x68\x44\x83\xfc\x2a\x27\xb3\x1c\xe4\xbb\x06\x1f\x64\x8c\xeb\xd1\xbd\x46\xfb\xcc\x25\xf1\x36\x48\x26\x81\xd7\xc5\x74\x81\x78\xd2\x23\x9a\xf6\x78\xfb\xbc\xc9\xf6\xdb\x01\x35\xd2\x33\x24\x87\x62\xc6\x52\x33\x9a\xa6\x25\x41\x99\x50\xc5\x6d\x7e\xa1\xf8\x9d\x6e\x69\x85\x67\x2c\x27\xb5\x78\x09\x6b\x9e\xac\x38\x9f\xf7\x1d\x6e\x20\x82\x95\xf5\x17\xa7\x64\x9d\x9a\x5c\xde\x61\xd0\x69\x6e\xb8\x83\xb4\xb5\xf6\x82\x5c\xb5\x41\x38\x50\x39\x40\xed\x91\xed\x17\xc9\x3e\x8b\x56\x8c\x5a\x9a\x13\xd6\x76\xaa\x14\x3e\x2a\xfc\x3c\x1a\x14\x8e\xa5\x60\x75\x51\x11\xe6\x51\x22\x44\x53\x0f\xef\xa9\x97\x08\x3e\x2d\xf7\x33\x5a\xc9\x28\xe6\x00\x52\x9d\xf0\x54\x33\x18\x59\xab\x9b\x94\xb6\x11\x50\x68\x0e\xd7\x94\xec\xd0\x3f\xd2\xe0\xf1\x9d\x99\x3c\x27\xa3\x3f\xc3\x2f\x7b\xec\x96\x79\x5c\xc1\x37\x34\x6e\xf2\x20\xa3\xbf\x37\x3a\xdb\x0d\xf9\x90\x3e\xf6\xec\x53\x0a\x33\x96\x55\xfa\x1e\x39\x65\x46\x4b\x05\xee\x55\x73\x78\xa6\x33\x6e\x6a\x72\xf1\x5d\x2c\x43\x8a\xcd\x29\xa7\xe2\x8a\x2d\x17\xaa\x41\xc5\x57\xb8\xa0\xc0\x9b\x4c\x90\xb9\x45\x39\x46\xa8\xf5\xff\x4f\x05\x9c\xb6\x37\x29\x7b\xad\xed\x34\xbc\x1f\xc1\x87\xca\x9c\xf1\xb0\xa4\xb2\x53\x64\x08\xf4\x4f\xf9\xf1\x60\xe7\x92\x36\xc5\x54\xe5\xe4\x7b\x28\x21\xc5\x35\xf9\x29\x6a\xa1\x4d\x86\xe0\x02\x64\x85\xa5\x6b\x38\x23\x69\x75\x2d\xc3\x91\xb1\x70\x7a\xde\x74\xb9\x5c\x1e\xdf\x6c\xdf\xa7\x00\xa6\x24\x0d\xf8\xb1\xbb\x24\x27\x43\xa0\x5b\xca\x5a\xc2\x0c\x83\xe5\x9c\xf9\xfe\x64\x5b\x8c\xa4\xce\x90\x38\x41\x84\xe4\xa9\xb0\xe4\xda\xea\x50\xa6\x92\x18\x68\x2e\x79\x52\x63\x84\xc3\x1a\xba\xa7\xc5\x49\x9a\xc2\xb8\x4e\x57\x99\xdc\xde\x8f\x55\xa9\x84\xb0\xc1\x69\x07\x12\xdf\x62\xef\xef\x24\xa7\x17\xba\x0d\xb3\xbc\x17\xe6\xf7\xab\x1c\x61\x65\x2f\x3d\xc5\x13\xab\x48\x41\x64\x91\x1d\x86\x00\xf2\x79\x3f\x0f\x5a\xd2\x46\x5a\x42\xfb\xfe\xac\x1e\x05\x67\xf0\x08\x6b\x9f\xed\xf8\x85\x78\xf3\x9b\x5f\xd7\x60\x0d\x10\x41\x2b\xda\xe5\x8f\x42\xa6\x7e\xef\x49\x99\x69\xae\x43\xb6\x22\x08\x5b\x7f\xf1\xfc\xb2\x86\xd3\xf7\xe5\x07\x05\xb0\x27\x56\x2d\xee\xe1\xd5\xd5\x5e\x17\xf1\x7b\xb0\xe1\xb3\x51\x50\x6b\xc6\xf5\x78\x27\xdd\xc8\x52\xbf\x3b\x2c\x48\x75\x47\x35\x15\x91\x69\x17\x38\x8b\x73\xa9\x7d\x85\x52\x8f\x28\x8b\x56\xec\x57\x9e\xa7\x74\xd9\xfb\xb8\xcb\xfb\x8e\x53\xa0\x4e\x3f\x00\xf7\x87\x43\xd8\x8f\x4c\xdf\x3b\x6a\xef\xd0\x69\x47\xca\xa4\xc0\x06\x36\xb5\xc1\x41\x91\xef\x61\x6c\x2b\xbf\x90\x9d\x9b\x05\xfa\x47\x6a\x53\x7c\xec\x6b\x5a\xe0\xc0\x86\x5a\x0e\x82\x29\x3a\x9b\xa2\x0c\x7f\x24\xe1\x3f\xbc\x3c\xa4\xb5\x1e\x97\xaf\x5f\xc4\x21\x3f\x0a\xcd\x49\x3b\xbc\x9d\xfb\x53\x04\xfe\x96\xad\x37\x8c\xd9\xdb\x84\xa4\xd7\x71\xc4\xd6\x0d\x93\xb9\xa5\x11\xb0\xd1\x0c\xa7\x27\xb4\xaf\x06\x65\x55\xcc\x36\xfe\x26\xd3\xfb\xf7\x8e\xe5\xe8\xb2\xd6\x74\xdb\xaf\x55\x93\x1b\xaf\x35\xb4\x58\x55\x0e\xc9\xb5\xa7\x40\x51\x62\x27\x98\x83\xcd\x23\xc6\x7a\xd5\x88\x08\x3b\xfc\xbb\x2c\xcd\xb5\x62\xd4\xf8\x88\xe8\xe9\x62\x40\x8e\xde\x72\x39\x9a\x1b\x0a\x5b\x1b\x23\x34\xd2\xb8\x21\x36\xc3\x76\xb0\xf2\xfe\xbf\xba\x89\xd7\x79\x9c\x7d\xd2\x98\x43\xa1\x1e\xa8\x71\xe4\x8f\x52\x5f\xe2\x14\x6b\x4b\x79\xb8\xc4\xa6\x10\x67\xc7\x4a\xe3\xee\xc4\x2d\xe9\xf2\x8a\x11\x0b\x6a\xe9\xb5\x67\x5e\x8b\x8b\x5a\xbd\x82\xf9\x04\x01\xbc\x96\x00\x7e\x90\x96\x79\xce\x26\xcd\x97\xf3\x17\x9b\x14\x46\x57\xc4\xfd\xce\xa5\x0b\xe6\x17\x06\xa9\x00\x4d\x75\xd0\x23\xe7\x26\x39\x44\xf9\xe4\xe6\x5b\x04\xd2\x4e\x88\xf7\x3d\xf4\xaa\x90\x85\xf9\xbd\x22\xca\x98\x44\x98\x52\xf7\xe9\x2c\xa3\xdf\xb6\x30\x16\x27\x4f\x80\x6b\xe8\x89\xd8\xf8\x1a\x66\x2b\x86\x95\xb7\xc1\xa7\x1a\x94\x1f\x79\x7a\x6d\x9f\x9a\xa0\xd4\x67\xa3\x78\xf5\x95\x1a\x6e\x75\xea\x9b\x04\xcc\xff\x1d\x53\xe5\x16\x88\x12\x67\x71\x2f\x83\x28\x09\xdf\xbd\xe8\xdc\xa3\x4b\x15\x4d\x2f\xd4\x8e\x8d\x14\xa6\x33\xa7\x30\xf8\xd4\xad\xea\xa9\xb3\x8b\xa0\x26\xf2\xdd\xb4\x7e\xc5\xd6\x54\xdb\x04\x4c\xf7\xe2\xf7\x9a\x0b\x02\x9f\x0e\x23\x18\xa9\x08\x2f\xc5\xe1\x05\x16\x04\x2f\xc5\xc8\x2b\x9a\x01\x2e\x68\xa4\x1b\x16\xef\x29\x85\x63\xaa\x8f\x5f\x89\xc3\x09\xf7\x10\x91\x78\x8f\x73\x82\x55\x21\xe4\xa1\x82\x72\x85\x34\x43\x43\x39\x3a\x05\x4d\x3b\x25\x07\x82\xc6\x66\x87\x78\xff\xda\x73\x4e\xe5\xf1\xbd\x53\x5d\x52\x2a\xf2\xd2\x70\x06\x80\x9e\x44\xc0\x9a\x77\xd0\x67\x64\xb9\xbd\x3b\xec\x51\x36\x5e\x78\x75\x81\xbf\xb6\x52\x6b\x93\x13\x75\xd8\x65\x56\x21\xad\x9a\xe2\xfa\x4e\x10\xdf\xbb\x7c\xa2\x84\x14\xa9\x3d\x4b\xbb\xbd\x13\x52\x25\x53\xa8\x7a\x32\x9b\x7a\x80\xf3\x2c\x47\x6e\x42\xdc\x18\xcc\x90\x9e\x33\x8e\x5f\x0a\x05\xc1\x6a\xae\xf9\x40\x29\x27\x48\xa2\x9c\x07\xba\x5e\x43\x52\x84\xbc\x1f\xab\xeb\xe5\x69\x30\x7c\xee\x5e\xe3\x92\xbf\x7d\xae\x41\xab\x87\x15\x70\xed\x4c\x2f\xf3\x7d\x93\xa7\xb2\x14\xc2\x68\x17\x44\x79\xc3\x78\xea\x16\xb5\x8f\xb8\x40\xac\xbb\x9a\xd1\x8f\x1c\x26\xc4\x8b\xb8\xad\xee\xe6\xa9\xbc\xed\xe7\x74\xe9\xf1\x19\x63\xb9\x51\x90\xd2\xe3\xb9\x7c\x9a\xa5\x8b\x06\xb3\x27\xba\x26\x55\x9e\xf9\x62\x9d\xc8\x60\x49\x66\x53\xec\xdc\x92\x92\x52\xdf\xb5\xcd\x0c\xf3\x8a\xd0\xf4\x0f\xc7\x98\xd9\x86\x64\x14\x2c\x29\xf7\xe6\x79\x22\xf2\x39\xac\x13\x7d\x1a\x4f\x53\x36\x5a\x2c\x2c\xb0\x6a\xc5\xb1\x38\x87\x99\x7d\x6a\x71\x40\xc8\x5c\x2a\x32\x6a\x19\x1e\xa9\x44\xbd\xfe\xf2\x0d\xe7\x8c\x3f\xca\x41\x4b\x47\x98\xe1\x18\x34\xeb\xb6\x00\x8a\x18\x7a\x38\x83\xca\x2d\xc9\x25\x55\xe2\x6a\x43\x02\x12\x66\x53\x5c\x15\x0a\x71\xf5\xa0\x7e\x90\xbf\xad\xce\x26\x18\x29\x33\x1c\xe7\xc4\x59\x31\xd2\x56\x29\xb8\x60\xc1\x22\x1d\xd7\xcf\x0a\xdb\x10\xd4\x6d\x9a\x1f\x93\x33\xef\x8a\xef\x07\x7d\x42\x5a\xec\xd7\xcc\xd9\xf6\x12\x03\xe3\x6e\x28\x54\x2c\x90\x9d\x84\xc6\x66\xe6\xd7\x40\x3c\xe4\x04\x92\xeb\x71\x35\xdf\xc4\xd7\x68\x83\x2a\x7f\xb5\x2a\x8d\x3a\x36\x2b\x4d\xf2\xce\x2b\xf0\x4a\x4e\xc7\xd6\xa6\xcd\xa4\x20\xeb\xd7\x7d\x22\x3b\xc4\x53\x9e\x00\xee\xf7\xbc\x73\x65\x35\x7f\x26\x34\x9e\x4a\x0a\x10\x15\xf2\x8d\x99\xeb\xc4\x95\xc6\xd1\x76\xe9\x6e\x9f\x8d\x53\x12\xf4\x7a\xf1\x19\x4a\x55\x44\x34\x63\xca\x72\xdc\x82\x32\x19\xc6\x14\xa4\x30\xbd\x71\x53\x75\xe7\xa4\x9d\x05\x36\xb5\xaa\xf0\xeb\x02\x34\x47\xb8\x9f\x66\xb0\x14\x94\x18\xa0\xb1\x91\x4b\xfb\x70\xa9\x2d\x59\x00\x58\x63\x4f\xb4\xb4\xa6\x28\x18\x3e\x3a\xe6\x24\x7b\xe7\x83\xf2\xec\xa9\xca\x23\xd8\x9f\x3a\xe7\x68\xba\xec\xb4\x6d\xc8\x94\xc1\x27\x7c\x3f\x38\x27\xaa\x5a\xdc\x93\xb3\x66\x38\x47\x96\xf9\x5e\xd2\x98\x1e\xe9\xf2\xaa\x24\xae\x78\x0c\x89\x43\x74\x4b\x79\x78\x99\x83\x4a\xe3\x19\x2e\x15\xa5\x3e\xe8\x59\xb1\xa2\x86\x2d\x67\x52\x08\x18\x5b\xfd\x05\x80\x4e\x40\x5c\x4b\x8a\xef\x9c\xe8\x79\xa7\x83\x4b\xef\x33\xcf\x55\x35\x5e\x38\x95\x62\xf0\xdf\xa4\x57\xa9\x0e\xfe\x54\x8e\xe3\x02\x67\x68\x4a\xc8\x89\x3d\x61\xa3\x50\x4e\x6c\x8a\x2d\xa4\x33\xb6\xa4\xfb\x3d\x8e\x2c\x17\xb8\x57\x5c\xc2\x70\x64\x28\xb0\x7a\x52\x8d\x53\x16\x33\xcd\x89\x58\xb1\x19\x76\x2c\xb5\x7a\x47\x63\xdb\xe0\x25\xa7\x1b\x80\x28\x4f\x69\xd4\x2b\x3d\x63\x54\x52\xb1\x6a\x83\xd6\x39\x21\xd5\x51\x91\xb3\x3f\x4c\x8a\x11\x4d\x43\x7b\x5b\xa4\x82\x5d\xa5\xaf\xf3\x76\xac\xd3\xfe\x49\xbe\x1f\xdf\x86\x39\x0d\x59\x35\xb0\x77\x8c\xf7\x52\xea\x8f\xae\x86\x03\x8d\xa7\x5c\x51\xcb\x1a\xba\xb8\xcf\x51\x82\x33\xe6\xd4\x20\xee\x2a\x4f\xa1\xa4\xac\xa5\x2d\x11\xc4\x3a\x6a\xb0\x0a\x31\xda\x81\xb5\x77\x39\x53\xe1\x76\xfa\x1c\x9c\xe7\xa6\x09\x0d\x44\x0a\x78\xe3\xf9\x49\xdb\xe4\xf5\x4d\xb3\x25\x59\x05\x42\xf2\x3a\xbc\xf5\x55\x47\xc5\xef\x47\xcb\x2d\x81\x46\x1c\x75\xf3\x89\xb4\x88\xd1\xd7\xaf\x72\x0c\x39\x2d\xcb\x8c\xf0\x04\x82\xa8\x88\xa3\x12\x97\xb4\x14\xa3\x6e\x04\x03\xe1\x16\x17\x64\x75\x29\x0b\x58\x73\x38\x7b\x0c\x54\x65\x12\x12\xc4\xe6\x52\x3c\x0c\xf8\x2b\xd8\x51\xfa\x57\x1a\x79\xab\xa5\x7c\xba\x3a\x3a\x64\xa3\x64\x41\xf4\x55\x79\x5c\xa9\x72\x1c\xeb\x5e\x19\xf8\xe1\xb5\x39\x41\x61\x6b\x4b\xab\xab\x31\xf4\x46\xe6\xce\x2c\x9c\x7f\x2a\xb3\xd2\x57\x4c\x86\x1a\x29\x8a\x65\xa7\x82\x76\x3e\x9a\x32\x24\xa6\x19\x7d\x2e\x74\x95\xd7\x82\xb1\xec\x20\xf3\x90\xf7\x8e\x65\x03\xba\x68\xb1\x08\xee\x27\x52\x18\x7b\x60\x40\xd4\x4a\x8b\xe3\x90\x8e\x24\x1f\x22\x2d\xc2\xe2\xe9\xf2\xb4\xd8\x94\xf4\x1e\x63\x5d\x1b\x45\x94\xcd\xfa\x91\x5a\x32\x8b\x4e\x01\xc5\x74\x7a\xc6\xd1\xb8\x9e\xb1\xa0\x42\x10\xbd\xba\xa9\x47\x73\x18\x9f\x6e\xd5\xc3\xf8\x04\x97\x85\x95\xe1\x16\xd6\x18\xd2\xe2\x8f\x62\xea\xb3\xc2\x01\xd8\xe0\x56\x18\x4a\xf0\xc4\x58\xe3\xd4\x06\x66\xe3\xfc\x44\xf1\x26\x67\x0a\x98\xb4\x72\xff\xcc\x35\xf4\x49\x42\x96\xb8\x4e\xdb\x30\x1e\x04\x63\xdb\x98\xc6\x31\x5b\xf1\xee\x23\xb9\x95\x38\x9a\x28\x0e\xb9\xb7\x07\x53\x3d\x11\x63\x73\xaf\x32\x3c\xc6\x8e\xb8\x5a\xd1\x24\xe2\xbe\x96\xd4\xa3\x92\x0d\x77\xc9\x56\x0e\xc7\xa4\x5e\x2c\x42\x81\x20\x0b\x4f\x81\xcc\x9a\x59\xbc\x05\xc5\x88\x77\xde\x8b\x86\x57\x73\x25\x5e\xce\x97\x96\x0b\xa1\x8c\x96\x6b\x3b\x2a\xe1\x0b\xe4\x7a\x8e\x94\x62\x0d\x6a\xf2\x0c\x0b\xd0\x8a\x8d\x0b\xd7\x3c\xf1\x20\x0c\x0c\x85\x26\x8e\xb9\x98\x42\x1a\xaf\xc9\xd7\xf0\x81\x58\x4b\x73\x0e\x07\x67\xd1\xa8\xe8\x13\xcf\x17\xde\x2b\x68\x5f\xd1\xed\xac\x83\xe4\xcb\xdb\xd3\xdc\x25\x76\x94\x1e\x5e\xa8\xe7\x5d\x2b\x5a\xd8\x2a\x86\xd6\xbd\x62\x4d\xd5\x2c\x3b\x2d\xf4\x2a\x69\xd6\x0c\x85\xea\xf0\x59\xc4\x1f\x8f\x3d\x2e\x5e\x0d\x14\x7b\xfd\x95\x62\x42\xd2\x90\xa3\x33\xac\x34\x2d\x9e\xe9\x36\xd2\xf7\xfb\x68\x00\x43\x8f\xf5\x2f\x37\x97\x49\x4a\xd5\xb7\x98\xe6\x64\x0f\xee\xf4\xd1\x52\x16\x95\x78\x83\x92\xdf\x64\xab\xfd\x47\xef\x02\xbd\xae\x86\x87\xda\x16\x11\x27\x75\x9b\x5e\x15\xeb\x4a\xd6\xca\xdf\x38\xcf\x17\x65\x25\x6d\xac\xa6\x1c\x59\xbe\xf9\x7a\x2f\x02\xaa\xd4\x85\xf8\x99\x36\x27\x1b\x79\x15\xcf\x18\x7d\x52\x5a\xaf\x30\x6e\x6a\xd3\xfe\x14\x50\xfe\x2c\x67\x10\xb2\x52\xaf\x0a\x21\x1f\x1b\x18\x50\x48\xa2\x35\x43\xdc\x76\xad\xbe\xde\x15\xd2\x55\x11\x7b\xc7\x41\xaa\xbd\x3e\x61\x49\x64\x44\xae\x14\x2d\xdf\x26\xd0\x05\x8b\xfc\xfb\xe6\x30\x13\x77\x4f\x99\x95\x56\x7a\x2d\xf5\xb6\xc8\xdf\x0c\x62\xa3\x91\x32\x26\x1d\x0e\x6d\x2f\xa8\x94\x88\xe0\x8f\x71\xd5\xb8\x6c\xb4\xaa\x74\x87\xc6\xfe\xb0\x10\xf2\x65\xeb\xe1\x23\xaf\xff\xf1\xdd\x43\xbf\x76\x09\x45\xac\x8e\x1a\x24\x25\x8f\xbb\x9d\xe7\x74\x89\x49\xe6\xf1\xee\x54\x51\xab\x07\xb9\x7e\x34\xa6\x11\x57\x87\x8f\x16\x78\x93\x99\xf9\x3b\x46\xae\x2b\x22\xa0\x2c\x3d\x92\x44\xf5\x6e\xad\x29\x72\xb3\xe3\xbc\x20\x4a\x89\xc6\x03\xe8\x32\x5a\x45\xd8\x8a\x97\x93\x92\x4f\x70\x86\x88\xa1\x4b\x8e\x35\x4b\x44\xeb\x4a\x91\xb8\x00\x45\x89\xb1\x2c\x90\x15\x25\xc6\x18\x1b\x6f\x3c\x49\x5a\x2b\x28\xc9\xae\x6c\x14\xf3\x1f\xc9\xa2\xdc\xfb\xa2\x56\x59\x9c\xdb\x37\xb9\x82\xa3\x03\xb3\x35\x07\x6d\x14\x25\x14\xe7\x77\x83\xb6\x6d\xd1\xb2\x16\x22\xe7\xcd\x8c\xae\x96\xed\xeb\xb7\x2f\x9f\xc5\xaf\x74\x30\x92\xeb\x1e\x63\xbd\x54\x0b\xe8\xd8\x45\xdd\xa4\x08\xa9\xed\x66\xe3\x3f\x4a\x35\xa4\x42\x5b\x17\xa3\x07\x85\xab\x93\xac\x78\x52\xf2\x97\x07\xca\x75\x3b\xcc\xa4\x95\xfa\x9b\x44\x4c\xe9\x2e\x69\xe5\xc4\x2b\x71\x7d\xf0\x92\xa1\x55\x47\xa8\x1c\xb8\x77\x46\x96\x5e\x72\xf9\xbf\xa8\x98\x26\xdd\xeb\x56\x35\x42\x30\xd1\xfe\x26\xf1\x55\x2a\x91\xd3\xde\x5d\xf2\x69\x87\xc3\xb8\x2e\xef\x1e\x35\xd3\xee\xea\xa6\xf0\x0b\x2f\xc4\x3d\x51\x57\xd7\x61\xd5\x1c\xdb\x33\x44\x46\x3c\x4e\xef\xb2\x72\x8f\xd5\x68\x3d\xb5\xee\x0b\xf2\x58\xca\xf6\x9d\x14\x1e\xce\x93\x6e\xba\xa3\xc7\x55\xc3\x27\xb5\x95\x98\x19\xc3\xfc\x41\x71\x40\xed\x33\x98\x80\x53\x41\x6e\xba\xad\xb8\x2d\xc6\x92\xa2\xe5\xff\x06\x89\x90\x9e\xc4\x67\xa0\x91\x43\x3f\xb7\xb4\x9b\x5c\x92\xed\x9c\xd1\x44\xed\x50\xdd\x0d\x36\xc4\x2e\x14\x77\xa5\xf3\x4a\x35\x85\x72\x53\xd3\x43\x2e\xfd\xea\x7a\x52\x26\x3d\xe2\xb6\x2c\x2a\xe3\x2f\xe2\xb0\x0d\x0a\x1c\xa9\x8b\xd7\xdb\xce\x59\xbe\xcf\x3b\x5a\xd9\xc0\x60\x80\x74\xf5\x8e\x4f\x1e\x33\x16\xd3\x64\x9f\xb0\x02\x7d\x97\x34\x93\xc3\x1e\xd7\x2d\xc5\xd5\x52\x35\x5d\x41\xf3\xd7\x95\xfb\x49\x4b\x57\xaf\xae\x73\x57\xa6\xb5\xa7\x77\xd9\xc6\x1c\x27\xff\xab\xd2\x61\x8a\x63\x0a\x94\x35\xdc\x96\xc9\x9e\xc2\x49\xb5\x42\x69\x6a\xfb\x2f\xe3\x89\x23\x3c\x4b\x49\x81\x61\x68\x71\x55\xc1\x7b\x8a\x0b\x3c\x2b\x40\x30\x1f\x8e\x6b\x8e\x3f\x33\xc9\x57\xb0\x1a\xc7\xd8\xa0\x31\x62\x3e\x1e\x63\x52\x3f\x33\xc2\xa4\xf9\x32\xe6\xbf\x30\x62\x2b\x20\x4f\x97\xba\x1d\x3b\x78\x2d\xce\x9f\x39\x63\x5b\x7c\xc0\xd9\x2c\x73\x27\xcf\x48\xaa\xf4\x69\x73\x49\x26\xe5\xa3\x91\xc2\xc1\xfa\xe8\x9b\x30\x50\xe8\x8a\x1e\x61\xed\x0a\x16\x4a\xaa\xc3\xc3\x3e\x2e\x77\x23\x53\x11\x7c\x63\x3f\x2e\x4b\xea\x98\x51\xd2\xef\x9d\xfb\xdf\x91\x3f\x2b\x0b\x54\x93\x11\x6b\x5e\xed\x09\xb5\x00\x75\x4a\xf9\xde\x3b\x4e\x1a\x61\x53\x9f\x3d\x82\x8c\x64\x3a\x88\x3d\x1e\x32\xec\xfb\xa4\xc1\x73\xc8\x9f\x0e\x94\xca\x47\x7c\x9b\x15\xd6\xf2\xf7\x4d\x8f\x00\xf3\xf9\x2d\x5e\x64\x67\xd1\x66\x45\x1f\xf3\xc7\xc9\x83\x7c\xf8\xd8\xa0\x37\xf1\x6d\xe2\xe2\x19\xd8\x6e\x0c\x13\xe9\x3e\xd6\x4f\x6c\xd7\x49\xe2\xdb\x52\xbe\xbd\x85\x80\x53\x18\x87\xe7\x92\xe2\x9e\xe5\x44\x39\xae\xd0\x95\x39\xb0\x28\x49\xab\x42\xb1\xbc\xff\x54\x5e\x64\x87\x71\xe2\x2e\x21\xfe\x31\x80\x68\x38\xd5\x33\xde\x38\x7c\xd2\x43\x2c\x5c\x40\xf2\x3d\xae\x29\x02\xf6\x92\xb2\xac\xd1\xcc\xeb\x1f\x6f\xaf\x5c\x02\x6d\x6a\x8f\x07\x7c\x27\x3e\x57\xa5\xbe\x71\xad\x67\xb2\x4f\x0a\x80\xdc\x69\x9c\x55\x16\xf2\x56\x52\x17\x2b\x8a\x73\xbf\xd3\xab\x23\x69\x8d\x1c\x39\xd2\xf7\x18\xa7\x3c\xd5\xed\x60\x67\x47\x1c\x53\xbd\x7a\xa6\x57\x47\x0b\xd2\x86\x13\xdb\x21\xaf\x6f\x7f\x4c\x8b\x79\xe3\xbf\x8a\x82\x07\x71\xed\x1e\xca\xbb\xe2\xe9\x23\xb0\x68\xca\x96\xed\x38\x32\x47\x25\x22\x2d\x71\x90\xdd\x4b\xfa\x2e\x4f\x3c\x22\x6c\x41\x36\x32\x96\x59\x33\x32\xce\x00\x87\x02\xd8\xf0\x0a\x70\x6c\x51\xfe\xf4\xbb\x3b\x2c\xd9\x23\xf5\xb9\x1f\x01\x9b\xda\x62\x97\xb8\x4c\x97\x38\x95\xd1\xf6\x76\xe5\xd4\x3a\x85\xea\x51\x75\x16\x21\xdb\x5c\xcc\x02\xd4\x5e\xd9\x43\x0a\x24\x1b\x6f\xbe\x86\x8d\x5b\x25\xcb\xb6\x6b\x2b\x10\xeb\x28\x1c\x45\x29\x92\x52\x1b\xaf\x7d\xf9\x22\xc7\x28\x06\xd6\xf3\xa6\x68\x58\x5e\xfb\x3a\x35\x08\x19\x66\xa5\x52\xc9\x8c\x23\x5f\xe8\x4e\xd0\x14\x87\x6d\xe4\xfd\xae\x15\x18\xff\x08\x0a\x28\x95\xff\x79\x08\x04\xb9\xd0\xef\xea\xd3\x0c\xbe\x70\x41\x38\xf9\x0b\x3e\xed\xd9\x05\xad\x65\x05\x2d\xdd\xd3\x7e\x1a\x09\xef\xd2\x03\x78\xbf\x68\x20\x56\xb1\x52\xef\x82\xed\xaa\x62\x5b\x1c\x3e\x4f\xf6\xe0\x76\xf6\xa7\xae\x94\xcd\x3b\x52\xbf\x28\x7d\xf4\x18\xab\x3d\x94\x05\xe2\xef\x3e\xcd\xd4\x94\xe0\x68\xdb\x7f\xe9\xe8\x74\xdb\x54\xec\x13\x48\xf6\x59\x69\xbd\xab\x7b\x12\x66\x8f\xd8\x02\x4a\x87\x7c\x54\x5c\xc0\xee\x5b\x01\xde\xcc\x02\xf9\xeb\xf5\x6d\x56\x4c\xeb\xab\x83\x34\xbd\x53\x74\x40\x92\x69\xcc\xd4\xcf\xec\x59\xa7\x0b\xee\x11\x44\xf0\xc7\x15\x4f\xb2\x57\x8e\xb6\xcf\x85\xb4\x66\x04\x1c\x08\xeb\x65\x86\xc8\xa8\xf3\x80\xe9\x65\x5d\x69\xa0\x90\x56\x19\x12\xaf\x59\xcb\x53\x7d\x7b\x79\x79\x59\x14\xde\xf9\x1c\x4b\xc0\xa6\xb0\x55\xe2\x32\x91\x95\xc0\x98\x7a\x6b\x78\xad\x1c\x44\xc7\xf5\x2a\x9f\x65\x16\xe2\x86\x9f\x87\x86\x10\x25\xfb\xef\xe2\x5b\xd3\xf6\xf8\xb3\xc7\xb4\xdf\x4b\x7d\xc9\x0b\xf9\xfa\xe7\xe7\xe9\xf5\xf3\xdb\xf4\xfa\xf2\xc7\xf4\xfa\xc7\xa7\x87\x63\x2e\x14\x19\xf5\xd1\xc2\x64\xcd\x43\xcd\x1a\x5c\x32\x5e\x67\x57\x62\x0d\xef\xc7\xf5\x7d\xda\xe1\xbb\xfc\x51\xb8\x6b\xd6\xa6\x84\x74\x6d\x6a\x0e\x8f\x7b\xc3\x71\x30\x8e\xff\x53\x16\x81\x11\x3d\x61\xaf\x47\x28\x92\xf6\xed\xe5\xe5\xf5\x4f\x85\x6f\x59\x5d\xda\x87\x59\x2f\xb8\xd9\xcd\x61\x2b\xa9\xd5\xed\x08\xe9\x4c\xb2\xd4\x9f\xb9\xfc\x97\x57\xcc\x3d\xde\x7a\x1a\xf9\x7d\xe3\x1e\x47\xd1\x24\x39\x29\x73\xeb\xa5\x85\x1e\x35\x6e\x15\xad\x67\x52\xd0\xd2\x00\xa5\x37\x90\x5a\xdc\x5f\x54\xb5\xf7\xbd\x57\xfa\x2a\xce\xcd\x6d\xa7\xac\xb0\x86\xf3\x1e\x15\x0e\x68\xec\x65\x4d\xcf\xeb\xbf\x26\x4e\x85\x69\xe0\x9f\xd6\x93\x1d\x0d\x75\xbe\xc9\xe4\x74\x4b\xdc\x7b\x82\xb6\x51\x35\x5a\x52\xb6\x95\x6b\xaf\x54\x09\xcb\x75\xf5\x3d\x8b\x8b\xe1\x29\xf6\xcb\x09\xe3\x4a\xdc\x13\x5b\x1c\x36\x9a\x16\x9f\x4a\x92\x33\x41\xfd\xd9\x29\x45\xc5\x1e\x54\x41\x92\x1e\x95\xae\x1f\xc6\x8c\xb4\x26\x5c\x4b\xc2\x6c\xa3\x95\x82\x38\x86\x35\xfb\x70\x3b\x3e\x2b\x7d\x39\x38\x27\xe5\x76\x40\xca\xda\xaa\xed\xbf\x12\x1b\xe5\xad\xed\xa0\x70\x35\x76\x79\x85\xc8\xa2\x0e\x4d\x3a\x18\xff\x2a\xde\xa1\xed\x5c\xee\x4c\xfc\x48\xd6\x1a\x0d\x50\x71\xc9\x1f\x35\x53\xa1\x56\xb3\xda\xed\x36\x98\x45\x41\x73\x23\xee\xa7\x91\xc2\x2f\x8e\xb8\xdc\x72\x86\x85\x0a\x39\x6e\x9b\x86\x43\xfa\x2b\xcb\x7a\x8f\x77\xd4\xd4\x8b\x91\xf1\x28\xae\x5a\x6b\xae\xa2\x15\x33\x60\x7d\x21\xc9\x60\x05\x7c\x52\x5c\x04\x71\x3b\x71\x89\x39\x69\xd1\x29\x2e\x14\xa9\x1d\x5e\x2b\x2e\x7d\xad\xc5\x29\xc4\xee\x19\xdb\xa7\x2f\x72\xac\x2e\xf3\xe1\x2e\x5e\xc5\x12\xb1\xb6\xc0\x64\x7b\x39\x44\x3a\x72\xde\x54\x90\xfe\xa3\xfe\xee\xda\x20\x49\x41\x90\x9f\x64\xcb\xf7\xfc\xb4\xa1\x8f\x10\xe7\xfe\xe8\x20\xe5\x14\x08\x3e\x24\xbb\x60\xd3\x98\xae\xef\x69\xae\x62\xd6\x10\x90\xda\x1b\x2c\xed\x52\x31\x69\x5a\x47\x11\x70\x3e\x45\x95\x0a\xf9\xac\x9e\xc0\xc3\x3e\x3c\x49\x0f\x4a\x31\x99\x1e\x79\xcf\x6d\x91\x2b\x4b\x88\x83\x8c\x69\x29\xae\x5a\x2f\x5a\x26\xe5\x19\x76\x55\xa5\x01\xec\x34\xa9\xce\x7b\x61\xa7\x25\x2e\xa8\x00\x2c\x0e\x4a\xfe\x79\x5a\x09\x46\x24\xb3\x60\xe5\xc2\x92\x8e\x8b\x47\x15\x6d\x41\x02\xc5\xab\xeb\xdc\x1d\x44\x5a\x5c\x8e\xf1\x29\x80\x05\xcc\x5e\x25\xc1\xa3\xe1\x52\x0d\xb5\x29\xa5\x86\x28\x73\xa8\x50\x91\xad\x9b\x76\x5a\xac\x16\x90\x5e\x39\x21\x6d\x14\xa5\x90\xb6\xc2\x4c\x4d\x07\x68\xc9\xa4\x38\xa8\xb6\xe2\xa6\x25\x86\xc0\xbf\xbe\x48\x36\x10\xd7\x8f\xeb\x50\xac\x46\xc9\xf9\xd1\x13\x55\x80\x98\xaf\xf6\x5e\x1c\x4f\xda\x2c\x7c\x8e\x0e\xfd\x93\x13\xc5\x16\xd5\x2a\xb6\xed\x88\x35\xc9\x6a\x7a\x73\xb7\x73\x41\x79\x9a\xb4\x96\xac\xb8\x66\x2a\x76\x34\x62\xd3\xd2\x4d\x2c\xcc\x62\x0d\x4f\x47\xd4\x94\xe6\x85\x2d\xc0\x0a\x57\xad\x26\xcd\xb5\xa7\xc9\x3f\xd6\x8c\x5c\x80\x61\x1e\x7b\x58\x60\x99\x1c\x83\xc1\x22\xdd\x79\x68\xe6\x0d\xe2\xaa\xb0\xf1\xb7\x4e\x72\x59\x2a\x3c\x46\x9d\x0c\x61\xfd\x4c\x9f\xc9\x78\x7c\x95\x51\xf4\xe3\x20\xd9\x78\x7b\x62\x94\xb8\x14\xa0\xce\x58\xa2\x03\xa5\x09\xcc\xf9\xb6\xb2\x0f\xaf\x91\xde\x0c\xf5\x96\x3a\xa4\x64\x4f\x0e\xeb\x74\x20\x90\x4e\x69\xa6\x13\xba\x52\x9d\x0d\xfc\x99\xb5\x3a\x5e\x40\x6d\x6c\xba\x7c\x1f\xa1\x77\x79\x00\x04\x3e\x8d\xe5\xd5\xaa\x1b\x05\xd5\x77\x61\x2a\x4b\x38\xd4\xa2\x90\x1e\xe3\x68\x15\xf7\x2b\x54\x54\xa1\x61\xe0\xe8\xb4\x7e\xd2\xfa\x76\xcc\x5c\x7d\x98\x51\xd2\x58\x49\x5b\x11\xa8\x76\x11\xbb\x83\x99\x28\x0b\xc4\x48\xf8\xaa\xcc\x43\x92\xa2\x3f\x2b\xb6\x77\x6c\x4a\xdb\xad\xdc\xe7\xae\xa9\x13\xf6\x99\x7e\x34\xcb\xf8\x2c\xe9\x85\x8a\x58\x83\xc2\xb9\x1e\xe7\x81\x52\xda\x17\xe3\x2f\x70\x58\x16\xf2\x9e\xeb\xf1\x7c\xef\x7c\x26\x48\xa3\xee\x7d\x4a\x70\x59\xd0\xc8\x9f\xa6\x66\x16\x42\xa3\x75\xfe\x5b\xb1\xd5\x30\xa2\xee\x51\xa1\x00\xd5\x4f\x27\xec\x27\xee\x32\x7d\x7a\x9d\x20\x00\xc7\xa3\x94\x98\x2d\x37\x1c\xa4\x78\x2f\x6a\x2f\x8e\x6a\xe8\x71\x1c\x8f\x4a\xf9\x1c\x98\xb9\xa1\x8d\x7a\x92\xbf\x03\xe7\x28\x6e\xa3\x9d\x5e\xb0\x4f\x3b\x47\x4b\x2b\xca\xcf\x80\xa5\x8b\xfb\x7e\xe6\x56\x60\xf2\xb7\xbd\x79\x1e\x83\xdd\x26\xde\xc2\x6d\xd3\xaf\xf6\xfb\x62\xfb\x89\x5d\xb8\x27\x6d\x41\xa8\xbd\xc9\x6f\x8c\x6c\xa9\x73\xe8\x55\x1c\x11\x0c\xd8\xe1\xfb\xca\x9f\x92\x77\xc6\xaa\x98\x9c\x6d\x27\xa5\x02\xb3\x31\x4a\x96\x26\xaf\xb8\xa7\x0b\x69\x89\xdb\xc3\x84\x5e\x35\x4b\xf1\xea\x20\x72\x4a\x8f\xf8\xdd\x90\x19\xee\xe0\x37\xcd\x1a\x70\x14\x90\xcb\xd0\x4b\x37\xd9\x52\xc8\xe9\xaa\xc0\x1e\x9c\x4a\x33\xcb\x5f\xea\xea\x4e\xdc\xc3\xa5\x73\x7b\x33\xc9\x19\x19\x1b\xfa\x18\x6d\xe9\xa4\xdb\xdc\xe8\x54\x46\x56\x95\x5f\xfe\x9c\xde\xde\x5e\xa6\x6f\xaf\xd3\xdb\x63\xb8\x34\xe5\xe0\xa7\x51\xea\x4a\x0d\xfb\xbf\xcf\x5c\x5f\xbc\x4a\x9e\xf2\x9d\xa6\x36\xd2\xa9\xc4\x7b\x78\xf0\x9d\xff\x88\x03\x16\xa8\x4d\x2b\xfa\x3e\xc2\x4c\xef\xe4\x40\x2e\xfd\x76\xa3\x9d\x82\x7d\x5a\xb7\x24\x76\x4e\x2d\x7e\x7a\x86\x71\xe7\x40\x71\x50\xad\xee\x9b\xcc\x7b\x22\x8e\x15\x82\x0c\xea\x7c\xf9\xf2\x65\x93\x05\xc4\x15\x59\x33\x35\xb2\x07\x83\x4f\xe2\x8f\xe1\x1d\x2e\xd9\x27\x2e\xd0\x21\x86\xa3\x0c\x24\x6e\x57\x20\x0f\xa0\x68\x0a\x5a\x62\x5f\x46\x00\x0f\x79\xab\xcf\x4a\x72\x00\xd5\x1e\x15\xea\x63\x3b\xab\xe6\x01\x17\xa5\xe7\x36\x7c\x5c\x0b\x42\x49\xae\x73\x9d\xab\xea\x1c\xb2\x7d\xe6\x3a\x9c\xb4\xb8\xdb\xe5\x90\xf1\xac\x08\xa1\x52\x95\x7d\xdf\x82\x56\x35\xc9\xb8\x4f\xcd\x8c\xcc\x4e\xd4\x5a\xee\x0f\x1b\xbe\x71\x5a\x3d\x28\x09\xc1\x0d\x21\x3c\x33\x38\x7e\x14\xec\x0c\x52\xb8\xe7\x32\xf2\x41\xa4\x62\xdb\x0c\xc2\x68\x00\x48\xb0\x27\x59\xa0\x47\x49\x39\x9c\x9e\x17\x84\x0b\x75\xed\x10\x8d\x93\xdf\x35\x63\xf1\x1c\xb2\x90\x37\xa8\x43\x28\xed\x50\x6a\xf6\xec\x18\x57\x54\xed\x84\xb1\xec\x9b\xa7\x2c\x99\x77\xf7\xc2\x75\xfe\xae\x18\xa5\x45\xa9\x78\x71\xb2\x52\x7b\x29\x57\xe9\x87\xef\x9b\xde\xa2\xa8\x52\x7d\x79\x79\x95\x6c\xdc\xaf\x9f\x6f\xad\x61\x1f\x5e\x34\x69\x9c\x1e\xe2\xac\x38\xf3\xf9\x8a\xd1\x83\x5c\xc3\x13\x32\x33\x73\xe2\x56\xc1\x67\xe5\x53\x25\xa8\x4a\x92\xf5\x19\x32\x37\x49\x94\x7e\x7c\x06\xdf\xf1\x64\x53\xd0\xea\x70\x7f\x4f\x0a\x05\x09\x6f\xc5\x57\xc5\x09\x40\x4c\x33\xc9\x1b\x98\x41\x8a\x5f\xef\xed\x9a\x69\x55\xec\x0a\x16\x27\x0b\x47\x8a\xa8\x59\x16\x41\x41\x52\x97\xda\x8b\x1c\x5c\x5b\x0a\xda\x9d\x3c\x37\x89\x3e\x3f\x3e\x86\xaf\x1f\x65\x46\xa4\x5b\xc4\x0c\xd3\x2a\x3a\xfb\x0e\x8d\x83\x77\x79\xb1\xda\x5d\x5e\x85\x74\x9b\x0c\x6b\xb2\x6a\x32\x46\x2b\x18\x6d\xd5\x2a\x82\x5b\x7f\xf2\x30\x1f\xd2\x0c\xd9\x0f\x82\xd2\x52\x21\x50\x51\xf3\x65\x0d\x4a\xff\x89\x9a\x7a\x96\xb8\x20\x47\xea\x6a\xf1\xd9\xfd\x49\x95\x40\x06\x42\xb9\x20\x93\x97\x97\x31\x5c\xbe\x7d\x95\xcd\x86\x71\x7b\x20\x2b\x6d\xfb\xcb\x12\xa6\x9c\xa5\xd2\x47\x03\x70\x33\xf2\x17\x18\xc4\x50\x69\x5d\x6c\xcf\x9e\x0c\x34\x79\xea\xdc\x66\x66\xea\x15\xdf\xbb\xd2\x4e\xeb\xf5\xf3\x2b\x03\x2a\x9b\x66\xc6\xcf\x5c\x57\xa7\x17\x8f\x75\x45\x54\xfc\xcc\x41\x2f\xe6\x34\x9b\xb8\x7a\xe8\xab\x8c\xc1\x51\xce\x49\x4b\xb3\x40\x2c\x59\x9b\xd0\x9f\x06\x32\x63\x45\xe2\x80\xee\x4a\x16\xbf\xfa\x71\x04\x38\x64\x1d\xc9\x89\x60\x32\xcf\x33\x57\xe2\x5c\xaa\xc9\xd0\x99\x2a\xca\x71\xd2\x56\x9a\x1e\xa5\xc3\x82\x9f\x55\xea\x74\xa0\x4b\x56\x6a\xe9\x2d\xc8\x7c\xcc\xd1\x02\x5c\x1c\x73\xab\xe5\x72\xaa\x28\xcb\x58\xe6\xf2\x73\x4d\x71\x9e\xee\x5a\xe4\x2c\x5b\xbf\x14\xbe\x7e\x95\x5e\xf3\x6f\x20\xaa\xd2\x35\x18\x3e\x5d\x3e\xac\x78\xf1\x39\xef\x53\x78\xac\x68\xce\x09\x9e\xc5\x92\xb9\x57\x0b\x9e\xaa\x01\xa5\x7b\xec\x62\xde\xf4\x2a\x2f\x26\x59\xbc\x81\xfd\x82\x3e\x62\x55\x72\x84\xa4\x76\x22\xe2\xb8\xea\x27\xdf\xa3\x71\xe2\x92\xcf\x46\x15\x1d\x96\x9a\x7b\xbd\x2e\x69\xc8\xcf\x15\x51\x83\x81\x08\x21\xc5\x38\x17\x6e\x8b\x2a\xcf\x98\xd9\xdb\xed\xf0\xf8\x59\x1c\xb1\xe7\xa4\x73\x7f\x19\x87\x00\x4f\xf2\x80\x73\x8e\x03\x27\x90\x96\xe4\xf2\xfa\xed\xf3\x37\x59\x8a\x6f\x4f\xa7\x7b\x93\x6f\x69\x55\x32\x78\x30\x18\x92\xf2\x75\x38\x57\x3c\x99\xfa\x2b\xb6\x54\x70\x7a\xd1\xe9\x1d\xe7\x3d\x95\x0d\xcb\x8f\x70\xdb\xc3\x61\x76\x81\x2a\x26\x36\x34\x87\x23\x16\xa2\x2a\x8a\xe3\xcc\x8a\xd9\xe3\x21\xbf\x56\xcd\x18\x0d\x57\x3b\xcd\x59\x2d\xea\xe4\x5b\x41\xd9\x61\xe1\xc2\x64\x0b\xf9\x26\x97\x92\x0c\xc7\x9c\x46\x31\x8e\x5f\x69\xc2\xf8\x77\x0d\x59\x84\x4f\xc6\x6a\xf6\xde\x10\x5d\x88\x55\xa6\x28\xe1\x1c\x57\x28\x04\xca\xe3\x07\xba\xf5\x38\xce\xc3\xd8\x08\xc9\xf8\xed\x8e\xf3\x5b\x2e\xe9\x9d\xd1\x5b\x49\x62\x81\x34\xb5\x6b\x7a\x39\xe3\xa2\x85\x27\xeb\xa7\x5f\x80\x6a\x03\x94\xcb\x28\x6c\x26\xcd\xd4\xb8\x3e\x2b\x6c\x44\x4f\xdd\x71\xcc\x58\x1c\x30\x08\x05\x22\xe6\x45\x10\x47\xd7\x8b\x70\x23\xc4\x49\x93\xe0\x94\x3e\x1a\x79\xc1\xd2\x62\xb1\x7c\xdf\xa1\x4c\xbd\x20\xfb\x7b\x42\xb4\xe8\x83\x16\x0b\x3d\x22\xec\x38\x93\xd2\xcf\xc4\xc4\xff\x2f\x75\x89\x7e\xc0\x69\xc2\x75\xea\x9f\x65\x52\x87\x85\x33\xd9\xaa\x29\xe1\x76\x3e\x51\x6c\x45\x71\x9b\xb8\xae\xae\xc6\x2b\xb9\x67\x76\x70\x87\x7c\x69\x08\x13\xd9\x17\x30\xa3\x39\xcf\x2f\x84\x93\x04\xb2\x2e\xab\x71\xae\x14\x26\xec\x7e\xd3\xdd\x06\x46\x16\xc6\x65\x40\xc6\x9c\x44\x22\xe2\x58\x15\x2c\x97\x23\x04\xe9\x11\xe9\x7a\xa4\x43\x5e\x89\x0c\xd1\x77\xa5\xc3\x3c\xf7\x9d\xbd\xba\xd4\x17\xf2\xf2\x59\xc4\xa0\x8b\x9e\x2f\x64\x5c\x49\x0a\xfe\xba\xf0\x96\x42\xf9\x25\x47\xb5\x15\xa3\xa0\x8d\xb6\x11\x63\xa2\x6f\xcf\x4a\x40\x71\xc2\xd0\xc3\xeb\x81\x1b\xd3\x2b\x84\xe8\x4b\xbc\xc8\xba\x89\x69\x26\x5a\x5f\xc1\x9e\x0d\x77\x48\x59\x9f\x04\x41\x22\x99\x5b\x77\x46\xc9\x3f\xbc\x62\xd7\x02\xf5\xdc\x02\xc3\x29\xb9\x6b\xbe\x2c\x57\xf1\x22\x4b\xea\x47\x3d\x31\x69\x4c\x9e\x65\x51\xfd\xa0\x0c\xca\x32\x30\x0c\xab\x77\x30\xdb\xbb\xa2\x3a\x96\x74\xd1\x2a\xd7\x02\xa7\x0d\x97\x14\x66\x85\xc5\xbb\x62\x3b\x59\x5a\xa9\x81\x3f\x31\xca\x2a\x0e\xbc\xb5\x4a\xb8\x55\x05\x98\xe2\x63\xc9\xfb\xf2\x6d\xfa\xf2\xc7\xf4\xfa\x6d\x7a\xfd\xf2\xf2\x70\x40\xdb\x9f\x7c\xd5\x40\xba\xe4\x7c\xb4\x61\x7d\x78\xbd\x67\xae\xb1\x83\x56\xec\xa6\x01\x3e\xa4\xda\x74\xe7\xb1\x71\x49\xc3\x20\x7f\x98\xc6\xc8\x08\x97\xc6\xd2\xf0\x7c\x4e\x5c\xcd\x24\xe7\x3d\xfc\xa8\x01\xfb\xa4\xf4\xa4\x55\x1a\xa4\x0e\x92\x8a\x7c\x94\x59\xb0\xf4\xe9\x8b\x4c\x03\x37\xa0\xbd\x64\x0a\x55\xde\xa1\x33\xcc\xc7\x07\xe1\xc7\x6d\x4f\x8a\xf8\x9f\xf4\xee\xed\x11\xf7\x23\x95\xed\x47\x9d\x88\x87\x83\xa8\xa4\x38\xd3\xba\x7c\xf4\xa5\xce\x07\x57\xa8\x14\xd5\x70\xb9\xb8\x77\xf1\xe2\xd8\x58\x6b\xe2\x80\xbe\x36\xab\xc0\xcd\x7e\xde\xa6\xef\xb2\xe7\xda\xb9\xf3\x98\x0c\xa0\x98\xee\x07\x86\x20\x58\x9e\x64\x31\x36\x92\x0c\xc4\x01\x68\x09\xf4\x42\x36\x66\x26\xae\x7f\x44\x4a\x18\x81\xf9\x1f\x6a\xc1\xa9\xa1\x3a\x67\x25\x29\x81\x3b\x36\xa5\x8b\x4a\x18\xbf\x6e\xa0\xc8\xe7\xcd\x46\xf7\xa0\x44\x80\xcf\x39\xe6\x92\xee\xa2\xf4\x70\x84\x87\x21\x19\xfc\xd1\xea\x74\xff\x0f\x71\x70\xcc\x72\xe7\x5b\x4e\xf0\x9d\x93\xd6\xa4\x68\xff\x7e\xc8\xb1\xd3\xb6\x33\x74\x1d\xfd\x33\xcb\xc6\xa4\x49\xf2\xb5\xd2\x8a\x4d\xe3\xfc\xb4\x69\xeb\x71\x22\xe1\x22\x50\x46\xe2\x4a\xb4\xa8\xd0\x82\x5a\x2a\x1f\x7e\x83\x34\x84\x57\x72\xca\xb4\x19\xa7\xd4\x34\x5b\x8d\x4f\x49\x2b\xee\x79\xfb\xb6\xf5\x88\x76\x60\x84\x8a\xc7\xeb\x36\x7c\x17\x2f\x8e\xc9\x08\xc6\xe0\xc6\x9b\x3d\xf9\xce\x68\x87\x2c\x41\x21\x04\x23\x6f\xe3\x11\x74\x3d\x2d\x54\xf0\x49\xda\x63\xf1\x66\x3d\x39\xb9\x6a\x71\x48\x67\x98\x3d\xb6\x43\xc1\x77\x68\x81\xa8\x10\xc8\x29\x04\x5a\xcb\x6d\xb1\x94\xf2\x31\x76\x3f\x0d\xfb\x4d\x4a\x68\xe3\x58\x59\x2a\x8b\x46\xdc\x58\x53\xcd\xe8\x1d\x16\x50\x6a\x00\xe5\x5e\xd4\x2c\x5a\x2e\x91\x66\x4b\x98\x5c\x5e\x27\x1a\xde\xc5\x34\x97\x5f\x68\x74\x2f\x44\x13\xed\x3b\xc4\x35\xd5\x48\x39\xa3\xe2\x4f\x3a\xa8\x90\xd4\x6f\xc5\x41\x1a\xfe\x77\x5a\x48\xdb\x4b\x1c\xf0\xe2\xfb\xc8\x22\x7c\xd1\x90\x8a\x9c\x2c\x8d\x92\x9d\xa0\x38\x34\x23\xad\x0b\x0b\x18\x75\x03\x58\x2c\xb8\xb9\xda\x27\x97\x02\x92\x9c\x42\xc3\xbd\xe5\xc4\x6e\xb9\xd7\x63\x4e\x87\xa4\x1b\xe6\x7e\xbd\xb2\x76\x92\xe2\x24\x3b\x31\x59\x48\x92\xcb\x06\x70\xd2\x6a\xb9\x42\xce\x1e\x75\x8c\x29\x1e\x3f\x18\xff\xc2\x88\xd6\x2f\xad\x00\xa9\x25\xbf\x2f\x6c\x10\x19\x79\x21\x77\xe2\x86\xbf\x32\xe7\x7b\x47\x66\xa7\x1b\xcd\x93\xdd\x3f\x55\xe3\x3e\x8c\x88\x87\x23\x9a\xc3\xd1\x2d\xf0\x79\x4a\xa0\x07\xee\x4c\x90\x19\x61\x92\x57\x66\x28\xb8\x39\x5d\x0c\x14\x09\xd4\x65\x81\xae\x3d\x63\xd1\xd9\xab\x97\xe3\xf8\x22\xe7\x77\xd5\x2b\x97\x3f\x90\x7e\x7b\x6b\xd1\x1e\xb4\x18\xf0\x0c\x66\x1b\x58\x7d\x51\xab\xa9\xb0\x0c\x4f\x35\x9d\xee\x39\xfc\xd2\xb0\xbf\x43\x3f\x44\x3f\x95\x6b\xf4\xa4\x67\x85\x48\x87\x0a\xff\xd0\xe0\x69\xd1\x93\x4b\xe1\x6c\x67\x49\x03\x81\x87\x39\x75\x79\x05\xea\x20\xed\xd6\x3e\x07\xaa\x6a\xcf\x8b\x5b\x49\x51\x51\x83\x75\xce\xc3\xf8\x26\x4e\xf1\x8c\xe5\x50\x2c\x22\x86\x73\x30\xf4\x8b\xbc\x1d\xfd\x06\x95\x64\xcd\x57\x1d\xc5\x63\x74\x5c\x91\x46\x1c\x50\xfb\xc6\x55\x16\x53\x91\x54\x06\xe3\x18\x95\x53\x6b\xa4\x59\xcc\x40\xb6\x8b\x0a\xe7\xc8\xa8\x65\xef\xaf\xc0\xd4\x63\x19\x14\xe4\x42\x8d\x70\x62\x89\xec\x72\x94\xcd\x21\x5d\x94\xd8\x67\xe8\xbe\xd1\xcd\x1f\x94\xe7\x51\x50\xaf\x08\x3e\x76\x2f\xeb\x46\xc9\xa8\x2c\x69\xee\xb5\x71\x54\x89\xea\xa6\x98\x4b\x26\xfe\x52\xae\x7e\x73\x68\x09\xd5\x86\xb6\x33\x16\x4f\xf1\x5e\x79\x4a\x3d\x28\x43\xf2\xa4\xf1\xd8\x5d\x8f\x2b\x96\xca\x59\xa1\xb8\xa4\x02\x64\x65\xa1\x66\xbb\x13\xfc\xee\x40\xe1\x5e\x45\x2e\xda\x31\x31\xf7\xdd\x6a\xb1\xd5\xd7\x97\xd0\x6b\x57\xd0\xad\x88\xbb\x87\x68\xff\x36\x8d\x42\xa2\x60\x84\x63\xda\xb0\x8a\xd9\x12\x2b\xa8\x79\xd4\xeb\x9a\x95\x7c\x2a\xb6\xb0\x32\xc9\x0e\xfa\x6c\x3e\x62\x82\x92\xf4\x54\xac\xb0\xcb\x97\x97\x1e\x65\xdf\x29\xb8\xcf\xf2\xe6\xdb\x3b\xe7\x78\x94\x2e\xd3\xdc\xde\x91\x59\x70\x0a\x04\xc9\x5a\xf4\x16\x3d\x92\x61\x44\x66\x60\x32\x4e\x27\xed\x30\xb8\xac\x24\x13\x54\x32\x5d\xd0\x7f\x97\xd9\x03\xf5\xbd\x07\xe9\xc3\x85\x55\x8b\xa1\x99\xb9\xaa\xf1\x2a\x5a\xde\xe5\xb8\x39\x78\x7f\xe2\x1c\xb7\x13\xd5\xd3\xdf\xf0\x09\xc4\x9b\xd5\xd4\x83\xd2\x47\xc1\x93\x56\x96\xeb\x92\xbb\xec\x51\x46\x00\x93\xc0\xd3\x30\x1e\xa5\x41\xef\xe0\x15\xb8\xb9\xe0\xbd\x2f\xf5\x3b\xa3\x35\x6e\xeb\x12\x1a\xc3\xe9\x85\xac\x56\xd4\x75\xab\xaf\x6a\x56\x31\xdb\x29\x3e\x71\x02\x50\x40\xc5\x75\xc4\x13\x2f\x96\x1a\x73\x7b\xfd\xe3\xed\x6d\x0a\x49\x41\x01\x93\xb7\x53\x84\x79\xd6\xd4\xcd\xd1\x28\xfc\x52\x47\x4c\x61\x44\xa0\x36\x2b\x07\xf1\x06\x9b\x62\x48\x96\x7e\x50\xd5\xe8\xc0\xdf\x2f\xb3\x02\xff\xe6\x94\x0f\x2d\xcb\xe9\xc3\x8e\x95\x3e\xbc\x4d\xde\x48\x0e\x22\x63\x65\x4f\xf0\x31\xae\x38\x38\xa5\x8c\x05\x4e\x9c\xe7\x28\x8e\x03\x0f\x75\x86\x52\xc8\x80\x05\xe5\x6d\x7c\x2f\x1b\x97\x89\x0f\xc1\xc2\x21\x2f\xe9\x30\x70\x03\x2a\xba\xff\x43\xa5\x62\x7c\x52\x54\xd1\x52\xcd\x92\x43\x03\xdc\xc9\x7f\x47\x52\xa9\x09\x6e\x7b\x02\x44\xbf\xbe\x7d\x52\xe8\x77\x33\x58\xe5\xb7\x01\x43\xa1\xf7\xd6\xe4\xaa\x50\x5c\x68\x41\xab\x52\xca\x35\xd0\xed\x00\x4e\x55\xfc\x6c\x29\x29\x04\x94\x0b\x5d\xf2\xd7\x8d\xe9\x2c\x1b\x01\xd5\x81\x83\x00\x8d\x4b\x37\x78\x2d\x5c\x7c\xe0\xea\xe5\xb3\x6c\x2c\x75\xbd\x73\x5b\xa4\x7d\x3f\xcf\x95\x0f\x76\xf1\x11\x7c\x93\x26\xb3\xf9\xcf\xa3\x5f\x39\xd6\x1d\x15\xa3\x27\xa7\xcc\x05\xd9\x6e\xc4\x00\xe9\x4e\xa3\xe4\x43\x9d\x86\x4f\xab\x08\x63\x85\xb3\x5a\x08\x9d\xa9\x81\xe9\x22\x4b\xc1\x8a\x91\xbb\xc1\xcb\x30\xfa\x99\x62\x24\x54\x38\xf1\x26\xca\x2a\xe0\x96\x02\x36\x78\x47\xe2\x0d\x2c\x2e\xd0\x7d\x9b\x6e\x64\x6c\x0e\xf9\x29\x49\x2a\x6e\x5b\x93\xb7\x4a\x40\xec\x9c\x60\xb8\xc6\x8a\x6b\xc1\x55\x39\x36\xaf\x52\x5e\x57\x89\xa5\x3c\x3a\x53\xfa\x4d\x92\x9e\xea\xb6\x9a\x8b\xdc\x85\x65\x84\xf7\xa7\x70\x7c\x7b\xf9\x24\x3e\x9b\x9d\xf5\x46\x06\xbc\x4e\xdb\x04\x33\xbd\x53\xd1\xe2\xbc\x7c\x16\x5e\xa1\x97\x3e\x94\xa2\x14\x81\x99\x93\xbf\xa5\x1e\x2d\x54\x42\xfd\xb5\x86\x06\x42\xa2\x25\xd8\xee\x1b\xb7\x6c\x14\xa7\x64\x6c\x7e\xfd\xf6\xed\xab\xf8\x52\x03\x05\xc2\xa2\x55\xfe\xff\xfa\xf5\x55\x5b\x3d\xde\x9f\x93\x47\xbe\xcb\xd7\x3f\x5e\x64\x9b\x68\x4f\x29\x93\x58\xb8\xa1\x13\x37\x9a\xe6\x4a\x94\xd2\x9b\x30\x75\x4d\x51\x23\x36\xbd\x2b\xfc\x70\x9a\x69\xf6\x0a\x6b\x6a\x81\x6d\x93\xd3\xf8\xf6\x0e\x4a\x50\x68\x2c\xc0\xf7\x94\xae\x20\xd9\x59\x87\x33\xbb\x78\xf3\x41\xec\x24\x31\x81\x86\xef\xfe\xb3\x90\x89\xe4\x5b\x7f\xd4\xd7\x50\x35\x6d\xc4\xa6\x76\xf7\x5a\x3b\x07\x22\x7a\x34\x18\x6b\x2a\x4a\x99\x22\x67\xdb\x79\x16\x5f\xa8\x3a\xc8\xf8\x34\xb9\xaa\x52\x7c\x9d\xd6\x09\x6c\xbc\xc8\x83\x38\x79\x14\x4b\xdc\x93\x3f\x2b\xb7\xea\xb5\xcb\x59\x67\x1f\xc4\xd9\xc7\x01\xb5\xd1\x48\x4e\xa9\x6b\xb6\x03\x63\x1e\x32\x83\x68\x58\x73\x4f\x4b\x0a\xe1\x12\x92\x7c\x95\x21\xf7\x6c\x65\xa3\xc2\x43\xa9\x2b\x8e\x24\x51\x69\x08\xab\xdf\xa7\x3c\xc4\xb6\xdf\xc1\x40\x69\xc0\x75\xbd\x9a\x77\x39\x42\xea\x29\x6e\x1c\x1d\x14\x27\xca\x75\xab\xf0\xee\x5b\x4b\x82\x9a\x5d\xff\xde\x93\x79\x2a\x1e\xb6\xac\x8a\xa2\xcd\xe6\x59\xd5\xf2\x4a\x0d\x03\x64\xd9\x3a\x85\xd9\x28\x38\xd1\xdc\xfb\x0a\xf2\x96\xf7\x14\xfb\xa5\x25\x25\xe2\xe6\x6e\xd8\xf8\xee\x26\x78\xfc\x0a\x60\x39\x94\x80\xb6\x25\x93\x16\x6e\x50\x22\xd8\xed\x2b\x36\xa3\xa4\x0e\x31\x35\x1e\x8b\xd3\x78\x41\x03\x2a\xd5\x8a\x6b\x01\x73\x2b\x23\x34\x3c\x35\x15\x04\xbf\xd3\xfe\xe4\x07\x8d\xe4\xcb\xc9\x6d\x98\x65\xeb\x66\x83\xf8\x51\xcd\x55\x1c\x33\x08\x27\xbf\xc0\x85\xfd\xf1\x66\x82\x40\x67\xd9\x11\xb3\xbe\x6a\x35\x0e\x4c\x49\xb5\x36\x77\x8b\x0f\x4a\x52\x78\xdb\x50\xd2\xaa\x5e\x28\x56\x47\xef\x4a\x2e\x73\xb4\x45\xfa\xea\xf7\xc3\x52\xd8\x44\xee\xbb\xb1\x32\x04\xca\x10\xd5\xbd\xc5\x82\xf4\x64\xb7\xbd\xcb\xbf\xaf\x2d\x45\xa4\x2c\x9f\xe4\xb7\x28\x6f\xaf\x58\x4c\x8a\x8d\x09\x61\xd2\x73\xa0\x56\x6c\xf5\x74\x40\x08\x0a\xe2\x76\x23\x9c\x8a\x97\x93\xb7\x27\xa6\xad\x49\x9f\xa1\x3a\xb0\x69\x9f\xc2\x63\x0d\xcf\x24\xcb\x89\x8f\x00\x5c\x95\x9c\xa2\xfc\x01\xfa\x88\x23\x9e\xf7\xf0\x3e\x2e\x7f\xbc\xca\x93\x3c\x6c\xc4\xa3\x25\xab\x95\xeb\xe5\xa3\x3e\x50\x73\x3a\x35\x26\xf9\x23\x64\x32\x3b\x77\x87\x15\x3f\xe2\xee\x38\xa4\x6a\xb5\x36\xcd\x0d\x16\xd8\x19\x6e\x90\x06\xb0\x10\x35\xc8\xb2\x45\xbc\x80\xef\x11\x8a\xcc\x2a\xe8\x91\x6b\x17\xc4\x9b\x3b\x22\x0d\x1a\xfe\x3f\x57\xbb\x12\x27\xf2\x7e\x5c\x16\x71\x65\x07\x1d\xfe\x1c\x8d\x2c\x5f\xbd\xeb\xb5\x92\x7e\x76\xf8\x16\x76\xe3\xe2\xc9\x6c\xc5\xd1\x19\x03\xb7\x16\x94\x66\x92\xbb\xf7\x4b\x52\x0b\x3e\xdc\xb2\x35\xa5\xcb\x7b\x2a\x1b\xb4\xd2\x95\x64\x10\xc6\x49\x86\x70\x08\xd8\x76\x86\x5a\xe5\x07\x04\x5c\xa1\x24\xad\x05\xa4\xc5\xf3\xeb\x8b\xcc\x00\xe5\x2a\xc8\xef\x14\x0f\xa5\x04\xb6\xc1\xc8\x59\x92\x51\xe7\x7f\x7c\x74\xff\x16\xdc\x01\x08\x8c\xd3\x98\x0d\x2d\x7b\xd3\xf2\xae\xbc\xb2\xe5\x15\x57\xdf\xb5\x9e\x2b\x33\xc4\x78\x6b\xd7\x29\x4f\x9a\xef\x53\x47\xf1\x0b\x8d\x76\x14\xd3\x96\x56\x2a\x72\x31\xa3\x7b\x04\x46\x6d\xf4\xbe\x27\xbf\x80\x6c\x85\x0f\xdd\x8c\x50\x0f\x6e\x9e\x2f\x98\xa5\x38\x04\x44\x12\x02\x5a\x8c\xd2\x4a\xdc\xb0\x47\x6c\x92\xf7\xa8\x18\x94\xcd\xf5\x30\x5f\xd5\x26\xcf\x0e\x0d\x50\x4b\x8a\xd7\xb4\xef\x13\x27\x57\x97\x7b\x2d\x16\x69\x42\x5b\x4a\x25\xca\x27\xf5\x3c\xff\xf4\x6c\x1e\x0e\xf0\xc8\x85\x2f\xfb\xa6\x09\x65\x73\xf8\x9d\x14\x02\xf8\xcc\x29\xde\x82\x2c\x56\x03\x0c\x94\x2b\xb5\x37\x6f\xea\x65\x54\xeb\x67\xf2\x9f\x66\x84\xaf\xdc\xb0\xfb\x34\xda\x98\xcb\xb3\x79\xfd\xf6\xf5\xb3\x8a\x13\x23\xb7\xe2\xee\x8a\xc7\xe1\x31\x00\x97\x0d\x11\x9a\xe9\xf1\xcd\x5b\xa7\xd6\x95\x25\xb9\x45\x49\x6b\xd3\xf7\x31\xf7\xcc\x81\x68\x50\x75\xa2\x10\x94\x6c\xd9\x2b\xb4\xa6\x80\xa6\x7d\xe3\x6a\x55\x43\xc9\x6b\x25\x7f\xea\x76\x44\x19\x3b\xb8\x42\xba\x97\x40\x9c\xea\xe3\x25\x6d\x68\x36\x89\x22\x30\x82\xc6\x7a\x99\xb2\xcc\x38\x5a\xbc\xad\xd5\x55\x01\xcb\x66\x6c\x7c\xdc\xc9\x03\xd6\x1e\xef\x44\x2f\xe9\x5d\x36\x64\x8d\xa7\x14\x48\x65\x6f\x14\xfe\x78\x79\x79\x11\x13\x26\x0a\x98\x4d\x89\xa1\xb4\x7d\x69\xb2\x79\x78\xf5\x24\xfb\xfe\xb7\xa5\x52\x38\x41\x9e\x72\xd7\xd2\x68\x18\x3d\x55\xcb\x65\xb4\x1e\x51\x01\x7a\xee\xec\x5b\xe9\xf2\x9a\xda\x2e\x61\x01\xad\x87\xde\x20\x5e\xe5\xe0\xe4\x40\x88\xa7\xde\x52\x90\x96\xd6\xd2\xd5\xd1\xf8\x3f\xf2\x4d\x66\xb6\x37\x7e\x25\x1d\x46\x40\x84\xe8\x67\x67\x28\x2e\x0c\xfc\xf8\x2e\xbe\xa5\x68\xa9\x6a\x64\x4d\x8d\xc2\xfb\xca\xdb\x45\x92\x00\xbc\x34\x2e\x98\xa7\x7e\xa6\x03\xd1\x2b\x47\xb2\xdb\x3e\xbd\xbd\x7e\x51\x3a\xe3\xee\xb4\xb4\xbb\x3b\x2a\xca\x1a\x16\x6a\xa7\x1f\xd4\x5f\x51\xe3\xde\xeb\x9d\x3c\xde\xdb\x6e\x73\x08\x56\xe5\x56\xdc\x14\xe1\x67\xa5\x6d\x43\x4f\x4a\x2f\x83\x9f\xd6\xa5\x34\xe2\x96\x2f\x7d\x28\x68\xc8\xdd\x3c\xc9\x28\x3f\x86\x1a\xcc\x49\x09\x49\x37\x47\x95\xea\xee\x8e\x23\x71\x84\x60\x51\xea\xb9\x86\x00\xa0\xa5\x5b\x38\xfc\xe8\xe8\xb5\x53\x75\x6a\x91\x05\xb6\x87\xd6\x0f\x4c\xe8\xf1\x90\x91\xd5\x44\x5d\x0e\x8a\xb1\xad\x02\x8d\x69\xab\x81\xdb\xbb\xcb\x77\x9a\x81\x06\x7b\x53\x28\xd5\xe2\xb1\x56\xcd\x30\xda\x71\x0e\xcf\x68\xd2\x05\xc1\x17\xc8\x23\x22\x21\x4d\x23\x02\xdb\xc7\x72\x8c\xf1\x6d\x98\x0b\xb2\x7d\xc8\x1f\x1b\x56\x76\xe2\x6f\x19\xa8\xd2\x5c\x98\xfd\x96\x16\x4f\x33\x6a\xa3\xa8\x26\xd7\x15\x17\x3d\x20\x15\xba\x10\x24\x95\x21\x59\x6e\x9d\x40\x04\xb4\x0c\x0b\xe6\x3e\x7b\x85\x16\x13\xb8\xd5\x86\x4c\x82\xe6\xc0\x09\xab\xa3\x1f\xc5\x85\x1e\x8f\x72\xd0\xee\x5e\x81\xf8\xa0\x33\x6f\x45\xc1\x90\xe3\x10\x38\x99\xa7\xd5\xf5\x5d\xbb\xb4\x89\xd4\xda\x61\x99\xea\xd3\xb2\x60\x3f\x15\x93\x60\xa6\x5f\x53\x48\xb3\x1c\xc9\x9b\x91\x38\x5d\xe8\xf5\xdb\x57\x99\xdf\xc9\x4d\x3d\x6d\x0a\x15\xfc\x19\x54\xc2\xc8\x39\xc7\x27\x79\x45\xdc\x39\xfe\xf1\x35\xd7\x2d\xb4\x54\xe8\xfb\xc4\x1e\x09\xd9\x87\x83\x6c\x57\x59\x65\x73\xda\x31\xea\xd9\x8d\xf7\x02\x58\x3b\xb1\x45\x19\x1c\x29\xe1\xdc\x25\x31\xad\x40\x5c\x38\x8e\xc7\xd4\xe3\xf2\xf5\x8b\xb4\xf2\x6c\x82\xb5\x3b\x91\x50\x7a\x06\x77\x94\x64\xb5\x2d\x4e\x42\xab\x4d\xc1\x1c\xa9\x01\xa3\x89\x0b\xd2\x47\xf3\x19\xe9\x15\x3e\x7d\xf9\x83\xa9\xd3\xfe\x2e\x40\xf0\xf8\x90\x36\xe0\x71\x46\xcf\x0f\x12\x67\x92\x21\x9d\xb2\x42\x19\x69\xbd\xcc\x69\x56\xea\x91\x7b\xea\xef\xdc\x41\x5e\xc1\xa0\x2d\xe8\x15\x65\x77\x77\xf8\xa4\x35\x59\x64\x27\x82\xd9\x41\xd2\x75\x5f\xad\xe6\x5f\x7c\xb4\x84\x64\xa6\x82\x6c\x58\xed\x98\x95\x4a\x76\xac\x7d\xb8\x14\xc4\x8a\xed\x97\xaa\x08\x34\x8c\xa0\x10\xbc\x33\x14\xb0\xf2\x8a\x70\xb8\xda\xa3\x82\x04\x99\x23\x56\x53\x48\x31\xb0\x86\x1e\xac\x4d\xe1\x1a\xd9\x46\x72\xef\xca\x92\x5a\x53\xdc\x90\xcb\xe8\x4a\x20\x5d\x85\xf6\x2c\xe2\xb5\xc2\x35\xa9\xf6\x61\x4c\xbb\xca\x3a\xfb\xf2\x59\xb5\x92\x39\x2a\xea\xd3\x59\x8b\xf8\x5a\x4a\xc1\xa4\x18\x51\xcf\xc3\x38\x9f\xd8\xc5\x95\xd1\x49\x93\x4c\x82\xe9\x9a\xe2\xac\x10\x5d\x3e\x88\x5b\xd2\x43\x6e\x2c\x04\xce\x8f\x3c\xdd\x55\xb6\x38\x34\xd1\xa6\xd4\x28\x3a\x9a\x93\xb7\x2a\xab\x4e\x39\xce\xcc\x9f\x0c\x5c\x57\xc8\x3c\x78\xe0\xad\x83\x87\xbc\x4d\x18\xc8\x79\xa7\x38\xcb\xce\x81\x4d\x3b\x2a\xf8\x18\x01\xb7\x5e\x1a\x45\xe3\xa5\x69\x14\x4e\xd8\x28\x18\xbf\x77\x05\xd0\x89\x29\xcd\x5a\x19\xb1\x8a\xd9\x31\xbe\x28\x5d\x5f\x53\x5c\x03\x45\x4f\x9e\x34\x5b\xcd\x58\x25\x50\xc2\x85\x79\x18\xaa\x91\x1f\x72\x0d\x92\x2d\x72\xb3\xf9\xef\x20\xe9\x1f\x2f\x3b\x2a\x74\xae\x61\x2f\xce\x4a\x66\xd7\x08\x35\xfe\x1d\x05\xe0\xe1\xb0\x21\x01\xf7\xa4\xee\x9b\x75\x22\xc9\x4a\x83\x75\xd7\x4c\xdc\x71\x27\xad\x30\x32\x24\xcf\xc9\x06\x11\x7d\x55\xd9\x96\x3b\xc6\x4b\x47\x43\x4d\x56\xa5\x67\x4c\xb2\xc8\x32\xa7\x9c\x8d\xed\x77\x8d\xac\xeb\xd9\x1f\xbf\x72\xc7\xaf\x75\x96\x55\x0a\x87\xd4\x2a\x45\x32\x30\xa1\xed\x92\x37\xc9\xd9\x32\x8a\xd5\xe0\xb6\x29\xdc\xda\x95\xeb\x34\x42\x9f\xd6\x39\xc9\x3b\xb9\xa1\x71\x7c\xa4\xb2\xc1\x25\xdd\xe2\xbd\x47\x5c\x52\x2f\xcd\x9d\xde\x5e\xc4\x3b\x55\x04\x2e\xac\x2d\xde\x05\xbc\x1f\x1d\x55\xba\x9e\xd2\xcb\xaf\x34\xfa\xa7\x0b\x47\xf0\x0e\xb4\x71\x43\x71\x51\x20\xe2\x59\xdd\xce\xac\x53\x7a\x75\xb2\x1a\x0e\xc7\x49\x6f\xa5\xa3\x24\xc9\x56\x97\x2c\x44\x27\xf7\x49\x6d\x93\xeb\x0e\xa8\x81\x2c\x1d\xcb\x88\x01\x89\x97\xab\xef\x4d\x4f\x2a\x19\xb1\x71\x8b\x5e\xed\xd6\xc2\xe9\x65\x83\xd3\x25\x8e\x18\x21\x37\xae\xcc\x22\xae\xd4\x21\x6b\x5f\x0f\xd1\x68\x4c\x01\x3e\xb1\xbf\xfe\x0a\x32\x45\x8f\x3d\xec\x94\xa3\x92\x82\x14\xa0\xac\x9d\x31\x1b\x9c\xea\x63\x3f\x92\xfb\x57\xe5\xa4\x16\x05\x7c\x3b\x39\x26\x8b\x8a\x07\x94\xa5\x76\xe2\x1a\x3c\x02\x3e\xf7\xf6\xf2\xf2\xf5\x42\x4c\x41\x00\xad\x9d\xe7\xf6\xc3\x4b\x94\xb5\x32\x06\x30\x95\xf7\x85\xb8\xdc\x77\xf2\x2c\xb3\x71\xf9\xaf\x3c\x6f\x19\xc1\xfe\xce\x4e\x38\x47\xb2\x24\xa7\x85\x69\xed\x42\xea\x7f\xeb\x10\xaf\xb2\x54\xb1\x79\x2f\xfc\x32\xc0\xc6\x85\x69\x26\x76\x88\x47\x44\xe1\xd3\x27\x8d\x27\x59\xa2\x53\xea\x8a\x8c\x73\x6a\x4a\x67\x79\x17\x05\xd5\x49\x34\xdb\xeb\xcb\xeb\x2f\x44\x69\x85\x6e\x33\xcc\xd5\xb8\x45\x0b\xa5\x07\xfc\x24\x28\x49\x23\xda\x0e\x39\x63\x61\x4a\xa5\xe2\xaf\x94\x5e\x9d\x76\x1e\x73\x53\xfc\x96\x8a\xec\x31\x55\xae\x71\xaa\x72\x74\x39\x7f\x63\x1c\xeb\xa2\x66\x07\xef\xf5\x46\x8c\x05\xc1\x86\x94\x9e\x15\x82\x96\x96\xe2\x67\x9d\xe8\x87\x97\x67\xe6\xfe\xd7\x77\x79\x7f\x8c\xda\xcc\x8b\x4a\x5b\xfb\xe8\xd6\x75\xc0\x86\x17\x9a\x22\xb6\xdf\x73\x49\xff\x2f\x69\xd7\xb2\xe4\x38\xae\x5c\xf7\xfe\x0b\xef\x2d\x46\x57\xf5\x63\xa6\x97\x0e\x6f\xbc\xf1\xce\x3f\x90\x04\x92\x04\x44\x00\xc9\xc2\x43\x14\xf5\xf5\x37\x12\x54\x55\xf7\xc4\x55\xa6\x6a\x62\x16\xb7\xe3\xc6\x10\x45\x81\x78\xe6\xe3\xe4\x39\xff\xd6\xec\xe8\xc9\x45\xc7\x9e\x39\xd2\x49\xe6\x2a\x65\xad\xae\xfd\xed\x42\xf6\x26\x47\xd1\x58\x48\x04\x45\xca\x37\x5f\x38\x70\x38\x71\xbd\x1f\x25\x90\x01\x43\xb3\x4a\xd4\xef\x5a\xff\x00\xe9\x8f\x7f\xac\x9a\x5e\x38\x67\xf4\xde\xe2\xcd\xda\x4f\x90\x5d\x4b\x1f\x79\xc1\xb0\x40\x3f\xe5\x61\x28\x8f\x4f\xa9\xb8\xdf\x15\xf5\x73\xd0\x62\xee\xdd\x3e\x8c\x4d\x59\x3e\x8c\x05\x77\xb8\x6f\x5e\x09\xc4\xd5\xdc\xc2\x8e\x79\xa6\x44\x1a\xa1\x39\xd4\x84\x57\xf9\xf4\xe3\xf8\x34\x23\x3b\x98\x8f\x40\xfc\xf4\x64\x7e\xe1\x69\xa4\x8f\x3a\x24\xac\x63\x2b\xd8\x22\xf1\x45\x1a\x9b\x2a\xf1\xcd\xa5\x9d\xc0\xb1\x89\xea\x64\xcf\xf1\x0d\xb8\x40\x52\xec\x7c\xbf\xee\x9d\x72\x5e\x39\xe4\x82\xcd\xbc\xdb\x66\x95\x68\x05\xc7\x57\x2f\x24\x1b\x4f\x3c\x80\xf5\xa2\xcd\xe8\x6f\x78\x4b\x29\x0c\xd3\x4a\x94\xe3\x0d\x1d\x69\xb4\x71\x65\x43\x9a\xa7\x33\x65\x85\xe2\xea\xd6\x48\x81\x1d\x6c\x58\xd7\x56\xca\x3e\x43\x44\xe5\x1d\xc7\x32\x95\x46\xbd\x5f\xdb\x1d\xd1\xd5\xc5\x7b\xc4\xd7\xfc\xf9\xf3\xf4\xe3\x9b\x38\xf8\x81\x43\x74\x3e\xcd\x93\xdc\x5b\x1f\x67\xa5\x28\xee\xdc\x6e\x7e\x57\x00\x82\x26\x0d\x19\x9b\xae\x1b\x9d\x86\x0b\x81\x5c\xff\x00\x7e\x95\x19\x5f\xcb\xb2\x6b\x3a\xda\x17\xa8\xf2\x8e\x71\xfb\x8a\xc5\x69\x45\x37\x0c\x36\xd2\x0f\xed\x4c\x67\xf9\x61\xcf\x05\x4c\x4d\xd1\x78\x4a\xbc\x8c\x6a\xa6\xf8\xdc\x5a\x3e\x76\xad\xf8\x26\x0b\x67\xc6\x88\x0d\x23\xec\x9b\xba\x36\x57\xf3\x0e\xb5\x95\x36\x4a\x0f\x5b\x11\xb4\x2a\x0f\x3b\xd3\xab\x36\x19\xdc\x5c\x7d\x51\x09\x13\x13\x2c\xde\xca\xe3\x36\xdd\xdc\xcf\x9f\x32\xd1\x80\x79\x97\xa4\x95\x1a\xc4\x61\x0d\xfe\x2a\xdb\x70\x6e\x19\x27\x71\x20\xf9\xa0\xf9\x04\x70\x77\x7b\x0b\x4e\x2e\x38\xe1\x58\x71\x80\x55\xde\x37\xad\x48\x54\x6e\x6e\x19\x7e\xc9\x88\x4a\x7f\xbe\x9e\xb4\xea\x5c\x96\x0a\xb9\x39\xa5\xcc\x1a\x0e\xf6\x4e\x79\xcb\x85\x83\x52\xe2\x19\x76\x19\xf6\x40\xf3\xab\xf8\x3b\x91\x85\x42\x39\x08\x20\xe1\x15\x66\x3f\xb3\x45\x4e\x52\x56\xae\x5b\x87\x93\x02\x7a\xad\x5b\x5d\xe4\x41\xce\x13\x66\x59\xf2\xb5\xb8\x8d\xa9\xa2\x8c\x93\xdd\x85\xb2\x17\xb0\xd1\xa7\x97\x97\xaf\xb2\x5c\x36\x23\xe5\x6e\x3a\x3c\x33\x97\xe9\xf4\x1e\x6f\x96\xba\x13\x88\x38\xb0\x3a\x07\xe8\xb0\x6d\xa8\x8a\x0c\x9a\x46\x90\xfb\x41\x15\x28\xf5\xf7\xda\x47\x55\x5e\x5b\xb6\x71\xfc\xf3\x23\xf7\xf7\xb0\x4d\x19\x9f\x80\x23\xf9\x6b\x67\xe3\x50\x73\x84\xaf\x0e\x62\xd1\x78\xc3\x38\x9c\x13\x29\x2b\x41\x7e\x5e\x3d\x23\x28\xa3\xca\xd7\xa4\x5a\x2e\x6b\x21\x69\xd1\xde\xbf\x26\xb1\xa5\x13\xf3\x02\xe9\xc7\x4f\x39\xec\xb0\xbc\x0e\xd7\x8c\xf2\x46\x8a\x41\x15\xbf\xa4\x38\x6b\x42\x0b\xbf\x29\xd3\x0a\x66\xcd\xd9\x23\x3b\xad\xe6\x31\xe5\x29\xa7\x09\xf5\x4b\x6e\xc3\x31\xc0\x2c\xfb\x82\x29\xf0\x4c\x69\xbe\x09\xbe\xbc\x7e\x95\x8c\xae\x15\x12\x65\x88\xaa\xc8\xdc\x6a\x40\x01\x2f\xbe\x63\x39\x56\x5a\xdf\xb9\x09\xe5\x57\xcd\x19\xec\x2e\x69\xe1\xf4\x08\xb4\x51\x81\x2c\xc8\x92\x32\xb2\x39\xcb\xf5\x5e\x0a\x6f\x1b\xf9\x85\x54\xac\xcf\xd7\x97\xef\x41\x66\x67\x0d\x58\x8b\xa1\x2c\x67\x8e\xbb\x32\x46\x27\xb6\x12\xdf\x71\x59\xd3\xa4\xf9\x2c\xdf\x5f\xa4\xe3\xfa\x2f\x49\x7a\x69\x3a\xf3\xe4\xa5\x10\xec\x8c\xd5\x6b\x99\xde\x19\x6b\x80\x3e\x97\xf2\x54\xbf\xe6\x96\x34\xae\x43\xc7\x22\xf2\x81\x9a\xe2\x45\x6e\xdb\x10\x77\x43\x4d\xe1\x90\x9b\xcd\x9a\xf0\x39\x7d\xb8\x38\x0d\x75\x3b\x38\xf3\x84\x3f\xef\xd9\x7b\x5d\x9f\xff\x10\x20\x7d\xfd\xf6\xc7\x73\xfe\x28\x71\xb0\x66\x18\xa9\xaf\x16\xf1\x15\x3d\x2c\xc1\x49\x20\xf1\x57\x38\x07\x32\xcb\xe7\x6b\x4f\xbe\xdf\x21\xe3\x72\x4f\x7d\x65\x36\xf5\x4f\x54\xdb\x1c\x2d\x9f\x3c\xd6\x8a\xb0\x3f\x74\x65\x84\x0d\xce\x18\x32\x90\x93\x93\x7c\x8e\x9d\x8a\x92\x60\xe4\xf8\x32\xa3\xdf\xc5\x06\xbf\xfc\x32\xb5\xd2\xe6\x3a\xc7\x5d\x59\x7e\x47\x5e\x6a\x68\x8f\xf7\xe1\xdd\x94\xf8\x95\x33\x93\xde\xc3\xb6\x2c\x6d\x5e\xa1\x26\xb8\x68\x29\xea\xed\xc0\xef\x1c\xf5\xa3\xd2\x0a\x60\xf1\xf5\xb7\x86\xa3\x42\x07\xb0\xb6\x5a\x77\x13\xd5\xf4\xcc\x04\x17\xca\x9e\x9a\xfc\x31\x25\x9b\x69\x68\x06\x64\x1f\x28\x3b\x7d\xe7\x97\xaa\x25\xb4\xde\xe3\xe5\xe3\x6e\x5c\x53\x12\x25\x3e\x84\xc6\x51\xb2\xa7\xac\xf0\xd3\xc2\xaf\x14\x9f\x73\xd2\xde\x9b\x9e\x94\xd1\x4d\xa0\x84\xf5\xa4\xd7\xf5\xf7\xa7\xba\xc5\xf6\xfa\x02\xc9\xee\xcf\x0f\x13\x0e\x41\x8b\xec\xe8\xbe\x04\x88\xda\xe1\x3f\x67\x48\xb6\xb2\x6e\x95\xd8\xd7\xe8\x73\xa6\xcc\x50\x2e\xb9\xb3\xbc\x6e\xab\x9f\x98\x0e\x1e\x72\xd4\x0e\x8c\xfe\xe5\x5c\x41\x2a\x83\x22\x39\xc1\x7a\xe4\xaa\x65\x2b\xb0\x6e\x1a\xe5\x0e\xeb\x76\x17\xbc\xaa\x68\x90\x08\x01\xf6\xe2\x61\xf1\x49\xde\x50\xdf\xc6\xd0\x90\xc1\x4e\x58\xc4\x31\xe6\xbb\x61\x9d\x9b\x57\xce\x64\x3e\x6f\x03\xa5\x59\x39\x72\xf9\xa4\xbd\x60\x2e\xc7\x75\xdd\x73\x9f\x72\x5b\xbc\x04\x54\x16\x2a\x67\x02\x46\xcc\xa7\x8c\x17\x8f\x72\xc2\x9c\x75\x30\x61\x9a\xc0\x6b\xb1\x1e\xb2\x38\xd2\x55\x3b\xc1\x6f\x3e\x29\xa6\x60\x27\x92\x5b\x03\x45\x05\xfc\xcb\x18\x3d\xaf\xe8\x0d\x26\xd6\xc0\x12\xff\x3a\x00\xed\x6a\x66\x2d\x55\xab\xc0\x5b\x38\x2f\xa2\xb1\x3e\xf2\x54\x0c\x2b\x28\x2a\x0a\xae\xc1\x1c\x94\x3c\x2c\x32\x5f\xcc\xd4\x64\x7f\x61\x47\xdc\xe5\x35\x58\x06\x86\xca\x5a\xa9\x52\xe4\xae\x06\x79\x07\x61\x48\xfb\xdc\x38\x2e\xcc\xa1\xa2\x28\xa4\xac\x01\x3a\xb5\xeb\x13\x21\xf8\xcf\x13\xb4\x4a\x7d\xa9\x0e\x83\x6f\xf2\xfe\xf7\x71\x51\xa8\x68\x18\xb6\xe2\x2b\xae\x2d\x68\xb5\x48\x3d\x9e\xa5\xde\x4e\xac\x99\xa0\xf5\x72\xa7\xc6\xfb\x51\xf3\x99\x19\x32\xc1\xa8\x3b\xaf\x28\xba\x9b\x7d\xc4\x3c\x3b\xe6\x70\x4d\x50\x41\x09\xa2\xe5\xb0\x05\x39\x40\xc5\x47\x88\x01\xad\xfe\xea\xc9\xc5\x84\x69\xe0\x2b\x9b\xe9\x36\xe4\x9d\xf6\x9e\xac\x3c\x59\xda\x92\x0a\x2e\xd8\xf8\x80\xd6\x78\x8f\x8b\xaf\xf8\x53\x06\x89\x38\xa2\x5a\x9a\x56\x0f\xff\xfb\x35\x20\xbd\x85\xa5\xd9\x0d\x45\x3d\xaf\x69\x31\x18\x88\xb2\x0b\x56\x96\xfd\x82\xb3\xa2\x9f\x64\x21\x80\x0f\x10\x41\x0a\x7b\x31\x79\x3c\xc9\x27\xe4\xd7\x2f\x2f\x1b\xe5\xa5\x7c\x42\x0e\xfb\xe5\xcf\x87\x0d\xfa\xad\x89\xac\xfa\x1c\xe4\x0d\xca\x97\xf0\x8d\xed\x0f\xf9\xa4\x65\x19\x49\x90\x83\x27\x71\xc5\x5a\x15\x9c\x39\x1f\x85\xd9\x2f\x4d\xf2\xc6\xe3\x7e\x70\xb9\x89\x2f\x48\xb4\x68\x89\x96\x5a\x8c\xa2\x8f\x59\xf9\xbe\x9a\x21\x73\x2a\x4c\x7c\x85\xcd\xb4\xb2\x94\xd3\x27\x7c\x17\xe3\x30\xe7\x9d\xb9\xb5\xc4\x26\x91\x92\x45\x99\x01\x77\x76\x86\x92\x6d\x8a\xdc\xaf\xd5\x21\xa7\xee\xdc\x85\xf3\xc5\xab\xcb\xbd\xa4\x17\xdd\x92\x64\xb6\x54\x73\x27\x5f\x95\x42\x14\x33\xd1\xa4\xf9\x14\x1b\xfa\xe8\xb5\x1b\xd4\x11\x6b\x45\xd7\xe2\x3c\x06\xfb\x34\xea\x23\x3d\xef\x37\x69\x57\x89\xba\x79\xe6\x70\x93\xcf\x21\xe0\x22\x05\x85\x6d\x95\xe5\x95\x28\xcd\x0e\x19\xd0\x2e\xfd\xdc\x2f\x30\x81\x34\x2e\xfe\x75\x7d\x95\xea\xef\x39\xb4\xdb\x0d\x3f\xed\x05\x9f\x61\x7f\xf7\x1a\x5c\x87\x5a\x2e\x15\xd7\xa2\x7d\xec\xdd\x59\x57\xf3\xf8\x19\xa7\xc0\xa0\xe3\x8b\x1e\x1b\xe0\xc3\xc1\x38\x90\xdf\x33\xf1\x8f\x48\xd7\x76\x7d\xf9\xa2\xf0\x7e\x7f\x2b\x6f\x32\x7d\x23\x43\x7c\x15\x6b\x1e\x59\x16\x1e\xac\xb2\x22\xde\x55\x13\x05\x76\x92\x1b\x51\xd5\x22\xb2\x7d\xe9\x9d\x09\xf7\x4c\x5c\xba\x24\xce\x87\x6b\x70\xf5\x69\x58\x1f\x97\x30\x47\x0c\x94\x4e\x5d\xfd\x5d\xfc\x25\x83\xc9\xf8\xa1\x2e\xcf\x96\xa4\x30\xc8\x6e\x61\x53\x57\xea\xde\xc8\x14\x89\x4d\x2f\xf6\x86\x3b\xed\x89\xd4\x80\x49\x0b\xd7\x00\x06\x6d\xe7\xc5\x95\xcf\xa6\x8c\x86\xb2\x75\xf7\xcc\xa0\xd4\x25\xbc\x1e\x63\xfb\x78\x5e\xea\xc1\x0d\x28\x21\x92\x27\xba\x56\xcd\xcb\x1b\x73\x33\xa8\x1a\x00\xfd\xc7\x33\x99\x25\xa2\x82\x60\xbc\xf3\x1f\x4b\xdf\x60\xc3\xf0\x37\xae\x8d\x99\xb5\xc1\x8f\x58\xc0\x50\xbe\x9e\xf0\xca\xf1\x4f\x08\xa7\xcf\xa8\x18\x5e\x7c\x32\xca\x41\x8c\x96\x48\x71\xc6\x0a\x57\xfb\xbf\xdf\x27\xd2\xfc\xe2\x1d\xe6\xad\x5d\x3b\x85\x5a\xb2\x7a\xe8\xa5\xd9\xf4\xec\x99\xd4\x83\x66\x93\x85\x2a\xdf\xf2\x10\xff\xf8\xfa\x45\xdb\x04\x1b\xb2\xc7\xa3\x00\xfe\x1b\x2b\xae\x59\x79\x42\x67\xa2\x61\x7e\x0c\x0a\x19\xed\x70\x73\x38\x84\xc7\x81\xba\x09\x2e\x5d\xa0\x42\x28\x4b\x8b\xb0\x7b\xfe\x9f\xf8\x69\x26\x0c\xf6\x8b\xcc\x09\x30\x66\x68\x11\x3b\xfb\xa1\xd8\xf7\x02\x31\xee\x67\xd9\x56\xbc\xfb\xa3\xd5\x55\xe2\x44\x12\xae\xe4\x95\xc5\xba\xb5\x33\xd3\xb8\xb7\xa0\x04\xb7\x33\x5e\xb4\x4a\xa9\x7e\xff\xb4\xac\x18\x9e\x33\xd6\x83\x96\xbd\x18\x52\x54\x69\x36\x1c\x47\x6d\x2f\xdf\xc5\xff\x8b\x2e\x36\x5b\x37\x68\x56\x52\xb0\x88\x64\x16\x56\xc7\x91\x9e\x77\x5b\xea\x3d\xe6\x21\xfd\x02\xdb\xd1\x91\x6e\x55\x8e\x9c\x1c\x08\x9e\xf0\x38\x17\x9f\xfc\xe1\xee\x49\x7f\x5c\xf0\xac\x20\x28\x23\x56\xa4\x5c\x1c\xa7\x85\x4a\x87\xc9\xcb\x6d\x27\xd8\xad\x52\x40\xdb\xc3\x7a\x34\x19\x23\x97\x93\x4c\x32\xb2\xf6\x16\x37\xb5\x96\x31\xee\x81\x53\x7c\xc2\x4e\x61\xcd\x9e\xa1\xff\x63\x52\xfa\x54\x75\x1b\xc5\xe4\xd5\x1a\xe7\xd8\xa8\x9d\x95\x30\xd0\x51\x94\xfc\x11\x54\x95\x4e\x27\xeb\x57\xad\xf4\xa3\xaf\x90\xd7\x17\x71\xc4\xd6\x7d\xf5\xc3\x9d\xd4\x5a\x6a\x33\x06\xea\xb2\x91\x16\xee\xd5\x94\x8f\x5b\xfd\xe2\xed\x97\x9a\xc0\x24\x82\xdb\x56\x9f\x7e\x88\x7f\xc7\x8b\xef\x66\x19\x0a\x28\x36\x99\x23\xd9\xa6\x75\x6f\x42\xe5\x4c\x80\x0b\xc0\x4d\x1c\xa3\x7e\x44\x2d\x61\x80\xc7\x7f\x6c\x29\x82\x4f\x9d\x70\xef\x4e\x1a\x2f\xfd\x4c\x1f\xc8\x89\x32\x13\x70\x88\x7d\x29\xac\xf8\xba\xdb\xfd\x59\x71\xbc\xaa\xa3\x73\x9f\x51\xe9\x71\x81\x64\x13\x69\x24\xf0\x0c\x71\xba\x4b\x95\x3f\x7c\x6e\x02\x94\xc2\x84\xbf\x73\xf3\x15\x72\x1f\x22\xe9\x20\xf4\x5c\xe6\x24\x3d\xe4\xf0\x0c\x32\xec\xfc\x82\xc3\xf2\x38\x35\x33\x42\x4e\x30\x36\x05\xf9\xcf\x2c\x6c\x69\x76\xcd\xbb\x26\x47\x03\xae\xa7\x9e\x5b\x90\x1e\x5b\x20\x4d\x11\xe0\x30\x43\x9a\x0c\xfa\xba\xcb\xbf\x05\xaa\xaa\x4e\x79\xb9\x61\xa5\x0d\x9c\x96\xc5\xb8\xd7\x5c\x0e\x1f\x5a\x96\xea\x6f\x2e\xde\x2c\x7c\xcb\x6b\x19\x50\xae\x16\x8c\x1a\x34\x9a\x53\x18\x11\x52\x03\x85\xbc\x12\x22\xd7\xe5\xcf\x6b\x00\x8d\xe0\x6b\x6e\x2c\xa4\x26\x7b\xf8\x7c\x48\xc0\xbd\x3f\x62\xa3\x34\x83\x78\x54\x4c\xaa\xef\xc5\x1b\xd0\x5f\x64\x5b\xaa\x42\xe4\xf0\x33\x4a\x3e\x4d\xc6\x77\x32\x3d\xf9\xe8\xa6\xa4\x4e\xf1\xd9\x03\xed\xd4\x64\x3c\x4e\xdd\xfc\xc8\x9b\x4f\xf0\xd5\x67\xd8\x23\x88\x24\xfc\x63\xb5\x7e\x96\x5d\xf0\xbe\x24\xd8\x24\x9b\xe4\xf8\x47\xaf\x8b\x96\x6d\xe5\x8f\x4a\x35\xa9\x81\xc9\xb4\xed\xe2\x52\xca\xd5\x6b\x58\xa5\x77\xf9\x99\x5f\x95\x96\x52\xcb\xa9\x30\x7b\x9d\xf8\xa5\x4c\xd2\x8c\x58\x65\x88\xf2\x3d\x58\x25\x3d\x36\x18\x42\x0b\x1a\xdf\x4b\x6e\xd5\x1d\xf5\x34\x92\xc7\x97\xe0\xb2\x8b\x0b\xb5\xc3\x6f\xc5\xde\xdd\x95\xbc\x0e\x2e\x42\x71\x36\x3d\xeb\xc4\x48\xaf\x98\x2f\x51\x1d\xbf\xcc\xb5\x3e\x2a\x30\xb0\x0f\x50\xb7\xef\x50\xc9\x24\xbb\x65\x94\x3b\xb1\x53\x27\x36\x39\x25\x32\x44\x8b\x62\x0a\x6e\x3e\x6d\xce\x97\x95\x91\xb3\xf2\xd2\x84\xcb\x13\x0a\x93\x42\x6e\xca\xef\x7c\x69\x0f\x5b\x54\xd7\x79\x08\x0a\x97\x05\xc8\xa7\x59\xc2\x6a\x28\x50\x92\x3f\x7b\x41\xe3\x40\x89\xfe\x8e\x59\x23\xb7\x30\x9d\x0a\x51\xaa\xb4\xba\x5e\xaf\x57\x2d\x4a\xe6\x9e\x64\xdc\x2f\x04\x6a\x24\xb6\xbf\x33\x6b\x19\x0f\xc3\x54\xaa\x28\xb2\x70\xad\xab\xb6\x89\x67\x3b\xca\xe0\x23\x97\xce\x4e\x56\x26\xad\x6e\xd1\x91\x47\x01\x4e\x47\x12\x50\xfa\xf6\x0d\x3d\x57\xc2\xaa\x85\x38\x5e\x2c\x1b\xa7\x05\x76\x0e\x1c\x6a\x0c\x02\x9c\x8e\x1f\x15\x3e\x7f\x63\x3a\xaa\x58\xfa\x71\x7e\x18\xbd\x66\x8d\xba\x85\x59\x6c\xa5\x01\x7c\x27\x2f\x94\xfe\xda\x77\x32\x5e\x2e\xa8\x92\xdb\x74\xdc\x94\x9c\xc3\x29\x14\xe8\x46\x39\x93\x74\x0f\x9e\x5b\xa9\x35\xfb\x52\x15\x4f\x7b\x8d\xa0\xed\xb0\x0d\xfc\x0c\x34\xca\x3e\x4e\xea\x75\xed\x8f\x43\x17\xbd\x86\xf7\xae\x75\xed\x93\xc6\x59\xf5\x6f\xe4\x56\xd2\xb4\x40\x38\xbd\xf9\x18\x41\x29\x2b\xd8\x7c\x1d\x33\x6d\x45\x09\xdd\x9e\x47\x76\xeb\xca\x10\x85\x72\x50\x3e\xcf\x55\x7c\xe3\x01\xae\x6e\xf1\x34\x62\x50\x60\xb5\xbf\x83\xf0\xa5\x78\x24\x17\x66\x61\xaa\x74\x3f\x83\xc5\x77\x7d\xfd\x91\x41\x21\xfa\x28\x14\x95\x52\x4c\x80\xbc\xc8\xa1\x70\x8e\x2e\x6c\x90\x4e\x2b\xa3\x1e\xc4\xed\xc8\x87\x05\x05\x14\x39\xe4\x0a\x5e\x3b\xb9\x80\xdc\x45\xce\x5d\xcc\xa0\x31\x2e\xd8\xdb\x0d\x3f\xe1\x10\x49\x47\x5a\x75\x99\xda\xec\x38\xdc\x51\x8b\x3a\x81\xc1\x37\x0e\xe8\x55\x0d\x5b\x7e\xf8\xed\x8c\x93\x93\xcf\x49\xc8\xb5\xc8\x87\x40\x84\xe2\x40\x73\xd1\xe6\xd4\xf4\xd0\xd2\x1f\xaf\xc3\xf7\xd7\xe1\xcf\x97\xe1\xf5\xf5\xe1\x73\xb8\xb6\xcc\x02\x85\x11\x64\xe8\xdc\x8f\x6f\x5c\xcc\xa1\x63\x97\x98\xe8\x56\x14\x61\x3d\xbc\xe3\xa1\xe7\x05\x85\x91\xf7\x11\x66\x4e\xf8\xc8\x37\xed\x9d\x03\xb7\x38\x45\x99\xcf\x52\x9b\xd9\xb0\x5d\x15\xee\xca\x9d\x66\x6f\x9c\x42\x01\x38\x22\xaf\x67\xe9\x27\x3a\xe3\x89\x04\x5c\x61\x94\xe2\xde\xd2\x8a\x99\x87\xd4\xa7\x99\xa7\x57\xfc\xa1\xc2\xc9\xbe\x1d\x73\x84\x28\x1f\x01\x05\xd2\x02\xfe\xa6\x91\x8e\x21\x27\xf6\x58\x92\x5e\xfc\xa5\xdf\xf5\xda\x24\xff\xb9\xdb\x1a\x85\x9a\xec\x1d\x2f\x1b\xa5\x99\xdd\x56\xb6\x37\x85\xa3\x6f\x0c\x3e\xc9\x77\x26\xd4\xb3\x66\x51\x00\x26\xc7\x56\x9d\x92\x2f\xd0\x75\xe9\x38\xc6\xc8\xe2\x37\x14\x77\x89\xa1\xf6\xbd\x4a\x40\x10\x9b\x67\xa0\x2a\x6d\xa5\x5f\xae\x0a\xc5\x03\xef\x7d\xe3\x5a\x32\xe9\x2c\xdf\xaf\x5c\x94\x53\x06\xfe\x77\x6c\x59\xc3\x8b\x43\x01\x85\x6f\xe6\xba\x97\xee\x8d\x89\xf3\xf2\x8b\x51\xe2\x34\x2a\x63\x03\x11\x7c\x96\xe0\x1e\xfb\x8e\xca\x9e\x39\x02\x1f\x8b\x57\x78\xe7\x1c\x55\x85\xd8\xe4\x03\x6a\x29\x4d\xfd\xbc\x43\xd8\x20\xfb\xc4\xdf\x22\x8f\xfb\x38\x1a\xaf\xc5\x80\xc8\xb4\xa2\xd5\x80\xbc\x7c\x79\xfd\x36\x30\xb1\xc5\x2c\x99\xbc\x8a\x4c\x7d\xc2\x85\x4a\xa0\x0b\x2c\x8a\x4e\xb6\xcf\xde\xfa\x16\x0d\x28\x4e\x25\xe3\xf2\xe5\xd5\xd0\x72\x18\x79\x93\x48\xcf\xa3\x4f\x37\xd7\x3e\xd4\x3f\xa4\xde\x32\xc5\xfd\x13\xfa\x9c\x69\x94\x50\x2f\xde\xf8\xb3\x38\x0e\x95\x37\x09\x6a\xd4\xa6\x9b\xaf\x19\x27\xcc\x59\xc9\xcb\x77\xb6\x1c\x43\x69\xf2\xb2\x05\x6f\xaa\xba\x68\xca\x11\x40\x52\x5a\x8c\xa3\x7c\xad\x59\x9f\xd1\x54\x2e\xe4\x64\x90\x81\xdc\x4f\x9a\xa3\x86\xc9\x68\xa1\x66\x50\xc4\x97\x9c\x9f\xdd\x91\xcd\xd5\xd4\x28\x7a\x3d\xac\x4a\x3b\xf5\xd7\x1c\xa8\xf4\xc1\xcc\xcc\xba\xfa\x2b\x9b\x1c\xc2\x1d\x55\x37\x18\xca\x63\x30\x40\x05\x83\xb2\xcb\xb5\x62\x36\xbb\xf4\x56\x58\xfd\xac\xd8\x8f\x9e\x81\x36\x56\xd3\xbf\xfb\xa8\x42\xee\x96\x80\xd8\x2c\xe2\x84\xea\x40\x62\xb7\x43\x1e\x77\xd2\x84\x51\x0d\xb9\x3a\xe0\x52\x12\x50\x00\x7c\xdc\xc3\x34\x6b\x27\x0c\x73\x49\xa7\x8d\xa9\x6a\xca\xe4\x43\x94\xe2\x7b\xfc\x15\x63\xf0\x55\x2e\xb3\x1a\xdb\xdc\x0d\x26\x69\x3a\xae\x17\xb4\x9e\xe4\x75\x17\x57\xaf\x46\x07\xae\x5f\x7f\x7c\x97\xb7\x30\x64\x53\xa9\x28\xe3\xcc\x26\x61\xf5\xc7\xb9\x2e\x75\x11\x46\x5f\x13\x0d\xc1\xa7\x55\x8b\x09\x51\x2e\xb5\x72\xad\xa6\xc7\x0b\x66\x6d\xf8\x17\xb2\x3e\xcd\xca\x2a\x62\x16\x87\xd3\xae\xf4\x7a\xdb\x41\x56\x70\x2a\xcc\x6f\xa6\x30\x76\x8f\xcd\x02\x93\x9a\x49\xcf\xb3\xe9\xb6\xbe\x54\xb9\x46\x4e\xda\xb5\x96\x3f\x5a\x48\x3b\x56\xc7\x27\x14\xd3\x0a\xcb\x73\xd9\x93\xb7\xa7\x8c\x6b\xf0\x8b\xfc\xf1\x3b\xb5\xb3\xbf\xc9\x2b\x6e\x82\xdc\x2b\x07\x9d\x76\x0c\xf9\xc4\x04\x4b\xe2\xe3\x23\x87\x60\x51\xb9\xa4\xaf\xd7\x71\x54\xee\x8e\x7e\xcc\xd5\xaa\xd9\x89\x8e\x01\x38\x49\x0a\x5b\xd5\x8d\xcd\x25\xad\x30\x61\xc5\xf4\x2c\x4d\xc9\x2b\x71\xb7\xb0\x9f\x0c\x64\x2d\x2a\x4a\x95\x53\x75\xe2\x73\x46\x0c\x57\x98\xc5\x08\x5b\x31\x0a\x99\x2a\x27\x5a\xaa\x92\x0c\xe7\x6f\x00\xcf\xde\x06\x2b\x83\x56\x2d\xe3\xc1\x8c\x69\x46\xc5\x8e\x57\xa2\xa4\x68\xdd\x32\xb7\xe0\x4f\x79\xd6\x3c\x03\xe0\xd7\xc1\xd7\x67\xbc\x0e\xed\x59\x95\x6e\x69\x93\x56\xe9\x75\x41\x8c\xab\xd7\x3c\xf3\xea\x70\x34\x14\xd7\xa0\xd4\xa7\x27\xda\x57\xb9\x7c\xae\x7b\xd4\xcc\x04\x6d\x40\x89\xbe\xf2\x22\x1b\x76\x6a\x57\x85\xd1\x9e\x03\x70\x96\xe4\x03\xa5\x52\x84\x7d\x91\x67\xb8\x52\xda\x77\x25\x21\xd9\x8c\x35\xaa\x2e\xa9\x5b\xce\xb2\x01\xc4\x4e\x4c\x94\x3f\xb0\xd0\x69\x96\xed\xdc\x02\xab\x5f\x80\x35\xf5\xa4\x16\x2b\x18\x3f\x79\xb3\xd2\xa2\x6c\xc5\x3e\x8a\x65\x85\x9c\x69\x63\x38\xa8\x56\x95\xd3\xd2\x2a\x0f\x25\xef\xb4\x31\x93\xb4\xee\x3a\xe1\xb9\x91\x97\xdd\x9a\x61\x9f\x88\xf3\x86\x49\xfe\xe6\x39\x3a\x19\x21\xc0\x41\x03\xa5\x26\x67\x6e\xa9\x30\x8e\xd1\x2b\x85\xd9\x7d\x30\xee\xe8\x43\x39\xe0\xc7\x3f\x74\xc1\x99\xf2\x8a\x16\x73\x51\x6e\xc3\xe0\xdb\x10\x1e\xfb\x7b\x75\xab\xf9\xb5\x64\x33\xd0\x0c\x34\xcb\x8b\xa0\xba\x3b\x3b\x26\xee\x28\x99\x55\xcc\x0a\xd3\xd9\x4a\xb4\x28\x25\x16\xf2\x79\x18\x1f\x5b\x43\x35\xc3\x05\x83\x4f\x81\x8c\xe2\x31\x14\xd7\x95\x55\x4b\x25\xa3\x45\x43\xc0\xa0\x5a\x91\x9c\x3e\x84\x46\xf9\xb2\x4e\x8f\x2b\xe0\xfc\xf5\xad\x69\x4c\x95\xec\x7d\x45\xf4\x11\xe5\xc8\xcd\x06\xd3\x14\xf0\x85\x59\x64\xa4\x26\x07\x17\xd6\x85\x58\xc3\xf3\x0e\x8c\x92\x7a\x7d\x99\x54\x8c\xac\x6b\xa1\x79\x39\x99\xc1\xfc\x0a\xcf\x34\x88\xee\x19\x6d\x69\xf1\x17\x3f\x6c\x45\x96\x35\x4d\x90\x6e\x1a\x70\xcb\x43\x82\x14\x31\xb5\xe4\xe5\x72\x88\x0d\x59\xd7\x49\x7c\x6c\x48\x4f\x88\x01\xa3\xdb\x23\xe4\xea\x75\xac\x68\xa5\x3c\xb4\x95\x8d\x2e\xc6\x4c\xc8\xcd\x36\x5f\x09\x54\x76\x10\x2c\x41\xab\xf7\xf1\x19\x2d\x9f\x6a\xf2\x5a\xdc\xab\x91\x07\x6d\xa2\x49\xbe\x09\xdf\x6a\x06\x23\xa5\x16\xfb\x7f\x5b\xb5\xc0\xb2\xed\x73\x31\xb6\xca\x41\x75\x39\x43\xc8\xe9\xf5\x35\xca\x1f\xe0\x6f\x41\x09\xc7\x1b\x30\x55\x0f\xc8\x3c\x33\xd5\x1c\x5c\x14\x2e\xd5\xb8\xf7\x05\x11\xf7\x77\xb3\xe3\xf1\x3b\x30\x04\xaa\x0a\xb7\x5d\x04\xb3\xcb\xab\xc5\xb8\xcc\x19\x6b\xcb\x67\xb7\x0c\xc3\x89\xbb\x5e\xb1\x65\xce\xb2\xdc\x13\x24\x3b\x41\x86\x4d\x49\x07\x1a\xbb\xbe\x7e\xf9\xf2\x43\x9c\x87\xeb\xbe\xff\xf8\x29\x1b\x22\x9c\x1c\x72\x09\xab\x9e\x2c\xb8\xee\x65\xb0\x57\xaf\xe6\x34\x60\x1d\x49\x89\x14\x9e\x5b\x82\x76\x93\xaf\xb8\xe8\xdd\x22\xf9\xdf\xa5\x36\x8b\xa9\x4a\x47\x5c\xa7\x04\x33\x49\xa7\x79\x30\x2e\x07\xd8\x76\xc6\x17\x08\xbf\xb2\xfa\x44\xfb\x49\x5e\x91\x53\xf0\xeb\x48\x1a\x0d\xfd\xec\x73\x18\x21\x69\x66\xcd\x7f\x9e\x4e\xbe\x00\x98\xa8\x1c\x89\xad\x80\x6a\x90\xf8\x0b\xd4\x0f\x89\x20\x30\x46\x53\xfd\xb8\xd7\x0c\x44\x3e\x64\x6d\x46\x79\xfe\xdc\x5c\x50\xe1\x9e\x00\x7b\xc1\x5c\x35\xda\x10\x05\x87\xce\x74\x60\xe5\x5e\x45\x20\xad\xe3\xb2\xec\x36\xb3\xa7\x12\xb4\x98\x1b\x1f\x49\x7f\xa3\xd5\x93\xc2\xb9\x7f\x5a\x54\xb7\x0e\x65\xba\x0e\x51\x70\xe1\x68\x3b\xf7\x5a\x7a\xe9\xaf\xf9\xd2\xd4\x9e\x49\x23\x15\x4f\x96\xea\x89\x25\xc9\xcb\xca\xf1\x47\x58\x57\x15\x90\x65\xc7\xa1\xd6\x7f\x60\x37\x79\x9e\x3e\xa9\x33\x1b\xec\x0c\x30\x17\xff\x7a\x9b\xb2\x46\x06\xa7\x49\x0c\x8c\x74\xd5\x7e\xf9\x39\x8d\x49\xf2\x86\x8e\x04\x97\x60\x7b\x26\x4e\x19\x9d\xd7\xbf\x1d\x20\x3e\x82\xc7\x52\x18\xe3\x49\x2a\x82\xf7\xa1\x56\x83\xcf\x7e\xf0\x86\x7e\xa4\xe7\x11\xd1\x7f\x1a\x33\x45\x3b\x23\x57\x31\x6a\xc3\xfc\x24\xff\xf3\x0e\xd8\x13\x5b\x84\xcb\x45\x96\xbb\xdd\x64\x9f\x8e\x73\xe4\xe2\x43\x36\x9d\x9e\x30\x35\x5c\xce\x1f\x71\x75\xe9\xc0\xe7\x91\xee\x60\x22\x39\x50\x59\x1d\x1a\xaf\x80\x8d\xfc\xa2\x44\x42\xef\x20\xbe\xb5\xf7\x43\xb8\xbb\xe6\x0c\x17\xd0\x6c\xcd\x67\x02\x43\xce\xd7\xcd\xab\xa6\xe8\x47\x21\x9e\xfe\x54\x52\x9e\xea\xff\xed\x5e\xd5\x70\xf3\x72\x65\xf9\x27\xa4\xbb\x8e\xcd\xf8\xf1\x8b\x4f\xba\x1b\xc5\x4c\x2d\x57\x92\xf6\x5a\xbb\xbf\x5f\x9c\xc9\xca\x51\x0a\xe9\xf7\xe7\xa5\xd7\x7f\xeb\xa6\x30\xb5\xbd\xe6\x6c\x0a\x1a\xc2\xf2\x42\x57\x95\x2b\xf3\x29\x93\x61\x75\x1c\x8f\x54\xb6\xf8\xf3\x32\xbd\xfb\x87\x08\xcc\x47\x87\xf8\x7b\x21\xa3\x44\xf3\x0b\xb5\x6c\x18\x2b\x32\xcb\x1e\xda\xfb\x70\x09\x78\xae\x75\x2d\x83\x0b\x14\xe4\x89\xe3\xe0\x06\xb6\xa0\x1c\xf7\x1c\xb5\x72\x78\x55\x12\x0a\x01\x6e\x90\xb9\x0c\x23\xec\x8c\x56\x97\x0f\xf8\x7b\x1c\xe0\xc0\x72\x49\x3d\xea\x05\x1b\x4c\x78\x21\xbe\x66\x0a\x58\xdc\xa8\x5c\xcd\xae\x57\x3f\x48\x4f\xbb\x5d\x1b\x09\xd9\xac\x14\x07\x9f\x4c\x59\x07\xeb\x67\x6f\x50\x51\x94\xea\x39\xd5\x83\x22\x47\x9e\xc7\xb3\xc7\xe2\xfc\xf8\xa1\xe5\xfb\xb0\xd1\x38\x15\x27\x1d\xa8\xe3\x94\x64\xf4\xcc\x04\xbe\x3a\x66\xc8\x21\xa5\xf8\xfe\x58\x26\x6a\xf9\xf7\xea\x8d\xb7\x20\xaf\xb4\x0e\x7a\x78\xc2\x1e\xc1\x27\xff\x69\xd6\x64\xa9\xb8\x45\xa0\xaa\x20\x51\x9d\x8f\x18\xbd\x62\xbe\x8c\x65\x88\x94\x47\x8d\xc2\x99\x9d\xe8\x9a\x95\xf4\x68\x9e\x40\xcf\xba\x74\x18\xba\xe2\x2d\x1c\x03\x7a\xec\x50\xb1\x11\xc7\x44\x20\x19\x45\x9e\x85\xc1\x75\x7f\x7c\xfd\x2e\x3e\x37\xc0\xac\xf6\x69\x7e\xd3\xaa\x2f\x76\xca\xcb\x38\x16\xe9\x10\xe8\x4a\x9b\x07\x37\x97\xb4\xc0\x62\xee\x23\x26\x77\x83\x12\xed\xf2\x94\xf1\x57\xdc\xb4\x23\xfb\xcd\x9f\x18\xc6\x25\x81\x1f\x4a\x45\x0c\x27\xae\x11\x97\x4f\xa1\xda\x72\xaa\x1d\x9a\x27\xa4\xed\x0c\x35\xe3\xac\x2e\x52\xcc\x04\x07\x5d\x05\xd6\xe1\xca\xff\x4f\xe4\xac\xec\xb3\x7b\x82\x04\x61\xaf\xde\xc8\x87\xfc\x11\xfb\x32\x90\x39\x9b\x23\x37\x9b\xac\xf9\xf3\xa7\x64\x11\x63\x64\x42\x39\xb4\x8f\x03\x4b\x3d\x90\xa0\x1d\x1c\xe8\xcd\x49\x71\x30\x2b\xad\x45\xde\xb0\xcb\x4e\x4e\x0e\xb1\xac\xa4\x24\xec\xba\x07\xdc\x65\x81\x34\x67\x83\x69\xa9\x14\xfd\x02\x8b\xc5\xcf\x09\xb3\x42\xbb\xb0\x24\xda\x02\xda\x19\xb3\xa6\x01\x77\x63\x55\x0f\xcd\xa2\x46\xce\x9c\x2b\x27\x42\xa1\x93\x1a\xc2\x8c\xd5\xe5\x26\xa7\xb0\x66\xac\x13\x47\x00\x55\xb5\x75\x0c\x2b\x68\xcc\x38\x9e\x25\xf6\xd7\x55\x6f\x31\x24\xe0\x4a\x6b\x61\x31\x05\x9a\xfd\x9b\x3c\x1d\x96\xae\xfb\xac\x40\x31\x0b\xec\xaf\xf2\x2d\x62\xb1\xd5\x62\x1c\x9e\x98\xff\x41\xac\xa4\xb2\x30\x66\x99\x65\xdc\x70\x6a\xfd\xf5\x55\x5c\x73\x77\x9d\xb7\x44\x11\x6c\x79\x26\x6b\xcc\xc6\x4b\xcf\x21\x59\x0d\xb9\xbf\x1a\x7b\xb1\xaa\x45\x49\x71\xf5\x01\x1d\x6a\xe2\x91\x07\x9c\xe6\xc4\x01\x9b\x24\xf7\x88\xc5\x03\x98\x9f\x5a\x7e\x0d\xb5\x19\xbc\x66\x59\x32\x07\xbb\x66\xbe\xb0\x14\x16\x6b\x33\xe9\x17\x18\xe3\xdd\x0b\x28\xfe\xc0\xd4\xd2\x24\x0d\x48\x57\xf2\x3f\x53\x63\x4e\x0a\xf1\x05\x14\x5a\x5c\xe5\x73\x69\xf2\x49\xa3\xe7\xb9\x11\xdd\x14\x65\x4a\x0c\xc8\x14\x90\x90\x77\x92\x0d\xab\x8b\x99\x4e\x4f\xb2\x38\x7c\x1d\x7c\x54\x7b\x0d\x51\x5a\xd7\xa7\x62\xe4\x1a\xd6\x52\xdb\x34\xf9\x98\x11\xac\x56\xcd\xc9\x81\x38\xd4\xc4\xe1\xef\x0a\x07\xd2\x63\xbf\xd2\xa8\x25\x47\x68\xed\x5c\x0d\xaa\x06\x74\xcf\xfd\x72\x8e\x45\xde\x0d\xbf\x99\xd7\x8f\xb3\x8b\x6f\xfc\x21\x0a\x70\xa1\x7b\x70\xac\x4d\xc3\xbc\x44\x0a\x6b\x63\x6c\x37\xf9\x26\xae\x2d\x8e\x21\x0f\xb0\x4d\x01\x8a\x2a\xb3\xfe\xdc\xdb\x63\x4f\x6f\xcd\x3e\xdd\x2b\x57\x14\xfb\x94\xcb\xb7\x31\x6b\xa8\x8c\x1e\xfe\xe5\x12\x60\xb0\xd6\xeb\x0a\xac\x16\x2f\x18\x98\xaa\xac\x0c\x7c\x03\x4a\xcb\xe2\x83\x9c\x58\x6a\xd0\x2f\xd2\x03\xcd\x0b\x8a\x5d\xcc\x96\xf3\xbd\xe4\xa4\xbf\x45\x59\x87\x94\x61\xee\x85\x70\x01\xd5\xd8\xfd\x11\x37\xd0\x21\xab\x73\xf3\xc5\x29\x37\x78\x4f\xd6\xd4\x56\xb2\x42\x71\x56\xf6\x64\x58\xb6\x5a\xfc\x91\x6e\x7d\xea\x39\x80\x3b\xa2\x5e\xa6\xa1\x20\x9e\xfc\x7a\xb2\xa4\xf8\x05\x09\x2e\xde\x28\x24\xa2\x2f\x3f\x7f\x0e\xdf\x7f\x0e\x2f\xdf\xfe\x1c\x5e\xbf\x68\x44\x5a\x86\x5a\x52\x63\x43\xbe\x9e\x8a\x91\xad\x59\xb6\x1b\x41\xae\xd1\xf2\xa9\x9f\x59\x8a\x69\x06\xc1\x63\x52\xd8\xf9\x0f\x6a\x4b\x7d\x6a\x2b\xa8\x46\xff\x97\x4a\xaf\xdf\x65\xd7\xc4\xea\xb2\x5f\x75\x31\xe5\xc4\x55\x62\x5e\xb1\x8b\x6b\x6e\xa5\xa2\x1d\xe5\x73\xc2\x56\xaf\x2a\x39\xc4\x1d\x9a\xf5\xa4\x72\xc6\xf1\x92\x98\x3e\x21\x0f\x59\xe5\xf9\xec\x1c\xc8\x5e\xd5\x7b\x65\x4d\x21\x4a\xb8\xb1\x37\x26\xc1\xcf\x5e\x10\xb7\x67\x78\x63\x3d\x30\xb5\xe1\xcd\xf9\x9d\x14\xc0\x96\x29\xad\x57\xdb\x08\xd6\xd9\x06\x4e\x61\xa9\x9c\x5a\xf6\x69\x51\xb2\x51\x34\x16\xcc\x17\xd4\x61\x3b\xfd\xe9\x5d\x47\x57\x72\x39\xcb\x5e\x32\x16\x63\xe4\x52\x73\x63\xe0\x52\x69\x7d\x91\xf9\x30\x82\xaf\x35\xe0\xe8\x67\xcb\x84\x55\x0a\x89\x82\x8d\xf2\x56\x3c\x9e\x49\xf6\x74\xd5\x0b\x49\x3a\x92\x44\xad\x02\x7c\x96\xbf\xf5\x53\x95\xd2\x48\xc6\xa6\x53\x46\x3b\x29\x45\x84\x4f\x1e\x73\x96\xbe\x67\xb9\xa5\x06\x16\xcd\x92\xb0\x6e\xbc\x66\xa5\xd9\xe4\x48\xa8\x2a\xf6\x36\xde\x53\x90\xd2\xf3\x52\x9e\x95\x4a\x32\x7f\x26\x9b\xb4\x62\x1f\x8a\xfa\xd4\x17\x92\x31\x2a\xec\xf7\x69\xc6\xff\xd2\x36\xa8\x70\x0a\x20\xd3\x18\x61\x2d\xfb\x93\x6f\xe4\x26\xe2\xc3\x8d\x71\x00\xa9\x4c\x98\x3f\xd3\x44\xfa\x4a\xb4\xbe\xc6\xbd\x68\xf0\x97\x0f\xe9\xb2\x27\x6a\x3f\x57\x0b\xa7\xdf\xcc\x17\xa9\x59\x80\x05\x8b\x23\x86\x39\x83\x02\x31\xf0\xdf\xe4\x7b\xe8\x88\x93\x55\x98\x39\x53\xae\xe1\x4e\x56\xce\x19\x0a\x53\xc4\xb6\xc3\xca\x4a\x96\x59\x2d\xc5\x18\xc7\x45\xa6\x4d\x5b\x5d\xd2\x32\x02\xd5\x21\xc7\xd8\xf6\x96\xa4\x20\x55\x6d\x09\x95\xad\x36\xfb\x52\x87\x27\x4a\x16\x17\x8e\xc4\x89\x4f\x99\x87\xb8\xcb\x47\x44\xd4\x9f\x4b\x2b\xfd\x6e\x53\x4b\xef\x47\xd3\x05\x29\x95\x8b\xf2\x58\xe3\xa7\xe7\x0d\xe7\xc2\x4e\xc0\x55\xae\xb2\xbd\x9b\x49\xcf\x12\x01\xa7\xc8\xc1\x2f\x4d\x38\xc0\x40\xda\x65\xfc\x7f\xf7\x53\xd9\xd0\x55\xf4\x77\xcf\x33\x91\xd5\x36\x43\xbf\xad\x4e\x1c\xd9\x16\x9b\xac\x8d\x89\x2c\x93\x41\xae\x73\x95\xbe\x79\xcc\x43\x79\xfc\x64\x9f\xb2\x66\xc2\x30\xbd\x8f\x12\xe0\x33\xd9\x9e\x92\xc6\xcd\x51\x11\x62\x97\x00\x52\x60\x65\x1a\xb7\xd5\x41\xfd\x21\x3e\xa5\x3c\x70\x91\xe9\xdc\xff\x61\x2e\x4e\xd9\x95\x49\xe0\xab\x97\x6f\x12\xd3\x54\x1d\xe5\xb9\x9c\x7e\x93\xeb\x7c\xdc\xa4\x1f\x27\x1c\xa7\x91\x43\x81\x96\x99\x80\x1a\x5b\x6c\xc9\xca\xb1\x83\x8d\x6e\x62\x6d\x55\xdc\x99\xd8\x5d\xc1\x12\xb8\xe5\x2f\x32\x2c\x92\x8d\x33\x07\x4c\xc9\xf9\xa0\xc9\x1f\x1d\xfe\x93\x87\xc4\x60\xac\xfd\x99\x24\xc8\xb3\x80\x58\x8f\x72\x1e\x64\x0a\xe2\xab\xba\xb3\x85\x56\x57\x0e\x99\x81\x54\xea\x2a\x9b\xca\xeb\x2c\xaf\xd9\x00\x6b\xa5\x35\x90\x59\x98\x04\x5e\x6c\xf6\xe6\x31\x2d\x4d\x89\x47\x32\x49\x33\x46\x2d\xf9\x71\x89\xfe\x6a\x48\x89\x0e\xe0\x89\x41\x78\xac\xcc\x98\xe4\x85\xcb\xe8\xec\xbb\xd0\xf9\xe3\xb7\x54\x62\x4e\xa0\x1e\x8c\xe7\x6a\x19\xed\x1a\x82\xb2\xbc\x03\xeb\xa4\xb7\x75\x74\x44\x17\x84\xf0\x85\x79\x4b\xc4\x86\xbb\x5f\x95\xfb\xa4\xe6\x76\x51\x14\xdc\x2a\x8c\xb5\xca\xa1\xe2\x29\x43\x5a\x82\x6c\xd2\x6c\xe8\x67\x9c\x71\xdc\xcd\xd0\x69\x00\x0f\xc2\x22\x79\xc9\x74\x47\x8d\xbd\x83\xd3\x24\x8e\xf3\x02\xe9\xa3\x8e\x56\x42\xd2\x32\xb2\x66\xf4\xf6\x89\xf3\x3a\x8e\xc3\x13\xe9\x5f\xee\xce\x70\xed\xf2\x00\x52\x77\x78\x57\x6d\xa8\x84\x8e\xd1\x7a\xb3\xeb\x9c\x93\x2c\xd2\x9a\x34\x97\xed\xe5\x8f\xaf\xca\xfa\x3d\x96\x42\xdc\x23\x9c\x65\x2f\x98\x77\x80\xc6\xb2\xf7\x14\xfa\xc8\x04\x09\x68\xea\x65\x95\x01\x49\x3c\x16\x11\x92\x0c\x2a\x62\xcd\x27\xa5\xda\xee\xbc\xd2\x7a\x7c\x8c\xf4\x0b\x85\x46\xd4\x06\x32\x23\x1e\x96\xe4\x00\x8f\xd3\x72\xa3\x0f\x61\xe8\xeb\x67\x58\x57\xa9\xb0\x9e\x76\x90\xf3\x1f\x5c\x5a\xc1\xd1\xbd\x21\x91\xc5\x92\x48\x11\x5c\x88\x34\xee\xab\x37\xba\xb0\xa0\x83\xbc\x58\x0a\xab\x53\x2c\xc3\xe0\x99\xfc\x4e\x1c\x95\xb3\x8f\xb4\x42\x56\x22\x0b\xde\xb4\xd3\xb3\x83\x3f\x9d\xc1\x54\xb9\x76\xb5\x6e\x7e\x9e\xbd\xfc\xe7\x7c\xdf\xf1\x46\xd0\x6c\xb6\x7e\xeb\xbe\x35\x58\x98\xbf\x6a\x2e\xc3\x4c\x8f\xb9\x9e\x2c\xe4\x55\x26\x12\x9c\x23\xdd\x28\xce\x83\xbf\x79\x95\xe1\x76\xab\xd3\x8a\xa4\x49\x1a\xbe\x9c\x3e\x24\x0f\x7f\x1d\x01\xff\xf7\xdf\xff\xff\x3f\xff\xfb\x5f\xd6\x67\x34\xf5\x3f\xfe\x35\x00\xa4\x75\x57\x4f\x91\x7f\x01\x00")
+
+func rulesTxtBytes() ([]byte, error) {
+	return bindataRead(
+		_rulesTxt,
+		"rules.txt",
+	)
+}
+
+func rulesTxt() (*asset, error) {
+	bytes, err := rulesTxtBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "rules.txt", size: 98193, mode: os.FileMode(420), modTime: time.Unix(1792214185, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -209,7 +230,7 @@ func uiGitignore() (*asset, error) {
 	return a, nil
 }
 
-var _uiAppJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x58\x5f\x6f\xdb\x36\x10\x7f\xcf\xa7\x60\xb5\xa0\x94\x1a\x47\x49\xf7\x32\xc0\x86\xd1\xf5\x8f\x31\x0c\x58\xbb\xa2\x49\x9e\x82\x60\x50\x24\xda\x56\x23\x93\x2a\x49\xc5\xcd\x5a\x7f\xf7\xdd\x51\x94\x44\x49\x74\x92\xae\x45\x5d\xa0\x96\x78\xc7\xbb\xe3\xdd\xef\x8e\xbf\x98\x56\x8a\x11\xa5\x65\x9e\x6a\x3a\x3b\x38\x38\x79\xf6\xec\x80\x3c\x23\xbf\xf3\x55\x26\x52\x22\x6e\x99\xbc\xcd\xd9\xb6\x5e\x4a\x36\x8c\xdc\x25\x65\x69\xde\x32\xa6\x52\x99\x97\x3a\x17\x1c\xdf\x7f\x69\x24\xf8\xf2\x36\xc9\x39\xd9\x88\xac\x2a\x18\x11\x4b\xa2\xd7\x8c\x80\xb0\xc8\xd3\x04\xd5\x63\x50\x39\x39\xb8\x4d\x24\x2c\xe6\x17\xb2\x20\x73\x12\xac\xb5\x2e\xa7\x27\x27\xcf\x7f\xfd\x2d\x3e\x85\x7f\xcf\xa7\xf0\x74\x1a\x40\x3c\xe1\xb2\xe2\x29\xee\x22\xe1\xa2\x60\x1b\xc6\xf5\x7b\x29\xb4\x88\xc8\x97\x03\x02\x9f\x7c\x49\x42\x7d\x57\x32\xf0\xe2\x8a\xe3\x4d\xa2\xd3\x35\x53\xe4\xc9\x7c\x4e\x68\x63\x82\x36\xbb\xf0\xe3\x55\x9f\x0f\x96\xd5\xdb\x5a\x70\xc6\x0a\x96\x6a\x21\xc9\xd7\xaf\x03\x0d\xf1\xef\x43\x2a\x5b\x76\x7d\x93\x6b\x8f\x56\x7b\x32\xeb\x3d\x54\x56\xe8\x86\x89\x1f\x4c\x15\xab\x4d\x42\x84\x7a\x9d\xab\xd9\x3e\x39\x1e\x21\xb4\xcf\x31\x54\xb0\x32\x9b\xc0\x59\xb3\x26\xb6\x9c\xc9\x37\x56\x10\xc5\x9f\x2a\x26\xef\x9a\xa0\x5e\x16\x45\x17\xc3\xd8\x45\xce\x33\xf6\x19\xec\x9f\x42\x59\x5c\xd9\x76\x9d\x43\x9d\x1b\xaf\xea\xd2\xe8\x5d\x91\xa7\x4f\xc9\x70\x09\xab\x61\xd7\x86\x67\xc4\xcf\xd1\x91\xd1\xeb\x7b\xde\xf5\x9d\x49\xa6\x2b\xc9\xc9\x2b\x21\x0a\x96\xf0\xa1\x53\x27\xea\x5d\xfd\x68\xb7\xef\xc3\x49\x5a\x08\xc5\x94\x7e\x2c\x4e\x1a\xf5\x79\x57\x3c\xbb\xf4\x4d\xc5\xbb\x27\x7d\x4e\xda\x62\x2e\x32\x76\x0e\x41\x93\x39\x44\xf7\xdc\x97\x31\x3c\x56\xa3\x3d\x42\x91\x6f\x83\x93\x42\xbb\x6f\x36\xd2\x19\x64\x1c\x3f\xdd\x01\x1a\x6f\x65\x22\xe1\xeb\x1d\x44\xf8\x98\x72\xf1\xaa\x28\x3c\xa5\xd9\x45\xe1\x16\x0a\x27\xb6\xf1\xa2\x31\x8b\x69\xc6\x42\x41\x25\x0f\xda\x1c\x6b\x99\x70\xb5\x14\x72\xf3\x81\x7d\x0a\xc5\xf5\xc7\xe6\x64\x98\x5b\x18\x5d\x10\xd6\xe5\x55\x6d\x13\x94\x42\x5c\x2d\x01\xad\x04\x35\xcd\x2a\xe8\xc4\x65\xa5\xd6\x21\xe3\x29\x44\x7c\xf1\xe1\xcf\xd7\x62\x53\x0a\x0e\x1e\xc3\x32\x22\x47\x24\x98\x07\xf0\xbf\x47\x0a\x26\x2e\xcb\xab\xc8\xe2\xca\x9e\x06\xcd\x7d\x14\x39\x0f\x83\xa7\x01\x48\xe0\xc8\x09\x5f\x55\x45\x22\x41\x29\xae\xc7\x5e\x48\xb5\x82\x91\x47\x27\xe4\xd2\xec\xa4\x55\x1e\x4b\x51\x69\x26\xe9\xa4\x5e\xe0\xab\x97\x3c\x87\x9a\x31\x0a\xef\x57\x18\x67\x9c\x0a\xbe\xcc\x57\xed\xc4\x0b\x0f\x95\x06\x39\x20\xef\x36\xcf\x98\x9c\x90\xc3\x4a\x16\x1f\x8c\x91\x66\x0d\x13\x61\xcc\x8d\x45\xb1\x80\xb1\x2b\xb7\xb9\x82\x58\x4e\x14\xd3\x3a\xe7\x2b\x45\x23\x0b\xbe\xbe\xe9\xb6\x32\xb1\x59\x0e\x69\xab\x3f\xe9\x61\x08\x9c\x4c\x89\x63\x6d\xe2\xc8\x34\xdb\x94\x05\x6c\xbe\x30\x3a\x78\x69\xa8\x56\x31\x5e\xeb\x4d\xd1\xd3\x86\xa3\x6a\x29\x8a\x82\x49\x50\x3e\xb3\x6a\xaf\xb5\x2c\x68\x87\x92\x68\x14\x56\x72\x0d\x47\xf4\xc7\x64\x45\x0f\x04\x64\xb4\xea\x68\x1c\x3f\x58\xdc\x5d\x53\x01\x1b\x56\xd8\x8f\x0a\xea\x48\x0f\x55\x2a\x4a\x06\x8f\xf4\x10\xaf\x2b\x78\x70\x2a\x85\x22\xa8\x10\x0a\x1a\x74\xd6\x8b\xb6\xaa\x80\xd1\x2f\x16\xf7\x76\x5d\xad\x13\x40\xbe\x12\xe9\x8d\x72\x01\xdc\x60\x5e\xb2\x4f\x67\x67\x21\x9c\x6e\x42\x36\x4c\xaf\x45\x36\x21\x59\xa2\x93\x09\x61\x12\xe6\xf7\x26\xea\x72\x60\xf0\x9e\xc8\x64\x83\x76\xfa\x2d\x5f\xef\x9c\x36\x16\x7a\x32\x93\x38\xb4\xdf\x65\xa2\x7d\xc2\xb9\x82\xde\x86\x23\xa4\x76\x13\xa3\x08\x7c\xe1\xd7\x6c\xcf\xee\xda\x23\xcc\x55\x42\xff\x58\x9c\xd3\x3d\x86\xdc\xc6\xae\xea\xc1\xea\x2e\xcd\x7c\x7b\xd6\x2c\x01\xc8\x9a\xb3\xd2\xd7\x50\x2f\x68\xd3\x63\x9c\x92\x14\xaa\xec\xb0\x8c\x93\xcf\xc7\xdb\xed\xf6\x18\x2d\x1d\xc3\x29\xeb\xde\xce\xe8\xce\x13\xaf\x6d\x6b\x53\xbc\xb0\xf6\x12\xc5\xd0\x3d\x3c\xec\xf9\x6f\xab\x2d\x99\x8a\xc6\xa3\x15\xea\xac\xe0\x52\x8a\x73\xbe\x14\xa8\x62\xb2\x14\x79\x47\x76\x23\x8d\xc5\xcd\xbe\x21\xed\x05\x49\xbb\xaf\x9f\xf9\xf6\x44\x30\x9d\x81\xcc\x99\x4b\xa1\x06\xc9\x1e\xe3\xb5\x14\x22\x05\x2e\x70\xce\x3e\x6b\xd7\xf4\x86\x29\x95\xac\xd8\xd8\xba\xb3\x31\x2d\x12\xa5\xde\x21\x1d\x9c\x8f\x96\x62\x05\x25\xd0\x21\x5d\xc3\x60\xa1\x51\x3d\x27\x29\xa1\xe3\x44\xec\xfa\xf7\xc6\xe4\x9e\x5c\x77\xaa\x51\x73\x75\xe0\xff\x75\x8b\x58\x12\x79\x84\x83\xa9\xcb\x16\x74\x67\x00\xc0\x0b\xa2\x5e\xcb\xa9\x97\x75\x73\xb9\x7d\x92\x64\xd0\x20\x5d\x27\xb3\x5b\x64\x27\xe3\x3b\xdc\x5c\x33\x56\x1c\xa7\x95\xc4\xeb\xef\x3c\x91\x2b\x66\xe8\x95\x5d\x57\x32\xb5\x37\x59\xd4\x70\x05\xb8\x09\xe4\xe0\xf4\x86\x4c\x95\x35\xd8\xc7\x0c\x8c\xe6\xbc\x84\x51\x16\x5d\x9e\x5e\x79\x68\x9e\x94\x8b\x7d\xfb\x62\x10\x0a\xb9\x67\x23\x57\x08\x20\xf0\x1a\xdf\x26\x45\x35\x28\xef\x43\x89\x7c\xff\xf7\xd9\x79\x00\x63\x57\xa9\x29\x1a\xda\x99\x21\xb4\xb0\x3d\xf2\x40\x5b\x20\x1a\x9f\xa0\x7a\x07\xb7\x7d\xb0\x6c\xa3\xc3\xbf\x08\x02\x1f\x31\xe9\xbd\x75\x49\x75\xc0\xa3\x92\x5b\x36\x2a\xe7\x84\xa8\x61\x68\x0d\x2d\x03\x57\x8f\xae\xe9\x9e\xa4\xa2\x99\x7e\xb5\xef\x2d\xaa\xaf\x00\x2d\xc0\xc6\xb6\x7e\x10\x06\x7a\xd3\x29\xe0\x6c\x0b\x29\x21\xb9\x82\xaa\xc2\x21\xfa\xf0\xc4\x8a\xc1\xe2\x7c\x0e\x02\x4f\xa5\xec\xb4\x44\x26\x1b\xa7\x09\x4f\x59\xd1\x34\xcd\x90\x09\xf6\x77\xed\x87\xd8\x0b\x70\x46\x8f\x3c\xec\x0b\x02\x40\xf4\x5d\xf8\xc0\xe7\x2b\x7e\x06\xa9\xd0\x6e\xf9\x47\x65\xff\x8e\x30\xde\x2c\xfe\x5a\x9c\x2f\x02\xaf\xe3\x3a\x0f\x8f\x19\x23\xdf\x8d\xb9\x47\xc1\x04\xb0\xe1\xce\x68\xf7\xb5\x99\xcf\x2c\xcb\x91\xde\xb8\x23\x7a\xe6\x3b\x1a\xea\xfd\xa4\x83\x79\x9a\x29\xb9\x2e\x98\xb7\x9f\xe0\x48\xee\x90\xf2\xb4\xc9\xb7\x27\xea\x08\xc8\x0a\x69\xf2\xf2\x83\xfa\xae\x3f\xf9\xe6\xc3\xb1\x66\xc4\x6e\x04\x01\xc1\x8b\x33\x18\xd2\xaa\x5d\x9f\x1c\x02\xb1\x0e\xf1\xe7\x98\x09\x31\xc3\x64\x40\x06\x2b\xf3\x9b\x4a\x0b\xf2\x00\x79\xb8\x63\xb1\xcf\x17\xd1\x0c\x34\x56\x67\x6c\x5a\x7f\xed\xba\x0d\x35\x31\xf2\x33\x4b\x7b\x37\x3c\xc0\x2c\xf1\x83\xec\x62\x6a\x1d\x4f\x06\x45\xe8\xb3\xc0\x69\x6f\xa5\xaf\x6b\xd9\xdf\xf4\x7b\xc9\xdf\x4f\x22\x78\x3e\x12\xdd\xa6\x75\xf0\xf7\xc1\x43\xec\xee\x7f\xf1\x25\xfb\xed\x80\xa8\xe1\x42\x4c\xbf\xc9\xef\x80\xba\x25\x39\x57\xce\x0f\x1a\xe1\x00\x4c\x59\x86\x4c\xdf\xfe\x60\x14\x43\x37\xdb\xfe\x55\xaf\xee\x10\xb2\x21\xcd\xf2\xbb\x7f\xb2\xda\x8c\xc1\x7f\x77\x15\x03\x48\x03\x47\x0a\x43\x3c\xcb\xea\xdb\x2f\x1a\xc7\xa3\xc5\x6a\x55\x30\x37\x10\xc4\xe4\x20\x18\x66\x34\xee\x89\xc6\xec\x71\x83\xc0\x5d\x0d\xa7\x80\xdd\x71\xba\x66\xe9\x0d\xcb\x5e\x50\xc1\xe9\x94\x8a\xe5\xd2\x69\xf4\x61\x57\xb9\x5c\x73\xd8\x03\x2d\xfe\x91\x64\x76\xb5\x30\xd0\xef\x77\x9e\xf9\x23\x32\xa8\x4d\x0d\x51\x77\x0f\xe2\x1e\x81\xb6\x47\x21\xed\x1b\x50\xb6\xf3\xcd\x7f\x1f\xb2\xa2\x19\x02\x6a\x67\x7e\xb5\x98\x1d\xfc\x17\x00\x00\xff\xff\x5a\x7e\x3b\x2d\x42\x16\x00\x00")
+var _uiAppJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xe4\x1a\x4d\x6f\xdc\xb8\xf5\xae\x5f\xf1\xa2\x06\xa1\x14\x2b\xb2\x93\xcb\x02\x33\x10\xdc\x6c\xec\x2e\x0a\x74\xb7\x41\xec\xf4\x62\x18\x81\x2c\xbd\xf1\x28\xd6\x90\x0a\x49\x79\xe2\x7a\x75\x2c\xd0\x3f\x50\xb4\x87\x1e\x7a\x29\xd0\x4b\x51\xa0\x97\x5e\xfa\x77\xb2\xbf\xa3\x20\x45\x69\x28\x0d\x67\x3c\x4e\xd2\x45\xb3\x9d\x09\xe0\xd1\xfb\xe2\xe3\xfb\x26\x15\x52\x0b\x04\x21\x79\x91\x49\x32\xf5\xbc\xfd\xc7\x8f\x3d\x78\x0c\x3f\xa7\x97\x39\xcb\x80\x5d\x23\xbf\x2e\x70\xd9\x82\xd2\x05\xc2\x4d\x5a\x55\xfa\x29\x47\x91\xf1\xa2\x92\x05\xa3\xea\xf9\x67\x1d\x46\x3d\x7c\x9b\x16\x14\x16\x2c\xaf\x4b\x04\x36\x03\x39\x47\x48\xab\xaa\x2c\xb2\x54\x91\xc7\x1e\x3c\xde\xf7\xae\x53\x0e\x69\x55\xbc\xe6\x25\x24\xe0\xcf\xa5\xac\x26\xfb\xfb\x4f\x9f\x7d\x15\x1f\xc4\x07\xf1\xd3\xc9\xd3\x67\x5f\x1d\xf8\x53\xcf\x0b\x66\x35\xcd\x14\x17\x04\xc7\x25\x2e\x90\xca\x97\x9c\x49\x16\xc2\xad\x07\x00\x50\xcc\x20\x90\x37\x15\xb2\x19\xd8\xe8\x78\x91\xca\x6c\x8e\x02\x1e\x24\x09\x90\x4e\x04\xe9\xb8\xd4\xd7\x49\x9e\x8c\xc0\xe2\xdb\x16\x71\x82\x25\x66\x92\x71\xf8\xfe\xfb\x11\x05\xfb\xed\x5d\x24\x4b\xbc\xb8\x2a\xa4\x83\xaa\xdf\x99\x59\x3d\x10\x06\x69\xab\xa9\xbe\xca\x54\xd8\x8a\x84\x04\xe4\xbc\x10\xd3\x4d\x78\x01\x09\x04\xe6\x77\x9c\xb3\xac\x56\x40\xa5\x75\x07\x63\x4b\x8a\xfc\xc8\x20\xc2\xf8\x5d\x8d\xfc\xa6\x53\xea\x79\x59\xae\x74\x58\x5f\xa2\xa0\x39\xbe\x87\x04\x0e\xa6\xde\x00\xb7\x9c\x17\x25\xf6\xab\x8a\x33\x4d\x77\x0e\x8f\x1e\xc1\x18\xa4\xbc\x61\x60\xe3\x3d\xaa\xef\xde\x9e\xa6\x1b\xae\xdc\x0c\x17\xe3\x28\x6b\x4e\xe1\x6b\xc6\x4a\x4c\xe9\x78\x51\x4b\xeb\x66\xea\x59\xec\x9b\xe2\x24\x2b\x99\x40\x21\x77\x8d\x93\x8e\x3c\x59\x39\xcf\x80\xee\xe5\xbc\x2d\xe6\xb3\xcc\x16\x53\x96\xe3\xe9\x4d\x85\x90\x24\x09\x3c\x1d\x0b\xee\xb6\xd5\x51\xaf\x45\x91\x8b\xc1\x32\xa1\xe1\x1b\x5a\xdb\x61\x71\xf5\xcf\xd0\x42\xef\xbe\xb8\x4a\x39\x52\xf9\x1d\xcb\x71\xea\x6d\x61\x36\x6b\xd1\xba\x2c\x1d\xae\x69\xc2\x60\x59\xd0\x9c\x2d\x63\x63\xe5\xb8\x52\xe9\xa8\x1c\x15\x4e\x3d\xaf\xb7\xb1\xe4\x29\x15\x33\xc6\x17\xaf\xf0\x5d\xc0\x2e\xde\x76\x3b\x53\x51\x29\x24\x87\x04\xce\xce\x5b\xf1\x33\xc6\x03\x05\xad\xa0\xa0\xa0\x28\x35\x54\x48\x1e\x57\xb5\x98\x07\x48\x33\x96\xe3\xeb\x57\xbf\x7c\xc1\x16\x15\xa3\x48\x65\x50\x85\xb0\x07\x7e\xe2\xc3\x1e\x38\xb0\xec\xe2\xed\x59\x75\x1e\x9a\xb8\x32\xbb\x51\xe2\xde\xb2\x82\x06\xfe\x23\x3f\x9c\x7a\x8d\xe7\xa5\xf4\xb2\x2e\x53\xee\x01\xc4\x6d\xd9\x0b\x88\x14\x69\x55\x91\x08\xce\x34\x27\xa9\x8b\x98\xb3\x5a\x22\x27\x51\x0b\xa0\x97\xcf\x69\xb1\x48\x25\x12\x0f\xe0\x3c\x54\xac\x19\xa3\xb3\xe2\xb2\xaf\x78\xc1\x43\x21\x53\x89\x2f\x39\xbb\x2e\x72\xe4\x11\x3c\xac\x79\xf9\x4a\x0b\xe9\x60\xca\x10\x5a\xdc\x3a\x2a\x66\x72\x8e\x7c\x59\x08\x0c\xc8\xbe\x40\x29\x0b\x7a\x29\x48\x68\x82\x6f\x28\xba\xf7\x4c\xac\xc1\x01\xe9\xe9\xa3\x41\x0c\xd5\xbc\x9c\x80\x25\x2d\xb2\x70\x12\x17\x55\x99\x4a\x7c\xad\x69\x54\xd3\x10\x3d\x61\x3c\x97\x8b\xd2\x6c\xbc\xfd\x97\x31\x2a\x39\x2b\x4b\xe4\x13\x20\x27\x86\xec\x85\xe4\x25\xe9\x89\x9a\x70\x4d\xad\xf4\x82\xd5\xd2\xad\x93\x41\xdd\xa1\x90\xa6\x6a\xb5\xe9\x29\x1b\xed\xdc\xa6\xf3\x80\x51\x2b\x18\x6a\x15\xc1\x19\x79\x28\x32\x56\x21\x89\x80\x3c\x54\xed\x8a\x44\x7d\x11\x08\x5a\x54\x04\x1a\xd1\x45\x67\x0b\x34\x5e\x85\x04\x6e\x9b\xa9\x0d\x97\x35\xa5\x58\x0a\x3b\x78\x8d\x38\xe0\xf8\xee\xe4\x24\xa8\x79\x19\xc1\x02\xe5\x9c\xe5\x11\xe4\xa9\x4c\x23\x40\xce\x8f\xd8\x22\x5c\xed\x5f\xc7\x7a\xca\xd3\x85\x92\x63\x9b\x05\x0c\xe7\xa4\x93\x30\xc0\x69\xa3\x29\xf9\x3d\xb4\xe9\x7f\xa9\x9a\xa2\x56\xeb\xb6\xd1\x7d\xda\x65\x62\x85\x82\x44\xeb\x63\x65\x74\xff\x4b\x71\xb7\x2b\xc2\x83\x04\xc8\x37\xc7\xa7\x64\x83\x20\x3b\xa9\xeb\xb6\xa8\xda\xa0\xa9\x8b\x67\x8e\x69\x8e\x5c\xef\x95\xbc\x60\x54\x22\x95\x4f\x54\x85\x24\x13\x20\xd6\x84\xb1\xff\xfe\xc9\x72\xb9\x7c\xa2\x2a\xc6\x93\x9a\x97\x6d\x5e\xe7\xa4\x71\xec\xd6\xa4\xb4\x76\x5c\xd0\xae\x12\xc6\x72\x8e\x34\xe8\x49\x6c\xd7\x04\x1c\x85\x65\xfe\xee\x9b\x31\x2a\x58\x89\x71\x41\x67\x4c\x91\x68\x2b\x85\xde\x88\x4a\x4f\x2b\x1d\x36\x66\x57\x63\xc3\x74\x9f\xb5\x00\xe9\x79\x86\x56\xef\x3e\x0d\x60\x29\x50\x4b\x37\x01\xb2\x41\x70\x8b\x8d\x0b\x4a\x91\x9f\xe2\x7b\x69\x8b\x5e\xa0\x10\xe9\x25\x4e\xb7\x31\x66\x65\x2a\xc4\x77\x6a\x0c\x4c\xd6\x40\xb1\xa8\xca\x42\x06\x64\x5e\xe4\x48\xc2\xb6\x3e\x12\x20\xeb\x46\x58\xd9\x5e\x7d\x9b\x68\x8b\x9d\x57\xa4\xa6\x00\x37\xa6\x0c\xab\xf4\x30\xc3\xe3\x1e\x90\x7d\x63\x29\x12\x81\xff\xcd\xf1\xa9\x1f\xda\x69\x56\x71\x76\x81\x76\x96\xb5\xae\xbe\xf5\xc6\x79\xa2\x59\x57\xda\xe8\x14\xe9\xd7\xf0\xf7\x5b\x39\xbe\xc6\x37\xe3\x10\xd9\x12\x1e\x3b\xf8\x7c\xac\xe9\x16\x77\x37\x9e\xc3\x70\x2e\xa3\x85\x53\xdb\x08\xc5\xa2\x62\x5c\x62\x6e\x9b\xc1\xc6\x9c\xc8\x54\xd6\x6a\xe9\xdb\x34\xcf\x31\x9f\x00\xf9\xf0\xaf\x7f\x7e\xf8\xc7\xbf\x3f\xfc\xee\xaf\x24\x82\xbc\x6e\x33\x0b\x0d\xfc\xef\x7f\xfa\xf0\xe7\xbf\x91\x08\x0a\x7a\x9d\x96\x85\xa2\xfe\xe1\x8f\x7f\xf9\xe1\x0f\xbf\x27\xc3\x02\xd7\x8a\x3e\xed\xa3\xb8\x57\xd3\xb2\x90\xaa\x5f\x45\xa5\x02\xb1\x1b\x56\xe3\x4b\x94\x66\x1a\x10\x5f\xdf\xa8\x60\x0b\x48\x2b\xe9\x8d\xb2\x09\x09\xcf\x0e\xce\x7b\xf6\xb1\x2f\x07\xfe\x7c\xf9\xeb\x93\x53\xdf\x51\xf7\x2c\xa7\xb6\x82\x47\x44\x6a\x99\x09\xdc\xb6\x7f\x8a\x4a\xc6\xd7\x69\x59\xe3\x28\x50\xc7\xd5\x6b\x32\x28\x5e\x43\x5a\x53\xb5\x26\x9f\x5a\xb4\x3e\xa2\x30\x0d\x5c\x81\xf9\x38\xb8\xd4\xc9\xa0\x0b\x88\x8d\x6c\xc7\x9c\x33\xbe\x53\xa9\xd8\xb1\xbe\xf5\x36\x55\x27\x3f\x7f\x5d\x4c\xe3\x8d\x00\x3b\x66\xbc\x23\x35\x76\xae\x29\x66\xc7\xf8\x5e\x05\xc4\x2b\xcc\xd3\x4c\x05\xe5\x2c\x2d\x05\x4e\xd7\x09\x94\x1a\x56\x40\x2b\xaf\xa7\x32\xbc\x1d\x77\x15\x4b\xe1\x96\xef\xb0\xa5\x4c\x08\xec\x41\xfb\x13\xf6\x20\x18\x88\x36\x6b\x1f\x02\x79\xc4\xf5\xcf\x84\x51\x02\x13\x20\xc4\xa5\xaf\xa8\x2f\xfa\x63\xf8\xc6\x51\xa2\xbe\xf8\x92\x66\x09\x0b\xff\x53\x1a\x11\x36\x38\x6c\x4b\xad\xef\x0c\xf8\xc0\x4a\x29\x7d\x3c\xfc\x7f\xe9\xf1\xf5\x85\x9d\xf2\x03\xcb\x39\x5b\xbd\xa8\x2f\x9e\x6b\xc9\x83\xf8\x4d\xf3\x7c\xb2\x5a\xf3\x21\x5e\xab\xdb\x87\xa1\xed\x54\x17\xd2\xc7\x48\x83\x8e\xb3\x9a\xab\xe3\xed\x69\xca\x2f\x51\xaa\x22\x69\xe0\x82\x67\xa6\x37\x85\xdd\x5d\x40\x40\x24\x1f\x59\x60\xd5\xd4\x24\x5f\xbf\x61\x21\x05\xad\x6a\xa9\xfb\xd8\xd0\x11\x8a\x0d\x39\x3f\xde\xc4\x17\xa3\x2a\xc4\x0e\x46\xc5\x34\x70\xf8\xb8\xa8\xee\x60\xcc\xb6\x59\xc2\xad\xce\x66\xab\xe9\xa9\x18\x38\x36\xe1\x3e\x74\x9c\x3b\x56\x87\xba\x7c\x9e\x0e\xd0\x84\x9e\x23\x94\x38\xce\x38\x8a\xb9\xe5\xdb\xf0\xf6\xde\xbb\x7e\x7d\xea\x3b\x85\xe7\x58\xa2\x44\x4b\x76\xcd\xcb\xfb\x89\x3f\xac\x79\x99\x90\x3d\xc7\x9d\x82\x12\x15\x81\x7f\x74\xfc\xab\xe3\xd3\x63\x7b\xf9\xf5\xf2\xfe\x8e\x8f\x3a\x8d\x10\x5b\xbb\xcc\x3b\xae\x56\x3b\x14\x42\x77\x18\xc7\xda\x42\x38\xbb\x88\x70\xe4\x8d\xc8\x52\x6a\x19\xa0\xa8\x5c\x59\x63\xe2\x55\xf9\x73\x90\x0f\xf7\x09\x5f\x25\x67\xa6\x46\x13\x8a\x4b\xf8\x05\xe3\x8b\xa3\x54\xa6\x41\x38\x24\x9a\xe5\x71\x5a\x55\x48\xf3\x80\xcc\x8a\x52\x9d\xc2\xd5\x9a\xea\xa7\x38\x3b\xb0\xef\xfd\xee\x0a\xaf\x3b\x93\xc5\x35\x53\xde\x35\x57\xba\x66\xcb\xd6\x17\x0e\x42\x55\x8a\x27\x30\x1b\xb5\x51\xf7\x50\x69\x2e\x96\xe2\x22\x47\x2a\x0b\x79\xb3\xce\xb3\x79\xb8\xac\x69\x8e\xb3\x82\x62\x3e\xce\x26\x47\xf3\xda\xa1\x81\xdd\x7f\x0e\xdb\xd4\xc4\x36\x55\x05\xa7\x7b\x76\x6b\x5e\x3d\xeb\xa8\x7d\x1d\x7f\x44\xf3\x5a\xa5\x87\xfd\x69\xa2\x35\xd0\xd0\x60\x43\x96\x70\xea\x2a\x2b\x3f\xfd\x5e\xb4\x2d\x42\x56\x3d\xa6\xf8\x42\x7a\x8c\x48\xaf\xed\x26\xd0\x3a\x2c\x82\xf6\xf4\xe1\x70\x9c\xba\x1d\x87\x04\x76\xf6\xdc\x9a\x00\x3d\x85\x28\x29\x43\x97\x4e\x5d\x3e\x15\x9b\x9d\x93\x17\x12\x8c\x6b\x3f\x93\x5f\x87\x87\x03\x9a\x2e\x50\xbb\x50\x15\xdf\xd6\xca\x11\x74\x6e\x15\x67\x4f\x7b\x18\xc7\x45\xca\xaf\x84\x81\x3f\x33\xf0\x66\x28\x7b\x53\xcc\xec\xab\x26\xd6\xda\x3a\x2e\x72\xd3\xae\x23\xa3\x86\x89\x1a\x97\xd7\x90\xa6\x17\xa5\xed\x37\xa7\xbf\xee\xb9\xea\x6d\x2b\x35\x9f\x74\x38\xf3\x0c\x87\x40\xd8\x6c\xa6\x8f\x68\x8c\x12\x77\x1c\x2d\xd8\x20\x8e\x5a\x09\x11\x08\x89\xd5\x27\x6a\xc5\x78\x8e\xbc\xd7\x49\x3f\xc1\x9e\x16\xdc\xec\x36\xd8\x7c\x9a\x6d\xd6\x87\x98\xd5\x52\x59\x4a\x33\x2c\x77\xa9\x77\x3f\x4e\xda\x48\xbe\x6a\x06\x90\x0c\x1e\xbb\xde\x80\x79\xa1\xee\xfc\xed\xf6\x30\x75\x6d\x4d\xd1\xfd\x48\x1b\x13\xeb\x3b\x53\x71\xe7\x1c\xb1\x24\x8f\xc5\x3c\xcd\xd9\x52\xb0\xec\x4a\x8c\xf7\xff\x71\x86\xda\x4b\x80\x40\x67\x97\xcf\x54\x4c\x86\x05\x3c\x19\x57\xe7\x51\x23\xdf\x4b\x7c\x50\xb7\xca\xfe\xd4\x39\x2a\x77\x5e\x00\x81\x32\x50\x85\x29\x02\x5d\x64\x2c\x77\x28\x45\x6b\x3d\x48\xf7\x21\xed\xab\x97\x53\x96\x44\x57\x7d\xb3\x84\x4d\x60\x5c\xb8\x3e\xea\xea\xb1\xe6\xa5\xeb\xae\xd1\x54\x34\x6f\xfb\x2c\x68\x43\x22\x6f\xb7\x19\xf0\xbf\x7e\xc1\xf8\x31\x6f\x3e\x5c\x37\x42\xdd\x67\xfc\xd2\x6c\xc0\x34\xf5\x46\xd4\x26\x04\x1c\x19\xba\xae\xfa\x8a\x34\x34\x7f\x1d\x07\x21\x94\x47\xc5\xcd\x11\x5b\xa4\x05\xdd\x76\x65\x9d\xe7\x77\xdc\x58\xe7\xc5\xcd\x9b\xbc\x15\x33\xbc\xb1\x56\x41\xea\x5b\x58\x3f\x82\x3c\x6f\xbb\x62\xe8\xd4\xe7\x55\x5d\xe2\x36\x55\xb8\xc1\x6f\xd3\x46\xd3\x7c\xde\x9b\x73\x95\x3d\xce\x6b\x73\x95\x34\x13\xf0\xf5\x92\x7e\x9f\x3d\xfa\xb1\x9b\xf7\xbe\xc4\x38\x37\x0e\xd1\xfb\x58\xbb\x10\x67\x57\x70\x08\xbe\x0f\x93\x1d\x4e\x2b\xff\x5b\x99\xe0\x0c\xb9\xdf\x98\xa9\xb9\xe7\x52\x3e\xbd\xef\x4b\x1b\xcd\x33\x8e\x7c\x05\x8c\x56\x93\xb9\x7b\xf9\x97\xac\x2c\xb2\x9b\x0d\x21\xaf\xa4\xf8\xe5\xc5\x9b\x4a\x13\xf9\x51\xc7\xd7\x9a\x29\xee\x31\x0e\xd1\x92\x5d\x5e\x96\x77\xed\x0b\x4b\xbc\xe7\xbe\x14\x57\x77\xce\xc0\x12\xe3\x6c\x8e\xd9\x15\xe6\x87\x84\x51\x32\xd1\xa3\xe1\xd4\x61\x03\xcd\x11\x4e\x6d\x2d\x47\x19\xb9\xe3\x7b\xc9\xee\xff\x58\xdc\xff\xcd\xe4\x0e\xa5\x7b\xa7\x60\xbd\x47\xa0\x36\x9e\x23\x40\xdd\xaf\x2f\xc1\x03\x68\xce\x43\x0f\x60\xea\xfd\x67\x00\xb8\xe6\x25\x29\xa4\x28\x00\x00")
 
 func uiAppJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -224,7 +245,7 @@ func uiAppJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "ui/app.js", size: 5698, mode: os.FileMode(420), modTime: time.Unix(1484362036, 0)}
+	info := bindataFileInfo{name: "ui/app.js", size: 10404, mode: os.FileMode(420), modTime: time.Unix(1792214333, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -469,7 +490,7 @@ func uiVendorLessMinJs() (*asset, error) {
 	return a, nil
 }
 
-var _uiViewsAboutHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x55\x4b\x53\xdb\x56\x14\x5e\xb7\xbf\x42\xe3\x6e\xda\x85\x11\x0f\xf3\x48\xc6\x78\x93\x55\x27\xed\x4c\x67\x3a\x5d\x33\xd7\xb6\x64\xa9\x08\xc9\x23\x5d\x97\x74\x27\x1a\x1c\x63\x82\xb1\x49\x29\x64\xc0\xc1\xa4\xc4\x10\xda\x60\x7b\xf2\x42\x91\x01\xff\x97\xa2\x7b\x25\xaf\xfa\x17\x7a\xf4\x30\x05\x5b\x9a\x2e\xaa\xb1\x47\xba\xe7\x3b\xf7\x3c\xbe\x7b\xce\xb9\xc9\xac\xf8\x53\xea\xf3\xcf\x92\x88\x29\x88\x71\x4d\xe5\xf8\xf9\x98\xc6\x61\x2c\xca\x39\x2d\xc6\x64\x24\xa4\x69\xf3\xb1\x7c\x41\x92\xe2\xaa\x98\x13\x30\x93\xc6\xb2\xfb\x8f\xe7\x55\x71\x09\xa9\x3f\x7b\xdf\x4a\x01\x4b\xa2\xcc\x79\xdf\xaa\x52\x90\xb3\x5c\x36\x96\x72\x7a\xdb\x64\xff\xc0\x69\x5d\xd9\x97\xad\x24\x8b\x5c\x17\xc2\x64\xaa\xff\x6b\xdd\xd9\x2f\x31\x49\x16\xbe\x41\x02\xbe\x07\x3e\x7e\x2c\x2c\xa5\x15\xac\x2a\x72\x0c\x80\x3b\x48\x46\x91\x31\x02\xfb\x2a\x20\xcc\xad\x27\x29\x4c\xa5\x48\xf1\x9d\x65\x6e\xd2\xfa\x1b\x72\xde\x24\xc5\x73\xb0\x3b\x35\xa4\xe4\xa5\xc7\x0c\x3d\x37\x1b\xe8\xf3\x36\x69\x98\x60\xe2\x7b\x01\x65\x95\x65\x4d\xc9\x2c\x6a\xf1\x9c\x62\x97\xd7\xec\xbd\x55\xd2\x59\x71\x8e\x8a\xd7\xfa\x2f\xf6\x7e\x8b\x1e\x96\x40\xd7\xb9\xec\xf6\x4b\x55\xb2\xb1\x43\x5a\x07\xf6\x66\x87\x34\x2f\x41\xed\xd6\x56\xe6\x87\xaf\x41\x7d\xc4\x5d\x52\x48\xa4\xe8\x76\x9b\x6e\xac\xb8\x56\xd7\x0f\x49\xfb\x09\xa9\x54\x9d\x56\xeb\x3e\x04\x9c\x18\x0d\x2f\xa9\x48\x2e\x09\xf0\x24\x25\x31\x85\x38\x2d\x3e\x31\x39\x17\xcf\xf0\xe9\x24\x0b\xeb\x21\xe4\xde\x64\x04\x32\x39\x3d\x13\x82\x64\x01\x01\x29\x38\xb9\x2b\x4f\xf3\xbe\x78\x04\xc8\x20\x0d\x4f\x7b\xd8\x10\xa0\x66\x12\xf1\xa5\xec\x74\xc8\x0e\x01\xc1\x6f\x72\x7c\x04\xd0\x90\xa4\x79\xf2\x00\x18\xc9\x9b\x75\x13\x0f\x63\x8f\x18\xc7\x96\xa1\xd3\x37\x2f\xa9\x7e\xd2\xff\x63\xc3\x69\xff\x5f\x26\xe3\xa8\x80\x85\x68\x3a\xa3\xe0\x80\xd3\x30\x38\x20\xd6\x83\x42\x68\xf4\xf9\xbd\x41\x23\x59\x0e\x34\xc2\xb9\x8e\x32\x3e\x60\x7c\x80\x87\xf3\x3e\xb4\xfb\xbf\xd9\xff\xb7\xc3\x1e\x3e\xf8\x0e\x08\xf7\x6b\x38\xbc\xc9\x98\x90\xbe\x83\xa9\x50\x78\x94\x18\x9b\x21\x4f\x8a\xf4\xd0\xb0\x8c\x33\xf7\xc4\x8a\x4d\x9c\xc9\xbb\xc6\xf6\xdf\x5b\x17\xcf\xed\xf2\x27\x38\x52\x77\x79\xf0\x91\x1e\xac\x7e\xf9\xf0\xc1\xb7\x5f\xfd\x7d\xb1\x41\xaa\x6d\xab\xdb\x04\x15\xbf\xc5\x68\xb5\x46\x2a\xa5\xbe\xde\x20\xe6\x31\xa0\xb4\xac\x03\xea\xcf\x12\xcb\xa8\x58\x17\x7b\x7e\x64\x8b\x99\x7c\x92\x1d\x69\x78\x3f\x3a\x18\x70\x82\x37\xdd\x04\x8c\xf3\xda\x7d\x96\x5d\xe4\x54\x99\x93\x64\x6e\x39\x2d\x72\xda\x98\xa2\xe6\xd8\x6f\xdc\x70\x17\x20\xde\x2f\x04\x0e\x65\xe3\xd9\xb9\x19\x34\x8b\xe6\x10\xcf\x67\x67\x33\x1c\xcf\xf1\x73\xd3\x3c\xcf\x27\xc6\xc7\xb9\xa9\x7b\x89\xf1\xa9\xd9\x89\xb9\x34\x3f\x93\x98\x45\xb1\x14\x6d\x34\xed\xfa\x53\x6f\xca\xdd\x71\x9c\x56\x19\x76\x48\x14\x84\x17\x41\x33\xa9\x55\xc8\xd9\x6e\xc4\x14\x1b\xe4\xab\x3b\xed\x0a\x35\x6b\xa0\x7e\xad\xaf\x93\xfa\x91\xdd\xad\x5e\xeb\x4f\xe9\xee\x89\x6d\x1e\x93\x92\x49\x1a\x8f\x49\x75\xdd\x69\x75\x80\x28\xcb\x58\x27\xc6\x0b\xcb\x30\xe9\x6f\x5b\xce\x87\x4d\x20\xcd\x29\xbf\x25\x6b\xbb\x96\x69\x92\xda\x29\x79\x56\xa3\x5b\xa7\x4e\xb3\x47\x56\xf6\xe8\xd9\x2b\xbb\x7c\xea\x32\x5f\x7a\x69\x75\x3b\xb4\xbe\x0f\x12\x70\x07\xe4\x5b\x57\x3d\x38\x0e\x72\xb5\x4b\x36\xba\xf4\x6d\xd7\x33\x7b\xd7\xf5\x4e\x07\x5c\xc3\xf1\x3a\xfa\x47\xfb\xf5\x91\xf3\xfb\x9f\xfd\x67\x65\x52\x59\x81\x23\x25\x5b\x17\xc4\xdc\x86\x99\x18\x76\x2e\x21\xf4\xb8\x4a\xd7\xfa\x66\x10\x56\xfd\x35\xdd\x3d\xef\x97\x36\x29\x48\x0e\xcf\x2d\xe3\x0a\x02\x72\xdd\x43\xf4\x67\x35\xeb\x53\x83\xf4\x8a\x76\xf1\x04\x20\x70\xe9\xa2\x1f\xce\xe9\x8b\xa6\x57\x3d\xb0\xd4\xc9\x5a\xc9\xea\x41\x32\x3b\x90\x43\xff\xf1\x25\x24\x40\x3b\x86\xbd\xdd\xb8\xd6\xab\x51\x55\x12\xdc\x38\x98\x7b\x84\xfd\xfb\x2e\x96\xfa\x4b\xdf\x86\x1f\x63\xbf\x5f\xb5\x7b\x1d\xfb\xb8\xda\x6f\xbc\x63\xa6\xee\x4f\x24\xe2\x13\xd3\xd1\x39\xb9\x7d\x97\xf7\x1b\x10\xea\x0e\x23\x35\xc7\xe1\xf9\xd8\x42\x5a\x42\xf2\x62\xec\x56\x1d\x42\x19\x66\x04\x55\xd4\xb0\x5b\x7f\x63\x78\x99\xe5\x91\x88\x85\x40\x26\x22\x79\xc1\x5b\x8f\x09\x78\xe9\xe6\x2e\x0e\xbb\x80\xa5\x5c\xf4\x3d\xec\xd7\xa6\xdb\x49\xaf\xf6\xfc\x7b\x18\x62\x62\xbd\xe0\x82\xf8\x07\xef\xe0\xf5\x4f\x00\x00\x00\xff\xff\x76\x67\xb7\x3f\x11\x08\x00\x00")
+var _uiViewsAboutHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x56\x5b\x6f\xda\xc8\x1f\x7d\x4e\x3e\x85\xc5\xff\xa5\x7d\x70\x9c\x00\xb9\x34\x72\x2d\x55\xfd\xef\xc3\xaa\xbb\xd2\x4a\xab\x7d\xae\x06\x63\x63\x6f\x1c\x83\x6c\xd3\xcb\x9b\xd3\x42\x68\x52\x02\x69\x37\xdb\x54\x90\x06\x7a\x21\x69\xb7\xe5\xa2\xde\x70\x0d\x09\xdf\xa5\x78\xc6\xe6\x29\x5f\x61\x35\x06\x67\x09\xd8\xda\xed\xee\x00\xb2\xe7\x77\xe6\x72\xce\x61\x2e\x3f\x3a\x2e\xde\x62\x66\x67\x68\x40\xa4\x45\x52\x55\x38\xfe\x6a\x48\xe5\x34\x4d\x94\x13\x6a\x88\x60\x25\xa0\xaa\x57\x43\xa9\xb4\x24\x91\x8a\x98\x10\x34\x22\xa6\xc9\xf8\x47\xa6\x14\x71\x1d\x28\x77\xdd\xf7\x64\x5a\x93\x44\x99\x73\xdf\x95\x64\x5a\x8e\x73\xf1\x10\xe3\xf4\xf6\x60\xf9\xd0\x69\x9c\xda\x27\x0d\x9a\x02\x78\x0a\x21\xcc\x0c\x7e\x3b\x70\xca\x39\x82\xa6\x84\x30\x8e\xc4\xc5\x5b\xde\x1c\xbf\xa6\xd7\x63\x49\x4d\x49\xca\x21\x66\x76\xe6\x02\xc2\x26\x65\x0d\x88\x32\xa7\x84\x98\x59\x62\xac\xd0\x42\x84\x81\xd9\x0f\x96\x59\x40\x07\xef\x60\xbb\x06\xb3\x6d\x9a\x12\x22\x13\x8d\x5c\x79\x5e\xcd\x2b\xe7\x1d\xd0\xd3\x26\xac\x98\x96\x59\xf8\x59\x00\xf1\xe4\x6d\x35\xc9\xae\xa9\x64\x22\x69\x6f\x3d\xb0\x4b\x19\xd8\xda\x70\x5e\x66\xfb\xfa\x3d\xbb\xdc\x40\xd5\x1c\x7a\xda\x74\x4e\x3a\x83\x5c\x11\xe6\x9f\xc0\xc6\xa1\x5d\x68\xc1\xda\x89\x5d\xca\x8c\x75\x25\x7e\xf9\xbe\xaf\xdf\xbb\x40\x00\x7f\x69\x21\xca\xa0\xbd\x26\xca\x6f\xe0\x51\xb7\xab\xb0\xb9\x09\x77\x8a\x4e\xa3\xb1\x4a\x53\x42\x74\x9a\x1e\x9d\x94\xb0\x09\x33\x33\x33\xb4\x24\x32\x80\x53\xc9\x85\xf0\x0a\xc9\xf2\x31\x9a\x92\xc4\x49\xe4\x4a\x38\x00\x09\x2f\x2e\xf9\x20\x71\x4e\xc5\x51\x82\x98\x88\xc7\xf8\x61\x78\x0a\x60\x81\xaa\x2d\xba\xd8\x04\xa0\xb0\x51\x72\x3d\xbe\xe8\xd3\x43\x00\xac\x00\xc2\xf3\x53\x80\x0a\x24\xd5\x8d\x8f\x00\x4f\xaf\x57\x68\x0a\x0b\xf7\x6a\x5e\xc1\xee\x41\xe3\xc8\x32\x74\xf4\xee\x39\xd2\x8f\x07\x7f\xe4\x9d\xe6\x7f\x75\x92\x04\x69\x4d\x08\xb6\x33\x08\x1e\x79\xea\x07\x8f\x8c\x75\x21\x1f\x1b\x63\xfc\x05\x34\xd0\xe5\x51\x0b\x7f\xaf\xcf\xbb\x07\x38\xee\xe1\xfe\xbe\x4f\xf4\xf6\x3c\xfa\x7b\xf7\xaf\x7d\x77\xed\xff\xff\xda\xec\x04\xbb\x1e\xe0\xb3\x3f\x82\x2d\x9e\x46\xce\x15\x8a\x9c\xc6\x93\xa9\xa4\x74\x77\x21\x32\xbf\xf8\xad\x42\xc6\xb7\x6a\x78\x3e\x1c\x1e\x17\x75\x09\x36\x37\xed\xea\x86\x65\x98\x31\xa0\x72\x4b\x51\xd8\xdc\x1c\x3c\xae\x5d\xfe\x47\x5a\xf1\x58\x64\x4c\x02\x6b\x5c\x84\x0c\xd6\x3d\xd9\xca\x5f\xe9\x78\xab\x73\xd5\xdf\x2a\xf8\xaf\xb3\xf1\xc6\xf5\x9f\xec\x52\x66\x78\xfa\xf8\x1f\x8f\xc4\x74\x88\x91\x44\x39\x7d\x27\x3a\xb7\x04\x37\xb3\xa8\x6a\x58\x46\x1d\x3b\x95\xad\x69\x6c\x0a\x0f\x56\xfe\x68\x75\x9f\xda\x5b\x5f\x90\x7e\x8c\xab\x87\x9f\xd1\x61\xe6\xd2\x8d\xeb\x3f\x5e\x3e\xeb\xe6\x61\xb1\x69\x75\x6a\xa8\xfc\x71\x78\x38\xa2\xe2\x2e\xdc\xc9\x0d\xf4\x0a\x34\x8f\xce\xba\x79\xb4\xa5\x5b\x9d\xda\xf0\x16\xb0\x8c\x1d\xab\x5b\x1a\x32\x5b\x63\x53\x34\x35\x75\x54\x63\x2a\x04\x43\x03\x42\x70\xef\x25\x41\xd3\x52\xea\x2a\x45\xad\x71\x8a\xcc\x49\x32\x77\x3b\x26\x72\xea\x5c\x52\x49\x50\x3f\x60\xba\x37\xa3\x73\x4b\xff\x13\x38\x10\x27\xe3\x2b\x4b\x60\x19\xac\x00\x9e\x8f\x2f\xb3\x1c\xcf\xf1\x2b\x8b\x3c\xcf\x47\xe7\xe7\xb9\xc8\x95\xe8\x7c\x64\x79\x61\x25\xc6\x2f\x45\x97\x41\x88\x41\x95\x9a\x7d\xf0\xd0\xbd\x9f\xbc\x49\xf1\x87\x8e\x29\x04\x35\x11\x1a\xd1\x0b\xb0\x19\xee\xee\xc0\xfa\x7e\xc0\xfd\xe3\xe9\xd5\x9d\xe6\x0e\x32\x77\x2d\xb3\xd0\xd7\xb7\xe1\xc1\x4b\xbb\x53\xec\xeb\x0f\xd1\xfe\xb1\x6d\x1e\xc1\x9c\x09\x2b\xf7\x61\x71\xdb\x69\xb4\xce\xba\x79\xcb\xd8\x86\xc6\x33\xcb\x30\xd1\xef\x8f\x9c\x4f\x05\xab\x53\x73\xb6\xde\xc3\x07\xfb\x96\x69\xc2\xdd\x37\xf0\xf1\x2e\x7a\xf4\xc6\xa9\xf5\xe0\x46\x09\xd5\x5f\xd9\x5b\x6f\xb0\xf3\xb9\xe7\x56\xa7\x85\x0e\xca\xa8\xfe\xca\x29\xe7\xec\x52\xc6\x3a\xed\xc1\x62\x13\x9e\xee\xc3\x7c\x07\xbd\xef\xb8\xc3\x5e\x9c\xfa\x49\xcb\x36\x8f\x2c\xa3\xee\xe8\x9f\xed\xd7\x2f\x9d\x17\x6f\x07\x8f\xb7\xe0\xce\x06\x3a\xfc\x0c\x1f\x75\xa1\xb9\xd7\xd7\xef\xf9\xfd\x2f\x3e\xf6\xe0\x46\x7d\xbd\x30\xa2\x75\xf0\x1a\xed\xb7\x07\xb9\x02\xd2\x0b\xa8\xda\xb6\x8c\x53\xa7\x9c\xc3\xd3\x9b\x26\xac\xef\x5a\x5f\x2a\xb0\x97\xb5\xb3\xc7\xa8\xda\x76\x5e\xbc\xc5\xe8\xa7\x36\x7a\x56\xc3\x1a\x8a\x6f\x2d\x43\x87\x0f\x72\x56\xef\xb9\xd5\x79\x62\x97\x32\x83\xfb\x27\xf0\x74\x1f\xb5\x0c\x7b\xaf\xd2\xd7\x8b\x41\xab\x64\x94\x2b\x68\xdc\x1d\x6d\x98\xa9\x84\x98\xaf\xfa\xde\x57\x7d\x8f\xb0\x3f\x66\xec\x5e\xcb\x3e\x2a\x0e\x2a\x1f\x88\xc8\xea\x42\x94\x5c\x58\x0c\xd6\x84\x37\x74\xca\x7d\xe0\x94\x48\x03\x4a\x82\xd3\xae\x86\x6e\xc6\x24\x20\xaf\x85\xc6\xd6\xe1\x2a\x45\xb1\x82\x22\xaa\x1a\x5e\x7f\x73\xda\x6d\x8a\x07\xa2\x26\x8c\x62\x22\x90\x6f\xba\xf5\x39\x41\x5b\x3f\xcf\xa2\xfc\x52\x27\x29\x11\x9c\x41\x0d\xd7\x26\xde\x49\xaf\x4a\xee\x0a\xc5\xdc\x28\x97\xdc\x88\xbf\xf7\xa4\xa9\xb8\x78\x8b\x99\xfd\x73\x00\x7c\xe5\x01\x86\xcb\x09\x00\x00")
 
 func uiViewsAboutHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -484,7 +505,7 @@ func uiViewsAboutHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "ui/views/about.html", size: 2065, mode: os.FileMode(420), modTime: time.Unix(1484286681, 0)}
+	info := bindataFileInfo{name: "ui/views/about.html", size: 2507, mode: os.FileMode(420), modTime: time.Unix(1792211593, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -509,7 +530,7 @@ func uiViewsBaseHtml() (*asset, error) {
 	return a, nil
 }
 
-var _uiViewsSettingsHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xdc\x58\xff\x6e\xdb\x36\x10\xfe\x7b\x7b\x0a\x42\x1b\x16\x07\xa8\xad\xc5\xc1\xb0\x21\x53\x8c\x0d\xe8\x1b\xec\x01\x02\x5a\xa4\x2d\x2e\x34\x29\x90\x74\x52\x23\x2b\xd0\x02\xfb\x91\x76\x28\xd2\x02\x59\xb1\x16\x18\xd2\x61\xeb\x10\xac\x5b\xb0\x02\x5b\xd3\xae\x4d\xfb\x32\x96\xed\xbe\x45\x49\xc9\x92\x1d\x47\x8e\x65\x5b\x09\xda\x38\x31\x4c\x91\xc7\x4f\xc7\xe3\x77\xc7\xe3\x39\x88\x6c\x54\xde\x7f\xcf\xf1\xca\x95\xde\xc1\xcb\xee\xd1\x81\x63\xeb\xa6\xee\xd0\xfd\xc0\xa5\x50\xca\x55\xeb\xeb\x66\xa3\xca\x95\xe0\xcc\xd2\x03\xc7\x46\x5c\xce\x14\x24\x0c\x0b\x3d\x02\x86\x3e\x8e\xb7\x5c\xf9\xca\x83\x88\x6f\x4a\xee\xae\xcb\xde\xbf\x7f\x04\x3b\x87\x1a\x79\x79\x44\x6c\x08\x4a\xf0\xcd\x11\x90\x51\x09\x97\xd3\xa2\x6c\x14\x3f\x03\xfd\x06\xaf\xd5\x24\x56\xc5\x72\xca\x34\x33\x53\xc1\x2a\xc5\xf1\xdc\xf0\x21\x5d\xb0\x2f\x5d\xe5\xa8\x35\x7e\x3c\x92\x11\x31\x9c\x1c\xac\xcd\x02\xac\x5e\x14\xd8\xc7\x50\xe9\x7e\x09\x08\x03\xc3\xa3\xa7\x43\x46\xb0\x68\xb2\x50\x28\x28\x7d\xc8\x2a\x5b\x5b\x52\x5e\xbd\xea\xd8\xe1\x43\xf6\x79\xb1\xe6\x18\x11\x95\x41\xa9\x64\x2e\x61\x7e\x53\x01\xd5\xf2\xb1\x36\x22\xbe\xa2\xac\x18\xa8\xc6\x45\xa3\x68\xf6\x5f\x70\x6a\x81\x0d\x48\x9b\x5a\x22\x52\xce\x02\x3e\x85\x2e\xf6\x38\x45\x58\x18\xa3\xac\xd8\x36\x66\xae\x68\xf9\x8a\x70\xb6\xd6\xc0\xca\xe3\x68\xc5\xd7\x30\x9b\x5c\xa0\x2f\x88\xbf\xe2\x73\xa1\x91\xed\x29\xf4\xf2\x93\x05\x09\xc1\x05\x30\xaa\x15\x11\x64\x75\xdc\x6f\x0b\x52\xf7\x14\xf0\x08\xd2\xbb\xee\xd8\x7e\x46\x4b\x65\xb4\xaa\x63\x67\xd9\x33\xbd\xb1\x09\xfd\x12\x95\x32\xda\xde\xc9\xbe\xbd\xa1\x38\x34\x2c\x74\x29\x71\xd7\x8d\xbd\xbf\x74\x8d\xa1\x4b\x66\xaf\x0b\x1f\xe2\x0d\xcc\xd4\xa2\x05\x3c\x81\x6b\xab\xd6\x07\x56\xa5\xfb\xe2\x6e\xef\xe5\x1d\xc7\x86\x15\xf0\x8d\x99\x19\x0f\xa4\x41\x20\x4c\xb1\xc2\x05\x29\x17\xad\x4a\xb0\xfd\xe0\xf5\xbd\xdf\xcd\xbc\x5c\x8d\x99\x2c\x77\x56\x8a\xa6\xae\x5d\xc2\x0d\xdc\x5f\xfb\x25\x60\xd4\x8f\xd1\xab\x8a\x01\xfd\x8d\xc9\x62\x9a\xbc\xa9\xa8\x0e\x62\x61\x5b\xf0\x26\x43\x18\x59\x95\xf6\xab\x5f\x82\xbf\x7f\xce\xbc\xda\xf1\x9a\xb8\x90\xb9\x98\x0e\xf6\x61\x54\x0f\x5c\x83\x4d\xaa\xc6\x2b\x12\xec\xdc\xed\x3c\xd9\xce\xdd\xec\x93\x39\xac\x25\xc4\xc4\x88\x98\x67\x7c\xcb\x18\x6b\xce\x34\xb8\x0c\x1f\x38\x99\x42\x4b\x78\x7e\x4e\x44\x3d\xfb\x80\x91\xca\x3d\x88\xd0\x78\xe2\x4d\x70\x80\xce\xe1\xf3\xe0\xe6\x83\x4c\xbc\x9b\x97\x4b\x7a\x74\xec\xf1\xab\xc7\xcc\xd9\x9d\x92\x1a\x9c\x34\x7d\x5a\x97\xce\x39\xda\x4f\xaf\xf5\xb6\xff\x4a\xd2\x9b\x73\x4c\x42\xce\x36\x0b\x89\x28\x98\x9f\x03\x76\x1f\x3d\x7c\xbd\x7b\xbd\x10\x3c\xde\xe9\xed\x1e\xb5\x9f\xde\x0a\xae\x3f\x0c\xfe\x3f\xec\x1e\xdd\xe9\x3e\xba\xb7\x98\x1f\xc7\xa7\x77\x44\xb9\x49\x94\xeb\x15\xeb\x9a\x9b\x3e\xf0\x9b\x94\x4e\xe5\x1a\x21\xda\x70\x6c\x71\x3d\xec\xae\x57\xf9\x15\x0b\x64\x9e\x6f\x3e\x03\xff\x52\xbc\x5e\xa7\xb8\xb0\xe0\x7a\x84\xa2\x35\xaa\x13\xbd\x85\x45\x6b\x6a\x2c\xa3\x05\x46\x61\x12\x5d\x23\xf5\xd2\x00\x6c\x75\x75\x81\xb3\x85\xe9\x00\x89\x01\x4a\x10\xf4\x59\x0e\x1b\xf8\x78\xcf\x34\xd9\x15\x85\x55\x4c\x81\x0e\xbb\xc7\x20\x74\xb4\x0b\x07\xb2\x1e\x44\x39\x85\xc6\xf3\x3e\x87\x82\xef\xf6\x83\xc7\xd7\x3a\xfb\xbf\x06\x2f\x76\x2e\x22\xeb\xe7\x24\x3d\x91\x6b\x75\xca\xab\x90\xe6\xc0\xf9\x04\x6b\x56\xca\x27\x00\x31\xe3\x87\x3a\x66\x24\xfc\x00\xe1\xdd\xe7\xfb\x47\xac\x2a\xfd\xcf\xb3\x73\x34\xab\x7c\x26\x15\x67\x38\xa6\x98\xdf\x68\x1f\xbd\xea\xee\xee\xb7\x9f\xff\xd6\xbd\xfd\xfd\x45\x77\xae\x81\x3b\x44\x8d\x79\x5d\x53\x9b\x6f\x06\xa7\x6c\x70\x7d\xcb\x4b\x5c\x52\x63\xcc\xed\xd6\x1a\x63\x56\x87\x36\xaf\xef\xbb\x72\xd8\x9c\xd1\x89\xcd\xdc\xb7\xd2\x7d\x4f\x4b\x75\xf3\xc8\x75\x3b\x37\x7e\x0c\x6e\xee\x47\xb9\x6e\xec\x44\xef\x7e\xc6\x9b\x2d\x08\x80\xb0\xe4\xf6\x49\xb4\xec\xf9\xee\x5c\x60\xa9\xfc\x69\xe9\x63\xfd\xb7\xb4\xa2\x5b\x4b\xe7\x1b\xb5\x81\xa7\x94\x9f\xff\x2a\xca\x6f\x3d\x79\x7b\x3f\xfc\x19\x1c\xdc\x6f\x3f\xbb\xd1\xfb\xef\x9f\x68\xfd\xdd\xfb\xdf\x06\x7b\x7b\xc1\xed\x5b\x29\x2c\x36\x55\x81\xe1\x0a\x41\x74\x3b\x77\xf5\x65\xf7\x44\x3d\x3a\xb2\x91\x1e\x86\x02\xc3\x7e\x78\x41\xa4\xb5\x86\x78\x03\x12\x26\xc7\x14\x1a\xb4\x6f\xe8\xbe\xe5\x91\x8a\x43\x4b\xdf\x96\x9b\x55\x5c\x72\x79\xe3\xd2\x52\xb9\x14\xff\x5b\x95\xad\xad\x7e\xf8\x1b\x42\x36\x95\xda\xf8\xbd\x29\x2a\x1d\xbb\xb4\x63\x75\x99\xb4\x2e\x47\xf3\x0a\xa7\xde\xd7\x69\x7d\x52\xdd\x2a\x78\xf6\xa4\xbb\xbb\xd7\xf9\xe9\x64\xdd\xc8\xb1\xcd\x22\xc3\x42\xbe\xdd\xaf\xfd\x47\xbf\xfd\x9f\x37\x01\x00\x00\xff\xff\xa5\x1e\xda\x9d\x11\x18\x00\x00")
+var _uiViewsSettingsHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5b\x7f\x73\xd3\x46\xfa\xff\x1b\x5e\x85\xaa\xf6\x8b\xed\xef\x38\xb6\x13\xca\x95\x09\xb2\xe6\x3a\x85\xde\x31\x37\x3d\x18\xa0\x77\xbd\xb9\xb9\xf1\xc8\xd2\xc6\x16\x91\x25\x9d\xb4\x0a\xe4\x82\x67\xc2\x1c\x01\x27\x90\x1f\xdc\x99\xa4\x09\x29\x21\x14\x8a\x0b\x4d\x02\x03\x0d\x26\x21\xf0\x62\xce\x2b\xd9\xef\xe2\x66\xf5\xcb\xb2\x23\x13\xd9\x31\x81\xb4\x17\x79\x26\x92\x76\xf7\x79\x9e\xdd\xe7\xf3\x3c\xfb\x3c\xbb\x2b\x8a\xe3\x87\xe8\x83\x07\xa8\x6c\x1f\x5d\x5d\x7d\x6d\x6c\xad\x52\xf1\x6c\x1f\x7e\xc1\xf1\x43\x04\x2b\x30\xaa\x9a\x24\xcf\x6b\xb9\xb4\x04\x15\x49\x24\xe9\x83\x07\x1a\x4a\x58\x49\x84\x0c\x2f\x02\x85\xa4\x0f\x12\x9e\x3f\x2a\x7b\x98\x3e\x9b\x65\x38\xe9\x82\x2a\xb1\x83\x6a\xf5\xf9\x0f\x68\xfa\x05\x15\xcf\x1e\x6e\xaa\xe6\x21\xa5\x48\x17\x9a\x88\x34\xd7\x60\x25\xa1\x47\xcd\xf5\x1c\x25\xec\x1b\x69\x60\x40\x05\xb0\xa7\xcf\xa7\x19\x6e\x09\x99\xb4\x00\x9c\xb6\xe6\x83\x7f\x45\xbb\x76\x5a\xe2\x86\x5b\x97\xe3\x8b\x82\x8a\x43\x4e\xad\xf7\x8d\x24\xc4\x4c\x8f\x02\x64\xc0\xc0\x24\x09\x35\x51\x04\x02\xc1\x8b\x84\x75\x67\x95\xda\x8d\x46\x42\x10\x5c\x84\x3d\x39\x0d\x02\x2e\xd4\x4f\x7c\x64\x55\x89\x01\x11\xcb\xc6\xe5\xdf\x22\x9d\x73\x51\x90\xdb\xb9\x12\xbe\x28\x5e\x94\x35\x48\xc0\x61\x19\x24\x49\x36\x0b\xd8\xc1\xb4\x74\xd1\x92\x05\x3f\x00\x2e\x49\x36\x72\xb7\xe5\xe4\xd9\xc1\x24\xa9\xaa\x9f\xb3\x90\x97\x44\xbb\x2c\x6c\xd5\x8c\x90\x04\xe4\xa1\x00\x92\x24\x9a\x59\x33\x8a\x25\x92\x88\x07\x10\x38\x0e\xb9\x6e\x76\x4b\x95\x19\x91\xa6\x54\x8c\xc5\x0c\x3d\x32\x62\xf7\x41\x64\x72\x20\x9f\xa7\xe2\xf6\x7b\xc2\x2d\xd0\x14\x3e\x9f\xa7\xd2\x4a\x9c\xa6\xd4\x1c\x23\x08\xf5\x26\x0a\xc8\x31\xca\xa0\x6a\xb6\x32\x4b\xa8\xb8\x49\x3b\xb8\x18\x0e\x14\x00\xc7\xc3\x00\xaa\xf3\xd5\x0c\xc6\x03\xe9\x10\x1a\x90\x94\x5c\x0f\x36\x28\x45\x12\x48\x62\x88\x11\x34\x90\x24\x5d\x81\xad\x3e\x92\x84\x2c\x30\x2c\xc8\x4a\x02\x07\x14\xac\x89\x49\xe3\xe1\x93\x40\x9a\xd8\x2d\x7f\x73\x28\x9b\xd8\xab\x6a\x7f\x3c\x0e\x44\x56\x19\x96\x31\x5e\x52\x39\x00\xb3\x12\xd7\x2f\x33\xaa\x7a\x41\x52\xb8\xdf\xf2\x72\xbf\x2c\x29\x70\x4f\xe4\x73\x15\xda\x3c\x44\xf7\xaf\xe9\xcf\x4a\xed\x89\x20\x3b\x2c\x81\xa2\x48\x0a\x81\xa5\xe8\xe1\x18\x31\x03\xec\x7b\x85\xcf\x64\x21\x91\xe5\x39\x40\xd2\x54\x5c\x0e\x46\x39\x28\xc0\x02\x9b\x8c\x23\x64\x5d\xa4\x80\x30\xa4\x82\x23\x1d\x5f\x14\x43\x64\x15\x30\x90\x24\x3f\xf6\x75\x12\x39\x69\xc8\x71\x11\x51\xa2\xa7\x37\x62\x56\xe2\x07\x92\xe4\x47\x9f\x0c\xf0\x8a\x0a\x49\xba\x52\x9e\x30\x1e\x6e\x52\x71\xe6\xdd\xf0\x6c\x60\x29\x30\x16\xc7\xeb\xed\x72\xbc\x44\x50\x8c\x1f\x2b\x6c\xde\xe1\x4f\xc0\x10\x10\x61\x84\x74\x85\xa2\x8d\x57\xb3\xd5\xd7\x37\x71\x9f\xdc\x96\x56\xd9\xc8\xc8\xdf\x95\xaf\x15\xc1\x96\x2e\xa6\x29\x7c\x04\x9b\x2d\x64\x94\x0c\x80\x49\x32\x95\x16\x18\x71\x90\xa4\x2b\x1b\x37\x8c\xcd\xe7\xc6\xdd\xcb\x75\x12\x6f\xeb\x30\x07\x04\x00\x9d\x2e\x47\x48\x1a\x15\xee\xd6\xe6\xef\x07\xee\xe0\x9e\x39\x37\xdf\x21\x54\x99\x21\x60\x0f\x61\xd4\x9e\x17\x23\xae\x55\xa7\xa1\x48\xa4\xa1\xe8\xd8\x17\xbe\x95\x34\x28\xf0\x22\x30\x5f\x2b\x92\x26\x72\x80\x23\xe9\xca\x9b\xef\xd0\xca\xb7\x6d\xa9\xd4\x5f\xa1\x2c\x23\xb2\x40\xa8\xab\xb4\x59\x0e\x30\xc0\x68\x02\x6c\x2d\x08\x9a\x9e\xd5\xd7\x0b\x5d\x1f\xfa\x9d\xcd\x9e\x8a\x43\x65\x87\x1a\x50\x09\xe4\x39\xe8\xc0\x3e\x86\x3e\xd8\x4d\x9f\xfd\x4e\x27\x0f\x6f\xa4\x18\xc8\x6f\x9b\x81\xaf\xd3\xba\x73\xb5\xd8\x23\xd5\xb9\x37\xf6\x45\x29\xc3\x71\xad\x21\xba\x83\xa9\xe8\x2f\x36\xd1\xc4\xdd\xe0\x08\x15\x98\x34\x10\xda\xb6\x03\x7d\xfc\xb1\x3e\x3d\x5d\xf7\x63\x5e\x0c\x0c\xf0\x02\x20\x09\x86\x65\x81\x0c\x93\x24\x9f\x63\x32\x20\x2e\x8b\x99\xa8\x75\x77\x5e\x06\x19\xb7\x53\xe6\x2c\x4a\x48\x22\x9b\xc5\xbd\x4a\x92\x8c\x98\xd1\x04\x46\x89\x01\x01\xe4\x80\x08\xc3\x30\xcb\xab\x91\x98\xca\x4a\x32\x08\x47\x62\xee\xf8\xa8\x2c\x23\x5a\x65\x18\x20\x54\xdc\xec\x43\x37\xd4\xf9\x76\x2b\xa3\xe2\xad\xf3\x04\x2a\x6e\x26\x19\xdb\xcb\x7c\xa0\xe6\xf7\x2a\x7b\x98\x46\x6b\xaf\xd0\xd8\x03\x34\xb6\x5e\xd9\x9c\x45\xab\xf7\xf4\xc2\x0b\xe3\xf1\x9a\xb1\x70\xa5\x36\x36\x69\x65\x66\xcd\xf9\x13\xb6\x2f\x67\x24\xcd\x7b\x13\x7e\x2c\x10\xe1\xb6\x94\x0c\xff\x28\x5c\xcc\x28\x80\x21\x70\x38\x89\x35\x83\x4d\x2c\xc5\x31\x90\x69\x61\xb2\x8a\x74\x41\x4d\x92\x87\x9b\x6d\xb7\x9e\xfe\xf4\x5c\xe0\x45\x7c\x4f\x64\x34\x1e\x5b\xfa\x00\x9f\x89\x9d\x57\x25\x31\x4a\x78\x2b\x09\x7c\x1a\x0c\x11\x0d\xc5\x5f\x08\x8c\x9a\xb5\x3a\x16\x25\xf4\xc2\x2c\x9a\x79\xac\x2f\x8e\x9b\x21\x65\xed\xdf\xaf\xf5\xa9\x07\x95\xf2\xd4\x29\xcb\x01\x57\x57\xdf\xd4\xe6\x56\xd1\xda\xd5\xda\xbf\x1e\x18\x0b\x57\xf4\xd9\x6b\x68\x65\x0e\x87\x5e\x4e\x77\xe8\x83\xad\x63\x38\x8f\x17\x70\xe3\x04\xab\xdf\x27\xb0\x97\x20\xe9\x91\x11\xcf\x63\x3e\xef\x1b\xd0\x51\xb2\x37\xcb\x03\x22\x54\x86\x71\x92\x67\x35\x04\x9c\x4f\x96\x67\xcd\x67\xa1\x7e\xc2\xac\x1c\x53\x21\x03\x35\x95\x48\x26\x89\x10\x2f\x0e\x31\x02\xcf\x85\xa2\x44\x63\x3e\xb8\xad\x22\xa7\xc9\x02\xcf\x32\x10\x84\xf2\x58\x4a\xab\x1c\x2b\x2e\x9f\xef\x27\x1c\xa9\xcf\x9a\x84\xff\xea\x6d\xfc\xb7\x7c\xde\x9a\xc4\xed\xde\xb6\x94\x80\xa4\xa3\x84\x43\x17\x38\xbd\xc7\x2d\xfd\xa3\xda\x06\x4f\x65\x71\x3f\x67\xce\xe6\x6a\xf8\xad\x4e\x4a\xc8\xb4\xf6\x22\x16\xe2\xb7\xf9\x2a\x2a\x8e\xd1\xec\x6f\x20\xd7\x36\x50\xe1\x49\x93\x8d\x74\xc1\x34\x2c\x0f\xd2\x3a\x71\xce\x49\x1c\x10\x92\x24\xb8\x88\xfb\x7d\x06\x70\x0c\x6b\x26\x36\x44\x6d\x61\xa6\x3a\x37\x8d\xd6\xae\x62\x27\xd8\xca\x0f\x51\x3e\xc3\x59\x1f\x52\x27\x72\xb4\x68\xe3\xe8\x31\xa4\xf2\x72\x22\x71\x34\x64\xc6\x8e\xed\x7a\xe6\xb3\x27\x4f\x27\x12\x47\x5b\xfa\xff\x96\x3c\x31\x9f\x6c\x67\x2c\x4d\x63\x6e\x9f\xa3\xe9\x16\x3a\xe3\xb8\xcd\xbb\xb4\xcf\x5d\x53\xf8\x0e\x79\x63\x27\x85\x0a\x73\xd5\xe5\x92\x2f\xd7\x6d\xd6\xd3\x0a\xce\xd5\xd5\x7b\xb5\x6f\xc7\x7c\xc0\xbb\xef\xd6\xc5\x3c\xde\x51\xd5\xd2\xd8\x37\xaa\x5a\x5a\x65\x15\xde\x5c\x19\x50\xdf\xc2\xc1\xb9\x70\xb4\x39\x32\xa2\x6a\xe9\x98\xa6\x08\xf9\xfc\xce\x33\xf5\xae\x03\x2f\xaf\x8f\xc4\x7c\xa5\x41\xd2\x96\xc0\x5e\xbc\xcb\xe7\x2b\xe5\x47\xce\xda\x65\xbb\x19\x94\x4d\xf8\x23\x4c\x4f\x1a\x24\x0e\x1d\x22\xf0\x1d\xce\x4f\x53\x03\x00\xb2\x59\xd2\x6f\x82\xb2\xf9\x37\x3a\xe3\x0e\x99\x7a\x58\xd1\xfa\xe2\x23\xfd\xf6\x73\x7d\xf6\x49\x3b\x24\x5b\x66\xa5\x5a\xba\x31\x2d\xb5\x95\xd6\x5e\x5e\xba\xa7\x09\x4f\xa7\x69\x0c\x9e\x6d\x93\xa4\x17\xca\x2d\xe2\xa4\x86\xf0\x28\x0b\xa1\x8c\x5d\x04\xb8\xc8\xe4\x64\x01\xc4\x58\x29\x17\xf7\xd2\x30\x23\xa0\x5f\x59\x62\xa3\xa5\xdf\x43\x66\xe3\x2f\x81\x02\x06\x14\xa0\x66\xc3\x91\xf6\x3d\xbf\xf1\xf8\x3a\x9a\x7c\xe6\x98\xd2\x87\x86\x72\xc2\x12\xac\x36\xf7\xbc\xb6\x50\x0c\xa3\x27\xd3\xfa\xdc\x7a\x94\x48\x54\xca\x1b\xfa\xf8\x75\x34\x51\x8a\xec\x85\xd6\x83\x18\x51\x8a\xc7\x09\xca\x10\xe3\x5d\xc7\xb5\x93\x03\xdf\x6a\xc4\xa5\x4b\x44\xdf\xa7\x78\xaa\x56\xf9\x7f\x80\x24\x79\x24\xb8\xf1\x34\x40\x00\xc0\x3f\x61\x76\xe1\x90\x2f\x97\x90\x77\x81\xaf\x8d\xf5\xa6\xbd\xd5\x71\x6d\x74\xa1\xfa\xe6\x5a\x65\xf3\x7b\x63\xe6\xaa\xa5\x6f\x2b\x8a\x08\xae\xdb\xf6\xdd\x8e\x7a\x81\x87\x6c\xb6\x27\xa3\x48\x9a\x4c\xc8\x9a\x20\xb4\x05\x89\x6d\xb0\x70\xe3\xea\xc0\xcd\xf1\xaf\xae\x47\x28\x65\x32\x42\xb3\x16\x87\x78\x26\x25\x2b\xd2\xc5\xe1\x50\xa4\x7d\xba\xce\xa6\x98\x1f\x08\x5d\xc2\xc9\x64\x48\x12\x43\xed\x11\xe7\xb9\x24\xe9\x4f\xcd\xd7\x2e\x3c\xa5\x01\x01\xee\x59\xc1\x19\x90\x94\x96\xe4\x82\x2f\x91\xb4\x58\x9e\x78\x17\xc0\x7f\xc7\xab\x29\xfa\xe2\x24\x9a\x58\x46\xf3\x25\x63\x62\x5d\x1f\xbd\xfc\x0b\x0b\xb3\x65\x45\x4a\x03\x1c\x68\x9b\x37\xc1\x23\x6c\xb3\x7a\x4c\x05\xca\x10\x50\xf6\x3c\xcc\xb6\x98\x67\x01\x23\xc0\xec\x30\x59\x97\x46\x4d\x09\x0c\x04\x22\x3b\x9c\xcf\xe7\xd4\xce\xc3\xde\x06\xf2\x38\xe4\xb6\xc9\x9b\x61\x98\xea\x1f\x71\x57\xca\x93\x68\x1a\x6f\x68\xef\x96\xad\xc3\x85\xd6\xbf\x1f\xd5\x7f\xbe\x5e\x29\xaf\x74\x4c\xd1\x22\x28\x48\xaa\x4a\xd2\xe1\x4a\xf9\x1e\xba\xff\xd4\x19\x2a\xfc\xf2\xff\x7b\x13\x09\xe2\x12\x21\x6a\xb9\x34\x50\xfa\x13\xf9\xfc\xff\x45\x82\xb2\xda\xd3\xb9\x6a\xc7\x4a\xf8\x87\x5e\xae\x57\x97\x6f\x54\xdf\xdc\xd1\xa7\x1e\xa0\xfb\x0b\x95\xf2\x23\xd7\x6e\xc3\xb5\x1f\x66\x71\x06\xf6\xfa\x9f\xfa\xd8\x3d\x7d\x6e\x3d\xd2\x85\xee\xb5\x25\xdb\x3e\x98\x03\x15\x86\x05\x29\x8e\x67\x84\x2e\x4c\x7b\x2e\xad\x4e\x67\x3a\x97\x80\x33\xb9\x79\x5e\x74\x38\x9f\xd5\x29\x7c\x90\x53\x58\xf7\xed\xc1\x45\x7f\x6d\x74\x5c\xbf\xfe\xa3\xb1\x32\x6b\xdc\x7a\xd0\x85\x7e\xd8\x42\xec\xc2\x8f\x03\x01\xb0\xd0\x56\xac\x90\x4e\xc9\x92\xc0\xb3\xc3\xde\xb5\x51\x1b\x46\x8d\x65\xce\xc6\x8e\x0a\xe0\x69\xf3\x6d\x38\xd2\x8e\xcd\x48\x66\x24\xe3\xa4\x09\x24\xad\xdf\x18\xaf\x2d\x6f\xa0\x8d\x69\xfd\xd6\x58\x6d\x61\x11\x15\xae\xe9\x93\xf7\xa8\xb8\x55\xad\x63\xba\x03\x8c\x0a\x01\x3e\x39\x80\x36\xd7\xab\x6f\x96\xf4\xc5\xd1\xca\xd6\x54\xe5\xd5\xb7\x68\xac\xb0\x6b\xda\x66\xf6\x9a\x52\xa4\x34\x2f\x92\x74\x75\x6b\xb5\xba\xb6\x7b\x79\x15\x46\xe4\xa4\x1c\x49\xd7\x16\xa6\xf5\xc5\x8d\x5d\x93\x13\x00\xa3\xc2\x14\x2b\x89\x22\x49\xeb\x8b\xa3\xe8\xc9\x4d\xcb\x1f\xef\x9a\x70\x96\x51\xb3\xa6\xce\xd0\xd2\x12\x9a\x99\x44\xb7\x37\xd0\xea\x82\x8b\xf0\xf6\xc8\x53\x71\x0b\x81\x74\x17\x8c\xe1\xbd\xc6\xa5\x95\xf2\x68\xb5\xf0\x93\x7b\xda\x72\x0f\x83\xd2\x77\x14\x95\xda\xe4\xac\xf5\xa7\x20\x4e\x68\xe7\x4a\xf8\x32\x1e\x3f\xa8\x15\x2f\x87\xd1\xd3\xe9\x6a\x71\x0b\x87\x69\x97\x1f\xa0\x8d\x17\xc6\xd6\x4d\xe3\xf1\xfc\x2f\x32\x14\x20\xda\x9e\xc0\x1b\x63\x01\x36\xcb\x0b\x5c\x4a\x90\xd8\xc1\x2e\x04\x03\x75\x62\x9d\x46\x03\x75\x0a\x4e\x38\xe0\x7d\xd3\x61\x3c\xe0\x21\xf1\x2b\x09\x08\xd0\x58\x09\x3d\x1d\xd5\x4b\xcb\xe8\xd5\x74\x17\xc4\xff\xe0\x50\xdf\x36\x50\x1b\x41\xcf\xab\xa9\x8c\x20\xa5\xbb\x12\x00\xbb\xb4\x3a\x85\xbc\x4b\xc0\x41\xbc\xe7\x45\x87\x80\xaf\x53\xf8\x95\xe0\xdd\xdc\xd3\x3d\x62\x05\x20\x7a\x61\x06\x4d\x2c\xa1\x99\x29\x74\x75\x12\x6d\x14\x8d\x95\x62\xb8\xfa\xa6\x88\x6e\xdf\x31\x16\x97\xd0\xea\x9d\x5a\x71\xbe\xba\xb6\x66\xdc\xbd\xfc\xbf\xcc\x70\x7b\x66\x68\x8e\x63\x8a\x03\x03\x40\x51\x00\x97\x52\x80\x2c\x74\x65\x6d\xd4\x87\x6c\xa7\xe6\xe2\x27\xa2\x63\x39\xfe\x65\x1d\x1a\x91\x2f\xb1\xfd\x6f\x4f\x87\xc4\xb4\x2a\x1f\x0b\x0e\xed\xa0\xf5\x03\x89\xd8\x41\xd8\x27\xca\xb9\xca\xd6\x1b\xa3\x58\xb2\xb6\x2f\x82\x0b\xbe\x3f\x6d\xb2\x6e\x3a\xd6\xcd\x6e\x2d\x5a\x94\x73\x1d\x18\x70\x63\x72\x2e\xca\xb9\x5d\xbb\x00\x51\xce\x75\x6a\xf1\x98\xbd\x6d\xe0\xe6\x6d\x87\xf6\x8c\xdb\xee\xbb\x2d\x8d\xae\xec\x69\x98\x1b\xb9\x56\xee\xe8\x18\xd1\xfe\xcf\x20\x83\x39\x01\xc2\xb8\x7d\x13\xcd\xfc\x84\x16\x9f\xa0\xef\x46\xc3\x89\x98\x79\xa1\x57\xa3\x7a\xf1\xb5\xb1\x39\x8f\x9e\x8e\xa2\xa5\x25\x63\xeb\x66\x94\x70\x6f\xd1\xd5\x31\x63\xe1\x8a\x7b\x4a\xb0\xb6\xbc\x69\x14\x4b\xe6\x5c\x60\x14\x4b\xf8\x74\xed\xfc\x26\xda\xba\xf5\x5e\xb7\xc5\x05\x5e\x85\x40\x4c\x65\x25\x15\x6e\xdf\x0c\xf7\x14\xe2\x2d\xf0\x50\x6f\xdf\x67\xb8\xcf\xb1\xde\x50\x7d\x2f\xbc\xb7\x6f\xb7\x9b\xe1\x1e\x2e\xfb\x63\x0b\xdc\xd4\xe0\x11\x0b\xfe\x7b\xa0\x3b\x77\xd4\xfb\x5b\x6a\xd1\x94\x28\x85\x4f\x52\xfa\x9c\x68\x70\xcb\xb0\x0e\x7b\xfb\x3e\xeb\xed\xe6\x41\x06\x97\xf8\xfe\x50\x1d\x3e\x1a\xf5\x21\x29\x0e\xcb\xd3\x42\x6f\x6e\x91\xad\xb6\xbe\x2e\xaa\xcd\xa5\xbd\x9f\x0c\x2e\x8e\xa5\xd6\x5f\xbc\x40\x33\x05\x4b\x85\x61\xe3\xd6\xbc\xf1\xe3\x06\x1a\x7b\x56\x9b\x5b\x89\x7c\x18\x0a\xcd\xf1\x17\x01\xd7\x42\xa3\xf5\xb2\x2e\xaa\xb2\x4e\xf4\x7d\xe9\xf2\x5d\xc7\x1c\x95\xf2\x84\x5e\x2e\xdb\x2a\x47\x63\x3f\xa1\xe9\x32\x5e\x84\xdd\x5c\x34\x56\xc6\xf5\xb9\xbb\xfa\xb3\x5b\xc6\xed\xe7\x38\x5f\x77\xd6\xf5\xf1\x56\x6d\x17\x4e\xe4\xb7\x52\xb1\x26\xab\x50\x01\x4c\xce\x39\x29\xe3\x7b\x20\xb3\x59\xf7\x8d\x8d\xb6\x7d\x26\x8c\xa1\xdd\x1f\x8f\x6b\x2a\x50\xea\x9f\xa0\xf5\x26\x6c\xb4\x1d\xee\xed\x3b\x8a\xbf\x51\xb1\x0d\xa1\x3f\x1e\x77\x8b\x7a\x13\x47\x13\x51\xc2\x32\x04\x6b\x18\x7c\x01\xd5\x02\x3c\x8d\x52\x85\x3a\xfe\x8c\xc2\x3a\xad\x86\x5e\xae\x1b\xc5\x25\xfd\x56\x21\xf0\xf7\x14\xd5\x6b\x8f\xd0\xea\x42\xe5\xe5\x78\xf5\xe7\x27\x96\x82\x71\xbc\x64\xee\xd5\x74\xff\x6b\x23\x8e\x1f\x4e\x71\x52\x8e\xe1\x45\xb5\x85\xd2\xfc\xbf\x36\x1a\x96\x34\xa8\xa5\xcd\x63\xb4\xd1\xde\xbe\x98\xf3\x23\x69\x57\xb9\x1e\xca\xf9\xfc\x5b\x3f\x0b\x6a\x52\xc3\x71\x7e\xf8\xb8\x25\x51\x78\xef\xc7\xfe\xc5\x9a\x51\x7c\x5a\x7d\x78\x05\x15\xe6\xbb\x30\xd8\x32\xad\xff\x3c\x5d\x7d\x58\x40\xf3\xa5\xff\x8c\x5e\x36\x81\x5a\x29\x4f\xd5\xe7\x5b\x34\xf6\xd4\x28\x96\xa2\x84\xbe\x36\x5d\x5d\xbe\x51\x29\x8f\xea\xdf\x2d\x47\x09\x34\x56\x40\x37\x5e\xe2\xcf\xb0\x16\xae\x58\xd8\x39\x46\x18\x4f\x37\xd1\x9d\xeb\xfa\xe2\xf8\xf1\x53\x5f\x7d\x7e\xf2\x8f\x51\xc2\xfa\xdf\x73\xf6\xeb\x2f\xbf\x3c\xf9\x8d\xfb\xf8\x87\x13\x7f\xf9\xf3\xa9\x33\xc7\xdd\xe7\x33\x27\x7e\x77\xe2\x9b\x28\x71\xf2\x74\xcf\x17\x27\x8f\x9f\x89\x12\xc7\xcf\x9e\xeb\x39\x7d\xea\xcc\xb9\x4a\x79\xea\xab\xcf\xcf\x7d\xf1\xfb\x28\x81\x26\x4a\x95\xad\x45\x7d\x71\xdc\x34\xdc\x28\xc1\xf1\x0a\x60\x61\xa5\x3c\xa5\x80\xf3\x80\x85\xc7\x08\xb7\x07\xb6\x5b\x79\x35\x5b\x5d\xbb\x69\x2c\x5c\xf1\x72\x40\x8b\x25\x99\x61\x2b\xe5\x95\x4a\x79\xd2\x19\x71\x79\x67\xf0\x29\x9a\x00\xde\x0e\xbb\x23\x4d\xb0\x6b\xec\x35\x2b\x29\x72\xcc\x73\xa0\x3b\x6a\x09\x7f\xe8\xe3\xde\xc4\x31\xa7\xcb\xbd\x89\x44\xec\x37\x9f\x62\xc7\x10\xef\x4d\x78\x2b\x38\x43\x11\xed\x3b\x12\xb5\xfa\xea\x01\xaf\x29\x59\x3e\xdf\xf9\xd7\x6c\x26\x01\xf7\x63\xb6\xfa\x53\xab\x6f\xd9\x9a\x6c\xe0\x0c\x6e\xf0\xee\xe1\x7f\xe0\x80\x3d\xbd\x38\xff\xa9\x38\xc7\x0f\xd1\x07\xff\x3b\x00\x09\xfe\x2e\x52\x81\x46\x00\x00")
 
 func uiViewsSettingsHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -524,7 +545,7 @@ func uiViewsSettingsHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "ui/views/settings.html", size: 6161, mode: os.FileMode(420), modTime: time.Unix(1484196676, 0)}
+	info := bindataFileInfo{name: "ui/views/settings.html", size: 18049, mode: os.FileMode(420), modTime: time.Unix(1792214333, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -585,6 +606,7 @@ var _bindata = map[string]func() (*asset, error){
 	"icon22x22.ico": icon22x22Ico,
 	"logo.png": logoPng,
 	"pac.tpl": pacTpl,
+	"rules.txt": rulesTxt,
 	"ui/.DS_Store": uiDs_store,
 	"ui/.gitignore": uiGitignore,
 	"ui/app.js": uiAppJs,
@@ -649,6 +671,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"icon22x22.ico": &bintree{icon22x22Ico, map[string]*bintree{}},
 	"logo.png": &bintree{logoPng, map[string]*bintree{}},
 	"pac.tpl": &bintree{pacTpl, map[string]*bintree{}},
+	"rules.txt": &bintree{rulesTxt, map[string]*bintree{}},
 	"ui": &bintree{nil, map[string]*bintree{
 		".DS_Store": &bintree{uiDs_store, map[string]*bintree{}},
 		".gitignore": &bintree{uiGitignore, map[string]*bintree{}},
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+type breakerState int
+
+const (
+	breakerClosed   breakerState = iota // server in use
+	breakerOpen                         // server skipped until the backoff ends
+	breakerHalfOpen                     // one trial connection decides
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerClosed:
+		return "closed"
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+const (
+	breakerThreshold   = 2 // consecutive failures opening the circuit
+	breakerBaseBackoff = 5 * time.Second
+	breakerMaxBackoff  = 5 * time.Minute
+)
+
+// circuitBreaker tracks the health of one server. After breakerThreshold
+// consecutive failures the server is skipped for a backoff period, then a
+// single trial connection is let through: success closes the circuit again,
+// failure doubles the backoff.
+type circuitBreaker struct {
+	mu       sync.Mutex
+	server   string
+	state    breakerState
+	failures int
+	backoff  time.Duration
+	retryAt  time.Time
+	trial    bool // half-open trial connection in flight
+}
+
+func (b *circuitBreaker) setState(state breakerState) {
+	if b.state == state {
+		return
+	}
+	log.Printf("server %s circuit %s -> %s", b.server, b.state, state)
+	b.state = state
+}
+
+// Allow tells whether a connection to the server may be tried now.
+func (b *circuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case breakerOpen:
+		if time.Now().Before(b.retryAt) {
+			return false
+		}
+		b.setState(breakerHalfOpen)
+		b.trial = true
+		return true
+	case breakerHalfOpen:
+		if b.trial {
+			return false
+		}
+		b.trial = true
+		return true
+	}
+	return true
+}
+
+func (b *circuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures, b.backoff, b.trial = 0, 0, false
+	b.setState(breakerClosed)
+}
+
+func (b *circuitBreaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	b.trial = false
+	if b.state == breakerHalfOpen || (b.state == breakerClosed && b.failures >= breakerThreshold) {
+		b.backoff *= 2
+		if b.backoff == 0 {
+			b.backoff = breakerBaseBackoff
+		}
+		if b.backoff > breakerMaxBackoff {
+			b.backoff = breakerMaxBackoff
+		}
+		b.retryAt = time.Now().Add(b.backoff)
+		b.setState(breakerOpen)
+	}
+}
+
+// Healthy tells whether the circuit is closed.
+func (b *circuitBreaker) Healthy() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state == breakerClosed
+}
+
+type BreakerStat struct {
+	Server   string     `json:"server"`
+	State    string     `json:"state"`
+	Failures int        `json:"failures"`
+	Backoff  int64      `json:"backoff"` // seconds
+	RetryAt  *Timestamp `json:"retry_at"`
+}
+
+func (b *circuitBreaker) Stat() *BreakerStat {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	stat := &BreakerStat{
+		Server:   b.server,
+		State:    b.state.String(),
+		Failures: b.failures,
+		Backoff:  int64(b.backoff / time.Second),
+	}
+	if b.state != breakerClosed {
+		retryAt := Timestamp(b.retryAt)
+		stat.RetryAt = &retryAt
+	}
+	return stat
+}
+
+// GetBreakerStats returns the circuit state of the configured servers, in
+// config order.
+func GetBreakerStats() []*BreakerStat {
+	servers.RLock()
+	srvCipher := servers.srvCipher
+	servers.RUnlock()
+	stats := []*BreakerStat{}
+	for _, se := range srvCipher {
+		stats = append(stats, se.breaker.Stat())
+	}
+	return stats
+}
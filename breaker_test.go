@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	b := &circuitBreaker{server: "a:1"}
+	for i := 0; i < breakerThreshold; i++ {
+		if !b.Allow() {
+			t.Fatalf("closed circuit refused attempt %d", i)
+		}
+		b.Failure()
+	}
+	if b.Allow() || b.state != breakerOpen || b.backoff != breakerBaseBackoff {
+		t.Fatalf("circuit should be open with base backoff, got %s %v", b.state, b.backoff)
+	}
+
+	// backoff over, a single trial goes through
+	b.retryAt = time.Now()
+	if !b.Allow() || b.state != breakerHalfOpen {
+		t.Fatalf("trial refused, circuit is %s", b.state)
+	}
+	if b.Allow() {
+		t.Error("only one trial is allowed while half-open")
+	}
+	b.Failure()
+	if b.state != breakerOpen || b.backoff != 2*breakerBaseBackoff {
+		t.Errorf("failed trial should double the backoff, got %s %v", b.state, b.backoff)
+	}
+
+	b.retryAt = time.Now()
+	b.Allow()
+	b.Success()
+	if !b.Healthy() || b.backoff != 0 {
+		t.Errorf("successful trial should close the circuit, got %s %v", b.state, b.backoff)
+	}
+}
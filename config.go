@@ -1,15 +1,21 @@
 package main
 
 import (
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
-	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -28,8 +34,7 @@ const (
 	ssPort         = 1271
 	httpProxyPort  = 1272
 	httpManagePort = 1270
-	HttpProxy      = "127.0.0.1:1272"
-	SocksProxy     = "127.0.0.1:1271"
+	proxyHost      = "127.0.0.1"
 )
 
 var storageFolder string
@@ -67,31 +72,141 @@ func init() {
 }
 
 type SSTunnel struct {
-	Ip       string
-	Port     string
-	Password string
-	Method   string
+	Ip         string
+	Port       string
+	Password   string
+	Method     string
+	Plugin     string // SIP003 plugin, empty when none
+	PluginOpts string
+	Remark     string
 }
 
+// ToString formats the tunnel as a SIP002 uri. Tunnels without plugin nor
+// remark and with plain passwords keep their old ss://method:password@ip:port
+// form.
 func (ss *SSTunnel) ToString() string {
-	return fmt.Sprintf("ss://%s:%s@%s:%s", ss.Method, ss.Password, ss.Ip, ss.Port)
+	u := "ss://" + url.UserPassword(ss.Method, ss.Password).String() + "@" + net.JoinHostPort(ss.Ip, ss.Port)
+	if ss.Plugin != "" {
+		plugin := ss.Plugin
+		if ss.PluginOpts != "" {
+			plugin += ";" + ss.PluginOpts
+		}
+		u += "/?" + url.Values{"plugin": {plugin}}.Encode()
+	}
+	if ss.Remark != "" {
+		u += "#" + url.PathEscape(ss.Remark)
+	}
+	return u
 }
 
+var errSSFormat = errors.New("输入不是shadowsocks格式")
+
+// decodeBase64 accepts both base64 alphabets, padded or not, as clients
+// differ in what they produce.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(strings.TrimSpace(s), "=")
+	if b, err := base64.RawURLEncoding.DecodeString(s); err == nil {
+		return b, nil
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
+
+// NewSSTunnel parses a shadowsocks uri, either SIP002
+//
+//	ss://BASE64URL(method:password)@host:port/?plugin=...#remark
+//	ss://method:password@host:port/?plugin=...#remark
+//
+// or the legacy ss://BASE64(method:password@host:port)#remark. Hosts may be
+// IPv6 literals in brackets.
 func NewSSTunnel(ss string) (*SSTunnel, error) {
-	re, err := regexp.Compile(`(ss)://([\d\-\w]+):([\d\w]+)\@([\d\w\.]+):(\d+)`)
-	if err != nil {
-		log.Printf("regexp for ss tunnel is not correct")
-		os.Exit(-1)
-	}
-	res := re.FindStringSubmatch(ss)
-	if res != nil {
-		method, password, ip, port := res[2], res[3], res[4], res[5]
-		if err = ss_go.CheckCipherMethod(strings.Replace(method, "-auth", "", 1)); err != nil {
-			return nil, errors.New("不支持的加密类型:" + method)
+	ss = strings.TrimSpace(ss)
+	if len(ss) < 5 || !strings.EqualFold(ss[:5], "ss://") {
+		return nil, errSSFormat
+	}
+	rest := ss[5:]
+	tunnel := &SSTunnel{}
+	if i := strings.IndexByte(rest, '#'); i >= 0 {
+		remark, err := url.PathUnescape(rest[i+1:])
+		if err != nil {
+			return nil, errSSFormat
+		}
+		tunnel.Remark, rest = remark, rest[:i]
+	}
+	if !strings.Contains(rest, "@") {
+		// legacy, everything but the remark is base64, a plugin might follow
+		query := ""
+		if i := strings.IndexByte(rest, '?'); i >= 0 {
+			rest, query = rest[:i], rest[i:]
+		}
+		b, err := decodeBase64(rest)
+		if err != nil && strings.HasSuffix(rest, "/") {
+			b, err = decodeBase64(strings.TrimSuffix(rest, "/"))
+		}
+		if err != nil {
+			return nil, errSSFormat
+		}
+		rest = string(b) + query
+	}
+	at := strings.LastIndexByte(rest, '@')
+	if at < 0 {
+		return nil, errSSFormat
+	}
+	userinfo, hostport := rest[:at], rest[at+1:]
+	if i := strings.IndexByte(hostport, '?'); i >= 0 {
+		query, err := url.ParseQuery(hostport[i+1:])
+		if err != nil {
+			return nil, errSSFormat
+		}
+		hostport = hostport[:i]
+		plugin := strings.SplitN(query.Get("plugin"), ";", 2)
+		tunnel.Plugin = plugin[0]
+		if len(plugin) == 2 {
+			tunnel.PluginOpts = plugin[1]
+		}
+		if tunnel.Plugin != "" {
+			if err := checkPluginName(tunnel.Plugin); err != nil {
+				return nil, err
+			}
+		}
+		// reloads would fail on it later, after it is saved
+		if isBuiltinObfs(tunnel) {
+			if _, err := parseObfsOpts(tunnel.PluginOpts); err != nil {
+				return nil, fmt.Errorf("obfs参数无效: %v", err)
+			}
 		}
-		return &SSTunnel{ip, port, password, method}, nil
 	}
-	return nil, errors.New("输入不是shadowsocks格式")
+	hostport = strings.TrimSuffix(hostport, "/")
+
+	if plain, err := url.PathUnescape(userinfo); err == nil && strings.Contains(plain, ":") {
+		userinfo = plain
+	} else if b, err := decodeBase64(userinfo); err == nil {
+		userinfo = string(b)
+	}
+	methodPass := strings.SplitN(userinfo, ":", 2)
+	if len(methodPass) != 2 || methodPass[0] == "" || methodPass[1] == "" {
+		return nil, errSSFormat
+	}
+	tunnel.Method, tunnel.Password = strings.ToLower(methodPass[0]), methodPass[1]
+
+	host, port, err := net.SplitHostPort(hostport)
+	if err != nil || host == "" {
+		return nil, errSSFormat
+	}
+	if n, err := strconv.Atoi(port); err != nil || n <= 0 || n > 65535 {
+		return nil, errSSFormat
+	}
+	tunnel.Ip, tunnel.Port = host, port
+
+	if isAEADMethod(tunnel.Method) {
+		if _, err = newAEADCipher(tunnel.Method, tunnel.Password); err != nil {
+			return nil, errors.New("无效的密钥:" + tunnel.Password)
+		}
+		return tunnel, nil
+	}
+	if err = ss_go.CheckCipherMethod(strings.Replace(tunnel.Method, "-auth", "", 1)); err != nil {
+		return nil, errors.New("不支持的加密类型:" + tunnel.Method)
+	}
+	return tunnel, nil
 }
 
 type Timestamp time.Time
@@ -120,10 +235,70 @@ type Traffic struct {
 	Out   int64  `json:"out"`
 }
 
+var (
+	errTunnelNotFound = errors.New("该Shadowsocks账号不存在")
+	errTunnelOrder    = errors.New("顺序必须是数字")
+)
+
+// TunnelRecord is a tunnel added by hand, Order is its position in the
+// failover order.
+type TunnelRecord struct {
+	Id       string     `json:"id"`
+	Uri      string     `json:"uri"`
+	Name     string     `json:"name"`
+	Remarks  string     `json:"remarks"`
+	Enabled  bool       `json:"enabled"`
+	Order    int        `json:"order"`
+	Created  *Timestamp `json:"created"`
+	LastUsed *Timestamp `json:"last_used"`
+}
+
+// Tunnel parses the record's uri, the record's name is the remark.
+func (r *TunnelRecord) Tunnel() (*SSTunnel, error) {
+	tunnel, err := NewSSTunnel(r.Uri)
+	if err != nil {
+		return nil, err
+	}
+	if r.Name != "" {
+		tunnel.Remark = r.Name
+	}
+	return tunnel, nil
+}
+
+func newTunnelId() string {
+	b := make([]byte, 6)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// NewTunnelRecord checks t and makes an enabled record of it, named by its
+// remark.
+func NewTunnelRecord(t string) (*TunnelRecord, error) {
+	tunnel, err := NewSSTunnel(t)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkTunnel(tunnel); err != nil {
+		return nil, err
+	}
+	now := Timestamp(time.Now())
+	return &TunnelRecord{
+		Id:      newTunnelId(),
+		Uri:     t,
+		Name:    tunnel.Remark,
+		Enabled: true,
+		Created: &now,
+	}, nil
+}
+
 type Config struct {
-	SSTunnels []string          `json:"ss_tunnels"`
-	Config    map[string]string `json:"config"`
-	Traffic   *Traffic          `json:"traffic"`
+	SSTunnels     []string            `json:"ss_tunnels,omitempty"` // before tunnel records, migrated on load
+	Tunnels       []*TunnelRecord     `json:"tunnels"`
+	Config        map[string]string   `json:"config"`
+	Traffic       *Traffic            `json:"traffic"`
+	SocksUsers    map[string]string   `json:"socks_users"`
+	Subscriptions []string            `json:"subscriptions"`
+	SubTunnels    map[string][]string `json:"sub_tunnels"` // last fetched tunnels by subscription url
 }
 
 func (c *Config) Set(name string, value string) {
@@ -142,6 +317,26 @@ func (c *Config) Get(name string) string {
 	return ""
 }
 
+// GetInt returns the integer stored under name, or def when it is unset or
+// not a number.
+func (c *Config) GetInt(name string, def int) int {
+	v, err := strconv.Atoi(c.Get(name))
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// GetPort returns the port stored under name, or def when it is unset or not
+// a valid port.
+func (c *Config) GetPort(name string, def int) int {
+	port := c.GetInt(name, def)
+	if port <= 0 || port > 65535 {
+		return def
+	}
+	return port
+}
+
 func (c *Config) AddTraffic(in, out int64) {
 	t := time.Now().UTC()
 	curMonth := fmt.Sprintf("%d%d", t.Year(), t.Month())
@@ -161,58 +356,284 @@ func (c *Config) GetTraffic() *Traffic {
 	return &Traffic{curMonth, 0, 0}
 }
 
+// migratePolicy turns the prefer_fastest switch of older configs into the
+// policy doing the same.
+func (c *Config) migratePolicy() bool {
+	on, ok := c.Config["prefer_fastest"]
+	if !ok {
+		return false
+	}
+	if on == "on" && c.Config["lb_policy"] == "" {
+		c.Config["lb_policy"] = "fastest"
+	}
+	delete(c.Config, "prefer_fastest")
+	return true
+}
+
+// migrateTunnels turns the uris of older configs into records. Ids are
+// derived from the uris, configs migrated twice at once agree on them.
+func (c *Config) migrateTunnels() bool {
+	if len(c.SSTunnels) == 0 {
+		return false
+	}
+	now := Timestamp(time.Now())
+	for _, sv := range c.SSTunnels {
+		sum := sha1.Sum([]byte(sv))
+		r := &TunnelRecord{Id: hex.EncodeToString(sum[:6]), Uri: sv, Enabled: true, Created: &now}
+		if tunnel, err := NewSSTunnel(sv); err == nil {
+			r.Name = tunnel.Remark
+		}
+		c.Tunnels = append(c.Tunnels, r)
+	}
+	c.SSTunnels = nil
+	c.renumberTunnels()
+	return true
+}
+
+func (c *Config) renumberTunnels() {
+	for i, r := range c.Tunnels {
+		r.Order = i
+	}
+}
+
+// GetSSTunnels returns the enabled tunnels added by hand in their order,
+// followed by those of the subscriptions, in subscription order.
 func (c *Config) GetSSTunnels() []*SSTunnel {
 	tunnels := []*SSTunnel{}
-	for _, sv := range c.SSTunnels {
-		ss, err := NewSSTunnel(sv)
-		if err == nil {
+	seen := map[string]bool{}
+	add := func(ss *SSTunnel, err error) {
+		if err == nil && !seen[tunnelKey(ss)] {
+			seen[tunnelKey(ss)] = true
 			tunnels = append(tunnels, ss)
 		}
 	}
+	for _, r := range c.Tunnels {
+		if r.Enabled {
+			add(r.Tunnel())
+		}
+	}
+	for _, u := range c.Subscriptions {
+		for _, sv := range c.SubTunnels[u] {
+			add(NewSSTunnel(sv))
+		}
+	}
 	return tunnels
 }
 
+// TunnelURIs returns the uris of the tunnels added by hand.
+func (c *Config) TunnelURIs() []string {
+	uris := make([]string, len(c.Tunnels))
+	for i, r := range c.Tunnels {
+		uris[i] = r.Uri
+	}
+	return uris
+}
+
+func (c *Config) GetTunnel(id string) *TunnelRecord {
+	for _, r := range c.Tunnels {
+		if r.Id == id {
+			return r
+		}
+	}
+	return nil
+}
+
+// hasTunnel tells whether a record other than skip has the tunnel of uri.
+func (c *Config) hasTunnel(uri string, skip *TunnelRecord) bool {
+	tunnel, err := NewSSTunnel(uri)
+	if err != nil {
+		return false
+	}
+	key := tunnelKey(tunnel)
+	for _, r := range c.Tunnels {
+		if t, err := NewSSTunnel(r.Uri); r != skip && err == nil && tunnelKey(t) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// addTunnelRecord appends r last in order, without saving.
+func (c *Config) addTunnelRecord(r *TunnelRecord) {
+	r.Order = len(c.Tunnels)
+	c.Tunnels = append(c.Tunnels, r)
+}
+
 func (c *Config) AddTunnel(t string) error {
-	_, err := NewSSTunnel(t)
+	r, err := NewTunnelRecord(t)
 	if err != nil {
 		return err
 	}
-	for _, sv := range c.SSTunnels {
-		if sv == t {
-			return errors.New("该Shadowsocks账号已存在")
-		}
+	return c.AddTunnelRecord(r)
+}
+
+func (c *Config) AddTunnelRecord(r *TunnelRecord) error {
+	if c.hasTunnel(r.Uri, nil) {
+		return errors.New("该Shadowsocks账号已存在")
 	}
-	c.SSTunnels = append(c.SSTunnels, t)
+	c.addTunnelRecord(r)
 	return SaveConfig(c)
 }
 
-func (c *Config) UpdateTunnel(oldT, newT string) error {
-	_, err := NewSSTunnel(newT)
+// SetTunnelUri points record r at another uri, keeping its id and state.
+func (c *Config) SetTunnelUri(r *TunnelRecord, uri string) error {
+	tunnel, err := NewSSTunnel(uri)
 	if err != nil {
 		return err
 	}
-	sure := true
-	for i, sv := range c.SSTunnels {
-		if sv == oldT {
-			c.SSTunnels[i] = newT
-		}
-		if sv == newT {
-			sure = false
+	if err = checkTunnel(tunnel); err != nil {
+		return err
+	}
+	if c.hasTunnel(uri, r) {
+		return errors.New("该Shadowsocks账号已存在")
+	}
+	r.Uri = uri
+	return nil
+}
+
+// MoveTunnel puts record r at position order of the failover order.
+func (c *Config) MoveTunnel(r *TunnelRecord, order int) {
+	if order < 0 {
+		order = 0
+	}
+	if order >= len(c.Tunnels) {
+		order = len(c.Tunnels) - 1
+	}
+	tunnels := make([]*TunnelRecord, 0, len(c.Tunnels))
+	for _, t := range c.Tunnels {
+		if t != r {
+			tunnels = append(tunnels, t)
 		}
 	}
-	if sure {
-		return SaveConfig(c)
+	tunnels = append(tunnels[:order], append([]*TunnelRecord{r}, tunnels[order:]...)...)
+	c.Tunnels = tunnels
+	c.renumberTunnels()
+}
+
+func (c *Config) UpdateTunnel(oldT, newT string) error {
+	for _, r := range c.Tunnels {
+		if r.Uri == oldT {
+			if err := c.SetTunnelUri(r, newT); err != nil {
+				return err
+			}
+			return SaveConfig(c)
+		}
 	}
-	return errors.New("该Shadowsocks账号已存在")
+	return SaveConfig(c)
 }
 
 func (c *Config) DeleteTunnel(t string) error {
-	for i, sv := range c.SSTunnels {
-		if sv == t {
-			c.SSTunnels = append(c.SSTunnels[:i], c.SSTunnels[i+1:]...)
+	for _, r := range c.Tunnels {
+		if r.Uri == t {
+			return c.DeleteTunnelById(r.Id)
+		}
+	}
+	return SaveConfig(c)
+}
+
+func (c *Config) DeleteTunnelById(id string) error {
+	for i, r := range c.Tunnels {
+		if r.Id == id {
+			c.Tunnels = append(c.Tunnels[:i], c.Tunnels[i+1:]...)
 			break
 		}
 	}
+	c.renumberTunnels()
+	return SaveConfig(c)
+}
+
+// updateLastUsed copies when the servers of the tunnels were last used.
+func (c *Config) updateLastUsed() {
+	used := serversLastUsed()
+	for _, r := range c.Tunnels {
+		tunnel, err := NewSSTunnel(r.Uri)
+		if err != nil {
+			continue
+		}
+		if at, ok := used[tunnelKey(tunnel)]; ok && (r.LastUsed == nil || time.Time(*r.LastUsed).Before(at)) {
+			ts := Timestamp(at)
+			r.LastUsed = &ts
+		}
+	}
+}
+
+func (c *Config) AddSubscription(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("订阅地址必须是http或https链接")
+	}
+	for _, s := range c.Subscriptions {
+		if s == u {
+			return errors.New("该订阅已存在")
+		}
+	}
+	c.Subscriptions = append(c.Subscriptions, u)
+	return SaveConfig(c)
+}
+
+func (c *Config) DeleteSubscription(u string) error {
+	for i, s := range c.Subscriptions {
+		if s == u {
+			c.Subscriptions = append(c.Subscriptions[:i], c.Subscriptions[i+1:]...)
+			break
+		}
+	}
+	delete(c.SubTunnels, u)
+	return SaveConfig(c)
+}
+
+// SetSubTunnels replaces the tunnels of subscription u and tells how many
+// were added and removed.
+func (c *Config) SetSubTunnels(u string, tunnels []string) (added, removed int, err error) {
+	old := map[string]bool{}
+	for _, t := range c.SubTunnels[u] {
+		old[t] = true
+	}
+	for _, t := range tunnels {
+		if old[t] {
+			delete(old, t)
+		} else {
+			added++
+		}
+	}
+	removed = len(old)
+	if added == 0 && removed == 0 {
+		return
+	}
+	if c.SubTunnels == nil {
+		c.SubTunnels = map[string][]string{}
+	}
+	c.SubTunnels[u] = tunnels
+	err = SaveConfig(c)
+	return
+}
+
+func (c *Config) GetSocksUsers() []string {
+	users := []string{}
+	for u := range c.SocksUsers {
+		users = append(users, u)
+	}
+	sort.Strings(users)
+	return users
+}
+
+func (c *Config) SetSocksUser(user, password string) error {
+	// RFC 1929 carries both fields with a one byte length
+	if len(user) == 0 || len(user) > 255 {
+		return errors.New("用户名长度须为1-255")
+	}
+	if len(password) == 0 || len(password) > 255 {
+		return errors.New("密码长度须为1-255")
+	}
+	if c.SocksUsers == nil {
+		c.SocksUsers = map[string]string{}
+	}
+	c.SocksUsers[user] = password
+	return SaveConfig(c)
+}
+
+func (c *Config) DeleteSocksUser(user string) error {
+	delete(c.SocksUsers, user)
 	return SaveConfig(c)
 }
 
@@ -229,9 +650,13 @@ func LoadConfig() (*Config, error) {
 	//log.Printf("read lock on config file released")
 	if err != nil || len(c) == 0 {
 		config = &Config{
-			[]string{},
+			nil,
+			[]*TunnelRecord{},
 			map[string]string{},
 			&Traffic{"201605", 0, 0},
+			map[string]string{},
+			[]string{},
+			map[string][]string{},
 		}
 		SaveConfig(config)
 		log.Printf("read config file err:%v", err)
@@ -241,6 +666,10 @@ func LoadConfig() (*Config, error) {
 		log.Printf("dejson config err:%v", err)
 		SaveConfig(config)
 	}
+	tunnels, policy := config.migrateTunnels(), config.migratePolicy()
+	if tunnels || policy {
+		SaveConfig(config)
+	}
 	return config, nil
 }
 
@@ -318,14 +747,50 @@ func UnsetPac() {
 	pac.Off(pacUrl)
 }
 
-func GetSocksProxy() string {
+func GetSocksProxy(config *Config) string {
 	//TODO add sharing feature
-	return fmt.Sprintf("%s:%d;", "127.0.0.1", ssPort)
+	return fmt.Sprintf("%s;", GetSocksAddr(config))
 }
 
-func GetHttpProxy() string {
+func GetHttpProxy(config *Config) string {
 	//TODO add sharing feature
-	return fmt.Sprintf("%s:%d;", "127.0.0.1", httpProxyPort)
+	return fmt.Sprintf("%s;", GetHttpAddr(config))
+}
+
+// GetListenHost returns the address the proxy ports listen on, loopback
+// unless they are shared with other machines.
+func GetListenHost(config *Config) string {
+	if host := config.Get("listen_host"); host != "" {
+		return host
+	}
+	return proxyHost
+}
+
+// localProxyHost is where clients on this machine reach the proxy ports.
+func localProxyHost(config *Config) string {
+	host := GetListenHost(config)
+	if ip := net.ParseIP(host); ip == nil || ip.IsUnspecified() {
+		return proxyHost
+	}
+	return host
+}
+
+func GetSocksAddr(config *Config) string {
+	return net.JoinHostPort(localProxyHost(config), strconv.Itoa(config.GetPort("socks_port", ssPort)))
+}
+
+func GetHttpAddr(config *Config) string {
+	return net.JoinHostPort(localProxyHost(config), strconv.Itoa(config.GetPort("http_port", httpProxyPort)))
+}
+
+// GetMixedAddr returns the address of the port serving both socks and http
+// proxy clients, or an empty string when it is not enabled.
+func GetMixedAddr(config *Config) string {
+	port := config.GetPort("mixed_port", 0)
+	if port == 0 {
+		return ""
+	}
+	return net.JoinHostPort(localProxyHost(config), strconv.Itoa(port))
 }
 
 func GetManagementAddr() string {
@@ -2,7 +2,11 @@ package main
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestStorageDir(t *testing.T) {
@@ -12,3 +16,135 @@ func TestStorageDir(t *testing.T) {
 		t.Errorf("Storage path is not created, :: %s", d)
 	}
 }
+
+func TestNewSSTunnel(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want SSTunnel
+	}{
+		{"ss://aes-256-cfb:pass@1.2.3.4:8388",
+			SSTunnel{Ip: "1.2.3.4", Port: "8388", Password: "pass", Method: "aes-256-cfb"}},
+		{"ss://YWVzLTI1Ni1jZmI6cEBzczp3L3Jk@my-server.example.com:443#%E9%A6%99%E6%B8%AF",
+			SSTunnel{Ip: "my-server.example.com", Port: "443", Password: "p@ss:w/rd", Method: "aes-256-cfb", Remark: "香港"}},
+		{"ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTp0ZXN0@[::1]:8388/?plugin=obfs-local%3Bobfs%3Dhttp%3Bobfs-host%3Dexample.com",
+			SSTunnel{Ip: "::1", Port: "8388", Password: "test", Method: "chacha20-ietf-poly1305", Plugin: "obfs-local", PluginOpts: "obfs=http;obfs-host=example.com"}},
+		{"ss://cmM0LW1kNTpzZWNyZXRAZXhhbXBsZS5jb206ODM4OA==#legacy",
+			SSTunnel{Ip: "example.com", Port: "8388", Password: "secret", Method: "rc4-md5", Remark: "legacy"}},
+	}
+	for _, test := range tests {
+		tunnel, err := NewSSTunnel(test.uri)
+		if err != nil {
+			t.Errorf("%s: %v", test.uri, err)
+			continue
+		}
+		if *tunnel != test.want {
+			t.Errorf("%s: got %+v, want %+v", test.uri, *tunnel, test.want)
+		}
+		again, err := NewSSTunnel(tunnel.ToString())
+		if err != nil || *again != *tunnel {
+			t.Errorf("%s: lost in round trip through %s", test.uri, tunnel.ToString())
+		}
+	}
+	for _, uri := range []string{"http://1.2.3.4:8388", "ss://aes-256-cfb:pass@1.2.3.4", "ss://nomethod@1.2.3.4:8388"} {
+		if _, err := NewSSTunnel(uri); err == nil {
+			t.Errorf("%s accepted", uri)
+		}
+	}
+	if s := (&SSTunnel{Ip: "1.2.3.4", Port: "8388", Password: "pass", Method: "aes-256-cfb"}).ToString(); s != "ss://aes-256-cfb:pass@1.2.3.4:8388" {
+		t.Errorf("plain tunnel formatted as %s", s)
+	}
+}
+
+func TestTunnelRecords(t *testing.T) {
+	uris := []string{
+		"ss://aes-256-cfb:pass@1.1.1.1:8388#one",
+		"ss://aes-256-cfb:pass@2.2.2.2:8388",
+		"ss://aes-256-cfb:pass@3.3.3.3:8388",
+	}
+	c := &Config{SSTunnels: uris}
+	c2 := &Config{SSTunnels: uris}
+	if !c.migrateTunnels() || !c2.migrateTunnels() || c.migrateTunnels() {
+		t.Fatal("migration should run once")
+	}
+	if len(c.Tunnels) != 3 || c.SSTunnels != nil {
+		t.Fatalf("migrated to %v", c.TunnelURIs())
+	}
+	for i, r := range c.Tunnels {
+		if r.Id != c2.Tunnels[i].Id || !r.Enabled || r.Order != i {
+			t.Errorf("unexpected record %+v", r)
+		}
+	}
+	if c.Tunnels[0].Name != "one" {
+		t.Errorf("remark not kept as name: %q", c.Tunnels[0].Name)
+	}
+
+	c.Tunnels[1].Enabled = false
+	c.Tunnels[2].Name = "three"
+	c.MoveTunnel(c.Tunnels[2], 0)
+	var got []string
+	for _, tunnel := range c.GetSSTunnels() {
+		got = append(got, tunnel.Remark)
+	}
+	if fmt.Sprint(got) != "[three one]" {
+		t.Errorf("got tunnels %v", got)
+	}
+
+	r := c.GetTunnel(c.Tunnels[1].Id)
+	if err := c.SetTunnelUri(r, uris[1]); err == nil {
+		t.Error("duplicate uri accepted")
+	}
+	if err := c.SetTunnelUri(r, "ss://aes-256-cfb:pass@4.4.4.4:8388"); err != nil || r.Order != 1 {
+		t.Errorf("uri not changed in place: %v", err)
+	}
+}
+
+// tempStorage points the storage dir at a temp dir, so tests leave the
+// user's config alone, and returns a func restoring it.
+func tempStorage(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	folder := storageFolder
+	storageFolder = dir
+	os.MkdirAll(GetStorageDir(), 0755)
+	return func() {
+		storageFolder = folder
+		os.RemoveAll(dir)
+	}
+}
+
+func TestLastUsedSaved(t *testing.T) {
+	defer tempStorage(t)()
+
+	config, _ := LoadConfig()
+	err := config.AddTunnel("ss://aes-256-gcm:pass@127.0.0.1:8388")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = SetTunnels(config.GetSSTunnels()); err != nil {
+		t.Fatal(err)
+	}
+	defer SetTunnels(nil)
+	start := time.Now()
+	tl := &TrafficListener{}
+	if tl.pending(start) {
+		t.Error("nothing to sync yet")
+	}
+	servers.RLock()
+	atomic.StoreInt64(&servers.srvCipher[0].lastUsed, start.Unix())
+	servers.RUnlock()
+	if !tl.pending(start) {
+		t.Fatal("use of the tunnel not pending")
+	}
+	tl.Sync()
+
+	config, err = LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := config.Tunnels[0]
+	if r.LastUsed == nil || time.Time(*r.LastUsed).Unix() != start.Unix() {
+		t.Errorf("last used not saved: %v", r.LastUsed)
+	}
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Exporting the tunnels added by hand for other clients: a SIP008 document,
+// a Clash config that also carries the routing rules, the
+// shadowsocks-libev config and a plain list of ss:// uris.
+
+const redactedPassword = "REDACTED"
+
+var errExportFormat = errors.New("不支持的导出格式")
+
+// exportFormats maps the format names to file names and content types.
+var exportFormats = map[string][2]string{
+	"sip008": {"tongshe.json", "application/json"},
+	"clash":  {"tongshe.yaml", "text/yaml; charset=utf-8"},
+	"libev":  {"config.json", "application/json"},
+	"uri":    {"tongshe.txt", "text/plain; charset=utf-8"},
+}
+
+func exportServer(id int, t *SSTunnel) *sip008Server {
+	port, _ := strconv.Atoi(t.Port)
+	return &sip008Server{
+		Id:         strconv.Itoa(id),
+		Remarks:    t.Remark,
+		Server:     t.Ip,
+		ServerPort: port,
+		Password:   t.Password,
+		Method:     t.Method,
+		Plugin:     t.Plugin,
+		PluginOpts: t.PluginOpts,
+	}
+}
+
+type libevConfig struct {
+	Server     string `json:"server"`
+	ServerPort int    `json:"server_port"`
+	Password   string `json:"password"`
+	Method     string `json:"method"`
+	Plugin     string `json:"plugin,omitempty"`
+	PluginOpts string `json:"plugin_opts,omitempty"`
+}
+
+type clashExportProxy struct {
+	Name       string                 `yaml:"name"`
+	Type       string                 `yaml:"type"`
+	Server     string                 `yaml:"server"`
+	Port       int                    `yaml:"port"`
+	Cipher     string                 `yaml:"cipher"`
+	Password   string                 `yaml:"password"`
+	Udp        bool                   `yaml:"udp"`
+	Plugin     string                 `yaml:"plugin,omitempty"`
+	PluginOpts map[string]interface{} `yaml:"plugin-opts,omitempty"`
+}
+
+type clashProxyGroup struct {
+	Name    string   `yaml:"name"`
+	Type    string   `yaml:"type"`
+	Proxies []string `yaml:"proxies"`
+}
+
+type clashConfig struct {
+	Proxies     []*clashExportProxy `yaml:"proxies"`
+	ProxyGroups []*clashProxyGroup  `yaml:"proxy-groups"`
+	Rules       []string            `yaml:"rules"`
+}
+
+// clashPlugin is the reverse of clashPluginOpts.
+func clashPlugin(t *SSTunnel) (string, map[string]interface{}) {
+	opts := map[string]interface{}{}
+	for _, opt := range strings.Split(t.PluginOpts, ";") {
+		if opt == "" {
+			continue
+		}
+		kv := strings.SplitN(opt, "=", 2)
+		if len(kv) == 1 {
+			opts[kv[0]] = true
+		} else {
+			opts[kv[0]] = kv[1]
+		}
+	}
+	if isBuiltinObfs(t) {
+		obfs := map[string]interface{}{"mode": opts["obfs"]}
+		if host, ok := opts["obfs-host"]; ok {
+			obfs["host"] = host
+		}
+		return "obfs", obfs
+	}
+	return t.Plugin, opts
+}
+
+// clashRule writes a routing rule the clash way, empty for the rules clash
+// does not have.
+func clashRule(r *Rule) string {
+	action := map[string]string{ruleProxy: "Proxy", ruleDirect: "DIRECT", ruleReject: "REJECT"}[r.Action]
+	switch r.Type {
+	case "MATCH":
+		return "MATCH," + action
+	case "DOMAIN-REGEX":
+		return ""
+	case "IP-CIDR":
+		if r.ipnet.IP.To4() == nil {
+			return "IP-CIDR6," + r.Value + "," + action
+		}
+	case "DST-PORT":
+		if r.minPort != r.maxPort {
+			return ""
+		}
+	}
+	return r.Type + "," + r.Value + "," + action
+}
+
+func exportClash(tunnels []*SSTunnel, rules []*Rule) ([]byte, error) {
+	config := &clashConfig{}
+	group := &clashProxyGroup{Name: "Proxy", Type: "select"}
+	names := map[string]int{}
+	for _, t := range tunnels {
+		port, _ := strconv.Atoi(t.Port)
+		name := tunnelName(t)
+		// clash needs unique names
+		if n := names[name]; n > 0 {
+			names[name]++
+			name += " " + strconv.Itoa(n+1)
+		} else {
+			names[name] = 1
+		}
+		p := &clashExportProxy{
+			Name:     name,
+			Type:     "ss",
+			Server:   t.Ip,
+			Port:     port,
+			Cipher:   t.Method,
+			Password: t.Password,
+			Udp:      true,
+		}
+		if t.Plugin != "" {
+			p.Plugin, p.PluginOpts = clashPlugin(t)
+		}
+		config.Proxies = append(config.Proxies, p)
+		group.Proxies = append(group.Proxies, name)
+	}
+	config.ProxyGroups = []*clashProxyGroup{group}
+	final := false
+	for _, r := range rules {
+		if rule := clashRule(r); rule != "" {
+			config.Rules = append(config.Rules, rule)
+		}
+		if final = r.Type == "MATCH"; final {
+			break
+		}
+	}
+	if !final {
+		config.Rules = append(config.Rules, "MATCH,Proxy")
+	}
+	return yaml.Marshal(config)
+}
+
+// ExportTunnels writes the tunnels added by hand in format, with passwords
+// replaced when redact is set.
+func (c *Config) ExportTunnels(format string, redact bool) ([]byte, error) {
+	tunnels := []*SSTunnel{}
+	for _, r := range c.Tunnels {
+		t, err := r.Tunnel()
+		if err != nil {
+			continue
+		}
+		if redact {
+			t.Password = redactedPassword
+		}
+		tunnels = append(tunnels, t)
+	}
+	switch format {
+	case "sip008":
+		doc := &sip008Config{Version: 1, Servers: []*sip008Server{}}
+		for i, t := range tunnels {
+			doc.Servers = append(doc.Servers, exportServer(i+1, t))
+		}
+		return json.MarshalIndent(doc, "", "  ")
+	case "clash":
+		return exportClash(tunnels, configRules(c))
+	case "libev":
+		// one config per server, a single server is a config file as is
+		configs := []*libevConfig{}
+		for _, t := range tunnels {
+			s := exportServer(0, t)
+			configs = append(configs, &libevConfig{s.Server, s.ServerPort, s.Password, s.Method, s.Plugin, s.PluginOpts})
+		}
+		if len(configs) == 1 {
+			return json.MarshalIndent(configs[0], "", "  ")
+		}
+		return json.MarshalIndent(configs, "", "  ")
+	case "uri":
+		var b bytes.Buffer
+		for _, t := range tunnels {
+			b.WriteString(t.ToString())
+			b.WriteString("\n")
+		}
+		return b.Bytes(), nil
+	}
+	return nil, errExportFormat
+}
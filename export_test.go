@@ -0,0 +1,57 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportTunnels(t *testing.T) {
+	config := &Config{
+		SSTunnels: []string{
+			"ss://aes-256-cfb:pass@1.2.3.4:8388#home",
+			"ss://aes-128-gcm:pass@tokyo.example.com:443/?plugin=obfs-local%3Bobfs%3Dtls%3Bobfs-host%3Dwww.bing.com#tokyo",
+		},
+		Config: map[string]string{"diy_domains": "example.com, 10.0.0.0/8"},
+	}
+	config.migrateTunnels()
+	uris := config.TunnelURIs()
+	// what goes out comes back in
+	for _, format := range []string{"sip008", "clash", "libev", "uri"} {
+		bt, err := config.ExportTunnels(format, false)
+		if err != nil {
+			t.Errorf("%s: %v", format, err)
+			continue
+		}
+		entries, err := ParseImport(bt, true)
+		if err != nil {
+			t.Errorf("%s: %v", format, err)
+			continue
+		}
+		if len(entries) != len(uris) {
+			t.Errorf("%s: got %d tunnels back", format, len(entries))
+			continue
+		}
+		for i, e := range entries {
+			want := uris[i]
+			if format == "libev" {
+				want = want[:strings.IndexByte(want, '#')] // no remarks
+			}
+			if e.Tunnel != want {
+				t.Errorf("%s: got %s, want %s", format, e.Tunnel, want)
+			}
+		}
+	}
+
+	bt, _ := config.ExportTunnels("clash", true)
+	for _, s := range []string{"DOMAIN-SUFFIX,example.com,Proxy", "IP-CIDR,10.0.0.0/8,Proxy", "IP-CIDR6,fc00::/7,DIRECT", "MATCH,", redactedPassword} {
+		if !strings.Contains(string(bt), s) {
+			t.Errorf("clash config misses %s:\n%s", s, bt)
+		}
+	}
+	if strings.Contains(string(bt), "pass\n") {
+		t.Errorf("password not redacted:\n%s", bt)
+	}
+	if _, err := config.ExportTunnels("surge", false); err != errExportFormat {
+		t.Errorf("unknown format accepted: %v", err)
+	}
+}
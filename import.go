@@ -0,0 +1,357 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Importing tunnels from the configs of other clients: shadowsocks-windows
+// gui-config.json, shadowsocks-libev config.json, SIP008 documents, Clash
+// YAML, and lists of ss:// uris or Outline access keys.
+
+var (
+	errImportFormat = errors.New("无法识别的配置格式")
+	errProxyType    = errors.New("不支持的代理类型")
+)
+
+// ImportEntry statuses.
+const (
+	importAdded     = "added"
+	importDuplicate = "duplicate"
+	importInvalid   = "invalid"
+)
+
+// ImportEntry reports what became of one server of an imported config.
+type ImportEntry struct {
+	Name   string `json:"name"`
+	Tunnel string `json:"tunnel"`
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	tunnel *SSTunnel
+}
+
+func importEntry(name string, tunnel *SSTunnel, err error) *ImportEntry {
+	e := &ImportEntry{Name: name}
+	if err == nil {
+		err = checkTunnel(tunnel)
+	}
+	if err != nil {
+		e.Status = importInvalid
+		e.Error = err.Error()
+		return e
+	}
+	e.tunnel = tunnel
+	e.Tunnel = tunnel.ToString()
+	return e
+}
+
+// ssServer is a server entry as shadowsocks-windows, shadowsocks-libev and
+// SIP008 write it.
+type ssServer struct {
+	Server     json.RawMessage `json:"server"` // libev allows a list of addresses
+	ServerPort int             `json:"server_port"`
+	Password   string          `json:"password"`
+	Method     string          `json:"method"`
+	Plugin     string          `json:"plugin"`
+	PluginOpts string          `json:"plugin_opts"`
+	Remarks    string          `json:"remarks"`
+}
+
+func (s *ssServer) entries() []*ImportEntry {
+	var hosts []string
+	var host string
+	if err := json.Unmarshal(s.Server, &host); err == nil {
+		hosts = []string{host}
+	} else if err = json.Unmarshal(s.Server, &hosts); err != nil || len(hosts) == 0 {
+		return []*ImportEntry{importEntry(s.Remarks, nil, errSSFormat)}
+	}
+	var entries []*ImportEntry
+	for _, host := range hosts {
+		name := s.Remarks
+		if name == "" {
+			name = host
+		}
+		tunnel := &SSTunnel{
+			Ip:         host,
+			Port:       strconv.Itoa(s.ServerPort),
+			Password:   s.Password,
+			Method:     strings.ToLower(s.Method),
+			Plugin:     s.Plugin,
+			PluginOpts: s.PluginOpts,
+			Remark:     s.Remarks,
+		}
+		var err error
+		if host == "" || s.ServerPort <= 0 || s.ServerPort > 65535 || s.Password == "" || s.Method == "" {
+			err = errSSFormat
+		} else {
+			// a round through the uri checks the method and the key
+			tunnel, err = NewSSTunnel(tunnel.ToString())
+		}
+		entries = append(entries, importEntry(name, tunnel, err))
+	}
+	return entries
+}
+
+func importJSON(data []byte) ([]*ImportEntry, error) {
+	var doc struct {
+		ssServer
+		Configs []*ssServer `json:"configs"` // shadowsocks-windows
+		Servers []*ssServer `json:"servers"` // SIP008
+	}
+	var servers []*ssServer
+	if data[0] == '[' {
+		// shadowsocks-libev configs, as exported for more than one server
+		if err := json.Unmarshal(data, &servers); err != nil {
+			return nil, err
+		}
+	} else if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	servers = append(servers, append(doc.Configs, doc.Servers...)...)
+	if len(doc.Server) > 0 {
+		servers = append(servers, &doc.ssServer) // shadowsocks-libev
+	}
+	if len(servers) == 0 {
+		return nil, errImportFormat
+	}
+	var entries []*ImportEntry
+	for _, s := range servers {
+		entries = append(entries, s.entries()...)
+	}
+	return entries, nil
+}
+
+type clashProxy struct {
+	Name       string                 `yaml:"name"`
+	Type       string                 `yaml:"type"`
+	Server     string                 `yaml:"server"`
+	Port       int                    `yaml:"port"`
+	Cipher     string                 `yaml:"cipher"`
+	Password   string                 `yaml:"password"`
+	Plugin     string                 `yaml:"plugin"`
+	PluginOpts map[string]interface{} `yaml:"plugin-opts"`
+}
+
+// clashPluginOpts turns clash plugin options into SIP003 ones.
+func clashPluginOpts(plugin string, opts map[string]interface{}) (string, string) {
+	var args []string
+	keys := make([]string, 0, len(opts))
+	for k := range opts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if _, ok := opts[k].(map[interface{}]interface{}); ok || k == "skip-cert-verify" {
+			continue // nothing SIP003 plugins take
+		}
+		v := fmt.Sprint(opts[k])
+		switch {
+		case plugin == "obfs" && k == "mode":
+			args = append([]string{"obfs=" + v}, args...)
+		case plugin == "obfs" && k == "host":
+			args = append(args, "obfs-host="+v)
+		case v == "true":
+			args = append(args, k) // v2ray-plugin flags like tls
+		case v != "false":
+			args = append(args, k+"="+v)
+		}
+	}
+	if plugin == "obfs" {
+		plugin = "obfs-local"
+	}
+	return plugin, strings.Join(args, ";")
+}
+
+func importClash(data []byte) ([]*ImportEntry, error) {
+	var doc struct {
+		Proxies []*clashProxy `yaml:"proxies"`
+		Proxy   []*clashProxy `yaml:"Proxy"` // older clash versions
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	proxies := append(doc.Proxies, doc.Proxy...)
+	if len(proxies) == 0 {
+		return nil, errImportFormat
+	}
+	var entries []*ImportEntry
+	for _, p := range proxies {
+		if p.Type != "ss" {
+			entries = append(entries, importEntry(p.Name, nil, errProxyType))
+			continue
+		}
+		s := &ssServer{
+			ServerPort: p.Port,
+			Password:   p.Password,
+			Method:     p.Cipher,
+			Remarks:    p.Name,
+		}
+		s.Server, _ = json.Marshal(p.Server)
+		if p.Plugin != "" {
+			s.Plugin, s.PluginOpts = clashPluginOpts(p.Plugin, p.PluginOpts)
+		}
+		entries = append(entries, s.entries()...)
+	}
+	return entries, nil
+}
+
+// fetchOutlineKey resolves a dynamic Outline access key, ssconf://, served
+// over https as a SIP008 style server or an ss:// uri.
+func fetchOutlineKey(key string) (*SSTunnel, error) {
+	u := "https://" + strings.TrimPrefix(key, "ssconf://")
+	remark := ""
+	if i := strings.IndexByte(u, '#'); i >= 0 {
+		u, remark = u[:i], u[i+1:]
+		if unescaped, err := url.PathUnescape(remark); err == nil {
+			remark = unescaped
+		}
+	}
+	resp, err := (&http.Client{Timeout: subscriptionTimeout}).Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("访问密钥返回%s", resp.Status)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, subscriptionMaxSize))
+	if err != nil {
+		return nil, err
+	}
+	body = bytes.TrimSpace(body)
+	if !bytes.HasPrefix(body, []byte("{")) {
+		return NewSSTunnel(string(body))
+	}
+	s := &ssServer{}
+	if err = json.Unmarshal(body, s); err != nil {
+		return nil, err
+	}
+	s.Remarks = remark
+	e := s.entries()[0]
+	if e.Error != "" {
+		return nil, errors.New(e.Error)
+	}
+	return e.tunnel, nil
+}
+
+// ssURIPattern finds ss:// uris and Outline access keys in text, up to a
+// space, a quote, a bracket or CJK punctuation. Remarks may be unescaped.
+var ssURIPattern = regexp.MustCompile(`\bss(?:conf)?://[^\s"'<>(){}，。；：！？、（）【】「」]+`)
+
+// findSSURIs returns the uris in text, with the punctuation of the sentence
+// they end dropped.
+func findSSURIs(text string) []string {
+	uris := ssURIPattern.FindAllString(text, -1)
+	for i, u := range uris {
+		uris[i] = strings.TrimRight(u, ".,;:!?")
+	}
+	return uris
+}
+
+func importURIs(data []byte, fetchKeys bool) ([]*ImportEntry, error) {
+	var entries []*ImportEntry
+	for _, line := range findSSURIs(string(data)) {
+		var tunnel *SSTunnel
+		var err error
+		if strings.HasPrefix(line, "ssconf://") {
+			if !fetchKeys {
+				continue
+			}
+			tunnel, err = fetchOutlineKey(line)
+		} else {
+			tunnel, err = NewSSTunnel(line)
+		}
+		name := line
+		if tunnel != nil {
+			name = tunnelName(tunnel)
+		}
+		entries = append(entries, importEntry(name, tunnel, err))
+	}
+	if len(entries) == 0 {
+		return nil, errImportFormat
+	}
+	return entries, nil
+}
+
+// tunnelName names an imported tunnel by its remark, or its address.
+func tunnelName(tunnel *SSTunnel) string {
+	if tunnel.Remark != "" {
+		return tunnel.Remark
+	}
+	return tunnel.Ip + ":" + tunnel.Port
+}
+
+// ParseImport reads the servers of a config in any of the known formats.
+// Outline access keys are only fetched with fetchKeys, not for text pasted
+// with links mixed in its prose.
+func ParseImport(data []byte, fetchKeys bool) ([]*ImportEntry, error) {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(data, []byte("{")) || bytes.HasPrefix(data, []byte("[")):
+		return importJSON(data)
+	case bytes.Contains(data, []byte("proxies:")) || bytes.Contains(data, []byte("Proxy:")):
+		return importClash(data)
+	case bytes.Contains(data, []byte("ss://")) || bytes.Contains(data, []byte("ssconf://")):
+		return importURIs(data, fetchKeys)
+	}
+	return nil, errImportFormat
+}
+
+// ImportTunnels adds the servers of the config in data to the tunnels,
+// servers already there are reported and skipped.
+func (c *Config) ImportTunnels(data []byte, fetchKeys bool) ([]*ImportEntry, error) {
+	entries, err := ParseImport(data, fetchKeys)
+	if err != nil {
+		return nil, err
+	}
+	return entries, c.addImported(entries)
+}
+
+// addImported adds the valid entries that are not known yet, in one save.
+func (c *Config) addImported(entries []*ImportEntry) error {
+	// disabled records count too, like in hasTunnel
+	known := map[string]bool{}
+	for _, r := range c.Tunnels {
+		if t, err := NewSSTunnel(r.Uri); err == nil {
+			known[tunnelKey(t)] = true
+		}
+	}
+	added := 0
+	for _, e := range entries {
+		if e.tunnel == nil {
+			continue
+		}
+		key := tunnelKey(e.tunnel)
+		if known[key] {
+			e.Status = importDuplicate
+			e.Error = "该Shadowsocks账号已存在"
+			continue
+		}
+		r, err := NewTunnelRecord(e.Tunnel)
+		if err != nil {
+			e.Status = importInvalid
+			e.Error = err.Error()
+			continue
+		}
+		known[key] = true
+		e.Status = importAdded
+		c.addTunnelRecord(r)
+		added++
+	}
+	if added == 0 {
+		return nil
+	}
+	return SaveConfig(c)
+}
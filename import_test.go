@@ -0,0 +1,115 @@
+package main
+
+import "testing"
+
+func TestParseImport(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		tunnels []string
+		errors  int
+	}{
+		{"shadowsocks-windows", `{
+			"configs": [
+				{"server": "1.2.3.4", "server_port": 8388, "password": "pass", "method": "aes-256-cfb", "remarks": "home"},
+				{"server": "5.6.7.8", "server_port": 8388, "password": "pass", "method": "aes-256-gcm", "plugin": "obfs-local", "plugin_opts": "obfs=http", "remarks": ""},
+				{"server": "", "server_port": 8388, "password": "pass", "method": "aes-256-cfb"}
+			],
+			"localPort": 1080
+		}`, []string{"ss://aes-256-cfb:pass@1.2.3.4:8388#home", "ss://aes-256-gcm:pass@5.6.7.8:8388/?plugin=obfs-local%3Bobfs%3Dhttp"}, 1},
+		{"shadowsocks-libev", `{"server": ["1.2.3.4", "::1"], "server_port": 8388, "local_port": 1080, "password": "pass", "method": "chacha20-ietf-poly1305"}`,
+			[]string{"ss://chacha20-ietf-poly1305:pass@1.2.3.4:8388", "ss://chacha20-ietf-poly1305:pass@[::1]:8388"}, 0},
+		{"clash", `
+port: 7890
+proxies:
+  - name: "tokyo"
+    type: ss
+    server: tokyo.example.com
+    port: 443
+    cipher: aes-128-gcm
+    password: "pass"
+    plugin: obfs
+    plugin-opts:
+      mode: tls
+      host: www.bing.com
+  - name: "vmess"
+    type: vmess
+    server: 1.2.3.4
+    port: 443
+`, []string{"ss://aes-128-gcm:pass@tokyo.example.com:443/?plugin=obfs-local%3Bobfs%3Dtls%3Bobfs-host%3Dwww.bing.com#tokyo"}, 1},
+		{"uris", "ss://YWVzLTI1Ni1nY206cGFzcw@1.2.3.4:8388/?outline=1#outline\nss://broken\n",
+			[]string{"ss://aes-256-gcm:pass@1.2.3.4:8388#outline"}, 1},
+	}
+	for _, test := range tests {
+		entries, err := ParseImport([]byte(test.data), true)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		var tunnels []string
+		errors := 0
+		for _, e := range entries {
+			if e.Error != "" {
+				errors++
+			} else {
+				tunnels = append(tunnels, e.Tunnel)
+			}
+		}
+		if errors != test.errors || len(tunnels) != len(test.tunnels) {
+			t.Errorf("%s: got %v with %d errors", test.name, tunnels, errors)
+			continue
+		}
+		for i := range tunnels {
+			if tunnels[i] != test.tunnels[i] {
+				t.Errorf("%s: got %s, want %s", test.name, tunnels[i], test.tunnels[i])
+			}
+		}
+	}
+	if _, err := ParseImport([]byte("hello"), true); err != errImportFormat {
+		t.Errorf("unknown format accepted: %v", err)
+	}
+}
+
+func TestFindSSURIs(t *testing.T) {
+	text := `您好，以下是新的节点：
+香港 ss://YWVzLTI1Ni1nY206cGFzcw@1.2.3.4:8388#香港01，东京(ss://aes-128-gcm:pass@[::1]:443).
+备用: "ss://broken", see https://example.com/xss://nope and ssconf://keys.example.com/abc!`
+	want := []string{
+		"ss://YWVzLTI1Ni1nY206cGFzcw@1.2.3.4:8388#香港01",
+		"ss://aes-128-gcm:pass@[::1]:443",
+		"ss://broken",
+		"ssconf://keys.example.com/abc",
+	}
+	got := findSSURIs(text)
+	if len(got) != len(want) {
+		t.Fatalf("got %q", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %s, want %s", got[i], want[i])
+		}
+	}
+}
+
+func TestImportTunnelsReport(t *testing.T) {
+	config := &Config{SSTunnels: []string{"ss://aes-256-gcm:pass@1.2.3.4:8388"}}
+	config.migrateTunnels()
+	entries, err := config.ImportTunnels([]byte("old one ss://YWVzLTI1Ni1nY206cGFzcw@1.2.3.4:8388#renamed and ss://broken, skip ssconf://keys.example.com/abc"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Status != importDuplicate || entries[1].Status != importInvalid || entries[1].Error == "" {
+		t.Errorf("unexpected report %+v", entries)
+	}
+	if len(config.Tunnels) != 1 {
+		t.Errorf("tunnels changed: %v", config.TunnelURIs())
+	}
+	config.Tunnels[0].Enabled = false
+	entries, err = config.ImportTunnels([]byte("ss://aes-256-gcm:pass@1.2.3.4:8388#disabled"), false)
+	if err != nil || len(entries) != 1 || entries[0].Status != importDuplicate || len(config.Tunnels) != 1 {
+		t.Errorf("disabled tunnel imported again: %+v %v", entries, err)
+	}
+	if _, err = config.ImportTunnels([]byte("no links here"), false); err != errImportFormat {
+		t.Errorf("text without links: %v", err)
+	}
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// The proxy listeners are keyed by the name of their port setting, so a
+// listener can be replaced when its port changes.
+var proxyListeners = struct {
+	sync.Mutex
+	m map[string]net.Listener
+}{m: map[string]net.Listener{}}
+
+// listen binds addr for the named listener and closes the one it replaces.
+// The old listener is kept when binding fails.
+func listen(name, addr string) (net.Listener, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	proxyListeners.Lock()
+	old := proxyListeners.m[name]
+	proxyListeners.m[name] = ln
+	proxyListeners.Unlock()
+	if old != nil {
+		old.Close()
+	}
+	return ln, nil
+}
+
+func closeListener(name string) {
+	proxyListeners.Lock()
+	ln := proxyListeners.m[name]
+	delete(proxyListeners.m, name)
+	proxyListeners.Unlock()
+	if ln != nil {
+		ln.Close()
+	}
+}
+
+// listenerActive tells whether ln is still the named listener, accept loops
+// use it to stop once their listener got replaced or closed.
+func listenerActive(name string, ln net.Listener) bool {
+	proxyListeners.Lock()
+	defer proxyListeners.Unlock()
+	return proxyListeners.m[name] == ln
+}
+
+// listenerAddr returns where clients on this machine reach the named
+// listener.
+func listenerAddr(name string) string {
+	proxyListeners.Lock()
+	defer proxyListeners.Unlock()
+	ln, ok := proxyListeners.m[name]
+	if !ok {
+		return ""
+	}
+	if addr, ok := ln.Addr().(*net.TCPAddr); ok && addr.IP.IsUnspecified() {
+		return net.JoinHostPort(proxyHost, strconv.Itoa(addr.Port))
+	}
+	return ln.Addr().String()
+}
+
+// bindAddr turns the address local clients use for a proxy port into the
+// one the port listens on.
+func bindAddr(config *Config, addr string) string {
+	_, port, _ := net.SplitHostPort(addr)
+	return net.JoinHostPort(GetListenHost(config), port)
+}
+
+var errListenerClosed = errors.New("listener closed")
+
+// connListener is a net.Listener fed with connections accepted somewhere
+// else, so an http.Server can serve the connections the mixed port found to
+// be http.
+type connListener struct {
+	addr   net.Addr
+	conns  chan net.Conn
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newConnListener(addr net.Addr) *connListener {
+	return &connListener{
+		addr:   addr,
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.closed:
+		return nil, errListenerClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	l.once.Do(func() { close(l.closed) })
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.addr
+}
+
+func (l *connListener) push(conn net.Conn) {
+	select {
+	case l.conns <- conn:
+	case <-l.closed:
+		conn.Close()
+	}
+}
+
+// StartMixed serves socks4, socks5 and http proxy clients on a single port.
+// The first byte of a connection tells them apart: socks requests start with
+// the protocol version, http ones with a method name.
+func StartMixed() {
+	config, _ := LoadConfig()
+	addr := GetMixedAddr(config)
+	if addr == "" {
+		closeListener("mixed_port")
+		return
+	}
+	addr = bindAddr(config, addr)
+	ln, err := listen("mixed_port", addr)
+	if err != nil {
+		log.Printf("Failed to start mixed proxy at %s: %v", addr, err)
+		return
+	}
+	log.Printf("start mixed socks/http proxy at: %s", addr)
+	httpLn := newConnListener(ln.Addr())
+	defer httpLn.Close()
+	go http.Serve(httpLn, proxyAuth(newHttpProxy()))
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if !listenerActive("mixed_port", ln) {
+				return
+			}
+			log.Println("accept:", err)
+			continue
+		}
+		go dispatchMixed(conn, httpLn)
+	}
+}
+
+func dispatchMixed(conn net.Conn, httpLn *connListener) {
+	const peekTimeout = 30 * time.Second
+	bc := newBufferedConn(conn)
+	bc.SetReadDeadline(time.Now().Add(peekTimeout))
+	first, err := bc.Peek(1)
+	if err != nil {
+		log.Println("mixed proxy read:", err)
+		conn.Close()
+		return
+	}
+	bc.SetReadDeadline(time.Time{})
+	switch first[0] {
+	case socksVer4, socksVer5:
+		handleConnection(bc, TrafficCounter)
+	default:
+		httpLn.push(bc)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDispatchMixed(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	httpLn := newConnListener(ln.Addr())
+	defer httpLn.Close()
+	connects := make(chan string, 1)
+	go http.Serve(httpLn, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connects <- r.Host
+	}))
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go dispatchMixed(conn, httpLn)
+		}
+	}()
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	conn.Write([]byte("CONNECT example.com:443 HTTP/1.1\r\nHost: example.com:443\r\n\r\n"))
+	resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("CONNECT not answered: %v %v", resp, err)
+	}
+	select {
+	case host := <-connects:
+		if host != "example.com:443" {
+			t.Errorf("http proxy got %s", host)
+		}
+	case <-time.After(time.Second):
+		t.Error("CONNECT did not reach the http proxy")
+	}
+
+	conn, err = net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	conn.Write([]byte{socksVer5, 1, socksMethodNoAuth})
+	reply := make([]byte, 2)
+	if _, err = io.ReadFull(conn, reply); err != nil || !bytes.Equal(reply, []byte{socksVer5, socksMethodNoAuth}) {
+		t.Errorf("socks5 hello not answered: %v %v", reply, err)
+	}
+	select {
+	case host := <-connects:
+		t.Errorf("socks5 hello reached the http proxy as %s", host)
+	default:
+	}
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Built-in simple-obfs client, so tunnels using the obfs-local plugin need
+// no external process. Both modes only dress up the start of the connection:
+// the http mode sends the first data as the body of a websocket upgrade
+// request, the tls mode as the session ticket of a TLS ClientHello and the
+// rest as TLS application data records.
+
+const (
+	obfsDefaultHost = "cloudfront.net"
+	obfsDefaultURI  = "/"
+
+	tlsRecordHandshake        = 0x16
+	tlsRecordChangeCipherSpec = 0x14
+	tlsRecordApplicationData  = 0x17
+	tlsMaxRecordSize          = 16 * 1024
+)
+
+var (
+	errObfsMode     = errors.New("obfs mode must be http or tls")
+	errObfsResponse = errors.New("obfs server response malformed")
+	errObfsOTA      = errors.New("one time auth is not supported with built-in obfs")
+)
+
+type obfsConfig struct {
+	mode string // http or tls
+	host string
+	uri  string
+}
+
+// isBuiltinObfs tells whether the tunnel's plugin is handled in process.
+func isBuiltinObfs(tunnel *SSTunnel) bool {
+	return tunnel.Plugin == "obfs-local" || tunnel.Plugin == "simple-obfs"
+}
+
+// parseObfsOpts reads plugin options like obfs=http;obfs-host=www.bing.com
+func parseObfsOpts(opts string) (*obfsConfig, error) {
+	c := &obfsConfig{host: obfsDefaultHost, uri: obfsDefaultURI}
+	for _, opt := range strings.Split(opts, ";") {
+		kv := strings.SplitN(opt, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch strings.TrimSpace(kv[0]) {
+		case "obfs":
+			c.mode = strings.TrimSpace(kv[1])
+		case "obfs-host":
+			c.host = strings.TrimSpace(kv[1])
+		case "obfs-uri":
+			c.uri = strings.TrimSpace(kv[1])
+		}
+	}
+	if c.mode != "http" && c.mode != "tls" {
+		return nil, errObfsMode
+	}
+	return c, nil
+}
+
+// wrap dresses up conn, a connection to server.
+func (c *obfsConfig) wrap(conn net.Conn, server string) net.Conn {
+	if c.mode == "tls" {
+		return &obfsTLSConn{Conn: conn, host: c.host}
+	}
+	host := c.host
+	if _, port, err := net.SplitHostPort(server); err == nil && port != "80" {
+		host = net.JoinHostPort(host, port)
+	}
+	return &obfsHTTPConn{Conn: conn, host: host, uri: c.uri}
+}
+
+func randInt(max int64) int64 {
+	n, err := rand.Int(rand.Reader, big.NewInt(max))
+	if err != nil {
+		return 0
+	}
+	return n.Int64()
+}
+
+type obfsHTTPConn struct {
+	net.Conn
+	host, uri string
+
+	wmu     sync.Mutex
+	written bool
+	r       *bufio.Reader
+}
+
+func (c *obfsHTTPConn) Write(b []byte) (int, error) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if c.written {
+		return c.Conn.Write(b)
+	}
+	key := make([]byte, 16)
+	rand.Read(key)
+	req := fmt.Sprintf("GET %s HTTP/1.1\r\n"+
+		"Host: %s\r\n"+
+		"User-Agent: curl/7.%d.%d\r\n"+
+		"Upgrade: websocket\r\n"+
+		"Connection: Upgrade\r\n"+
+		"Sec-WebSocket-Key: %s\r\n"+
+		"Content-Length: %d\r\n"+
+		"\r\n",
+		c.uri, c.host, randInt(51), randInt(2), base64.StdEncoding.EncodeToString(key), len(b))
+	if _, err := c.Conn.Write(append([]byte(req), b...)); err != nil {
+		return 0, err
+	}
+	c.written = true
+	return len(b), nil
+}
+
+func (c *obfsHTTPConn) Read(b []byte) (int, error) {
+	if c.r == nil {
+		c.r = bufio.NewReader(c.Conn)
+		status, err := c.r.ReadString('\n')
+		if err != nil {
+			return 0, err
+		}
+		if !strings.HasPrefix(status, "HTTP/1.") {
+			return 0, errObfsResponse
+		}
+		for {
+			line, err := c.r.ReadString('\n')
+			if err != nil {
+				return 0, err
+			}
+			if line == "\r\n" {
+				break
+			}
+		}
+	}
+	return c.r.Read(b)
+}
+
+// tlsCipherSuites is what simple-obfs offers, servers expect this exact size.
+var tlsCipherSuites = []byte{
+	0xc0, 0x2c, 0xc0, 0x30, 0x00, 0x9f, 0xcc, 0xa9, 0xcc, 0xa8, 0xcc, 0xaa, 0xc0, 0x2b, 0xc0, 0x2f,
+	0x00, 0x9e, 0xc0, 0x24, 0xc0, 0x28, 0x00, 0x6b, 0xc0, 0x23, 0xc0, 0x27, 0x00, 0x67, 0xc0, 0x0a,
+	0xc0, 0x14, 0x00, 0x39, 0xc0, 0x09, 0xc0, 0x13, 0x00, 0x33, 0x00, 0x9d, 0x00, 0x9c, 0x00, 0x3d,
+	0x00, 0x3c, 0x00, 0x35, 0x00, 0x2f, 0x00, 0xff,
+}
+
+// tlsOtherExtensions: ec point formats, supported groups, signature
+// algorithms, encrypt then mac and extended master secret.
+var tlsOtherExtensions = []byte{
+	0x00, 0x0b, 0x00, 0x04, 0x03, 0x01, 0x00, 0x02,
+	0x00, 0x0a, 0x00, 0x0a, 0x00, 0x08, 0x00, 0x1d, 0x00, 0x17, 0x00, 0x19, 0x00, 0x18,
+	0x00, 0x0d, 0x00, 0x20, 0x00, 0x1e,
+	0x06, 0x01, 0x06, 0x02, 0x06, 0x03, 0x05, 0x01, 0x05, 0x02, 0x05, 0x03, 0x04, 0x01, 0x04, 0x02,
+	0x04, 0x03, 0x03, 0x01, 0x03, 0x02, 0x03, 0x03, 0x02, 0x01, 0x02, 0x02, 0x02, 0x03,
+	0x00, 0x16, 0x00, 0x00,
+	0x00, 0x17, 0x00, 0x00,
+}
+
+type obfsTLSConn struct {
+	net.Conn
+	host string
+
+	wmu    sync.Mutex
+	writes int
+
+	record []byte // application data not returned yet
+}
+
+func appendUint16(b []byte, v int) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func tlsRandom() []byte {
+	random := make([]byte, 32)
+	rand.Read(random)
+	binary.BigEndian.PutUint32(random, uint32(time.Now().Unix()))
+	return random
+}
+
+// clientHello carries payload as session ticket.
+func (c *obfsTLSConn) clientHello(payload []byte) []byte {
+	var ext []byte
+	ext = appendUint16(ext, 0x0023) // session ticket
+	ext = appendUint16(ext, len(payload))
+	ext = append(ext, payload...)
+	ext = appendUint16(ext, 0x0000) // server name
+	ext = appendUint16(ext, len(c.host)+5)
+	ext = appendUint16(ext, len(c.host)+3)
+	ext = append(ext, 0)
+	ext = appendUint16(ext, len(c.host))
+	ext = append(ext, c.host...)
+	ext = append(ext, tlsOtherExtensions...)
+
+	sessionID := make([]byte, 32)
+	rand.Read(sessionID)
+	var hello []byte
+	hello = append(hello, 0x03, 0x03)
+	hello = append(hello, tlsRandom()...)
+	hello = append(hello, byte(len(sessionID)))
+	hello = append(hello, sessionID...)
+	hello = appendUint16(hello, len(tlsCipherSuites))
+	hello = append(hello, tlsCipherSuites...)
+	hello = append(hello, 1, 0) // null compression
+	hello = appendUint16(hello, len(ext))
+	hello = append(hello, ext...)
+
+	record := []byte{tlsRecordHandshake, 0x03, 0x01}
+	record = appendUint16(record, len(hello)+4)
+	record = append(record, 0x01, byte(len(hello)>>16), byte(len(hello)>>8), byte(len(hello)))
+	return append(record, hello...)
+}
+
+func (c *obfsTLSConn) Write(b []byte) (int, error) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	n := len(b)
+	var out []byte
+	switch c.writes {
+	case 0:
+		out = c.clientHello(b)
+		b = nil
+	case 1:
+		// the handshake the server is waiting for ends the client side
+		out = append(out, tlsRecordChangeCipherSpec, 0x03, 0x03, 0x00, 0x01, 0x01)
+		finished := make([]byte, 32)
+		rand.Read(finished)
+		out = append(out, tlsRecordHandshake, 0x03, 0x03, 0x00, byte(len(finished)))
+		out = append(out, finished...)
+	}
+	for len(b) > 0 {
+		size := len(b)
+		if size > tlsMaxRecordSize {
+			size = tlsMaxRecordSize
+		}
+		out = append(out, tlsRecordApplicationData, 0x03, 0x03)
+		out = appendUint16(out, size)
+		out = append(out, b[:size]...)
+		b = b[size:]
+	}
+	if _, err := c.Conn.Write(out); err != nil {
+		return 0, err
+	}
+	c.writes++
+	return n, nil
+}
+
+// Read returns the application data records, handshake records the server
+// sends ahead of them are skipped.
+func (c *obfsTLSConn) Read(b []byte) (int, error) {
+	header := make([]byte, 5)
+	for len(c.record) == 0 {
+		if _, err := io.ReadFull(c.Conn, header); err != nil {
+			return 0, err
+		}
+		record := make([]byte, binary.BigEndian.Uint16(header[3:]))
+		if _, err := io.ReadFull(c.Conn, record); err != nil {
+			return 0, err
+		}
+		switch header[0] {
+		case tlsRecordApplicationData:
+			c.record = record
+		case tlsRecordHandshake, tlsRecordChangeCipherSpec:
+		default:
+			return 0, errObfsResponse
+		}
+	}
+	n := copy(b, c.record)
+	c.record = c.record[n:]
+	return n, nil
+}
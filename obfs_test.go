@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+)
+
+func TestParseObfsOpts(t *testing.T) {
+	c, err := parseObfsOpts("obfs=tls;obfs-host=www.bing.com")
+	if err != nil || c.mode != "tls" || c.host != "www.bing.com" || c.uri != obfsDefaultURI {
+		t.Errorf("unexpected obfs config %+v: %v", c, err)
+	}
+	if _, err = parseObfsOpts("obfs-host=www.bing.com"); err != errObfsMode {
+		t.Errorf("options without mode accepted: %v", err)
+	}
+	for _, opts := range []string{"", "%3Bobfs%3Dwebsocket"} {
+		uri := "ss://aes-256-gcm:pass@1.2.3.4:8388/?plugin=obfs-local" + opts
+		if _, err = NewTunnelRecord(uri); err == nil {
+			t.Errorf("tunnel with bad obfs options accepted: %s", uri)
+		}
+	}
+}
+
+func TestObfsHTTP(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	go func() {
+		defer server.Close()
+		br := bufio.NewReader(server)
+		req, err := http.ReadRequest(br)
+		if err != nil {
+			t.Errorf("server: %v", err)
+			return
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if req.Host != "www.bing.com:8388" || req.Header.Get("Upgrade") != "websocket" || string(body) != "hello" {
+			t.Errorf("server: unexpected request %v %q", req, body)
+			return
+		}
+		server.Write([]byte("HTTP/1.1 101 Switching Protocols\r\nServer: nginx\r\n\r\nhi"))
+		more := make([]byte, 5)
+		io.ReadFull(br, more)
+		server.Write(more)
+	}()
+
+	c := (&obfsConfig{mode: "http", host: "www.bing.com", uri: "/"}).wrap(client, "1.2.3.4:8388")
+	c.Write([]byte("hello"))
+	go c.Write([]byte("world"))
+	got := make([]byte, 7)
+	if _, err := io.ReadFull(c, got); err != nil || string(got) != "hiworld" {
+		t.Errorf("unexpected response %q: %v", got, err)
+	}
+}
+
+func TestObfsTLS(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	go func() {
+		defer server.Close()
+		readRecord := func() (byte, []byte) {
+			header := make([]byte, 5)
+			if _, err := io.ReadFull(server, header); err != nil {
+				return 0, nil
+			}
+			data := make([]byte, binary.BigEndian.Uint16(header[3:]))
+			io.ReadFull(server, data)
+			return header[0], data
+		}
+		typ, hello := readRecord()
+		// handshake header, version, random, session id, cipher suites,
+		// compression and extensions length come before the ticket
+		const ticket = 4 + 2 + 32 + 1 + 32 + 2 + 56 + 2 + 2
+		if typ != tlsRecordHandshake || len(hello) < ticket+4 || binary.BigEndian.Uint16(hello[ticket:]) != 0x0023 {
+			t.Errorf("server: unexpected client hello %v", hello)
+			return
+		}
+		size := int(binary.BigEndian.Uint16(hello[ticket+2:]))
+		if payload := hello[ticket+4 : ticket+4+size]; string(payload) != "hello" {
+			t.Errorf("server: ticket carries %q", payload)
+		}
+		if !bytes.Contains(hello, []byte("www.bing.com")) {
+			t.Error("server: no server name")
+		}
+		server.Write([]byte{tlsRecordHandshake, 3, 3, 0, 2, 0, 0, tlsRecordChangeCipherSpec, 3, 3, 0, 1, 1, tlsRecordApplicationData, 3, 3, 0, 2, 'h', 'i'})
+
+		var types []byte
+		for {
+			typ, data := readRecord()
+			types = append(types, typ)
+			if typ == tlsRecordApplicationData {
+				server.Write(append([]byte{tlsRecordApplicationData, 3, 3, 0, byte(len(data))}, data...))
+				break
+			}
+		}
+		if !bytes.Equal(types, []byte{tlsRecordChangeCipherSpec, tlsRecordHandshake, tlsRecordApplicationData}) {
+			t.Errorf("server: unexpected records %v", types)
+		}
+	}()
+
+	c := (&obfsConfig{mode: "tls", host: "www.bing.com"}).wrap(client, "1.2.3.4:443")
+	c.Write([]byte("hello"))
+	got := make([]byte, 2)
+	if _, err := io.ReadFull(c, got); err != nil || string(got) != "hi" {
+		t.Errorf("unexpected response %q: %v", got, err)
+	}
+	go c.Write([]byte("world"))
+	got = make([]byte, 5)
+	if _, err := io.ReadFull(c, got); err != nil || string(got) != "world" {
+		t.Errorf("unexpected response %q: %v", got, err)
+	}
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SIP003 plugins run as subprocesses listening on a local port, they carry
+// shadowsocks traffic to the server their own way. Plugins are looked up in
+// the storage dir first, then in PATH.
+
+const pluginRestartDelay = 3 * time.Second
+
+var errPluginNotFound = errors.New("找不到插件")
+
+// knownPlugins are the SIP003 plugins tunnels may ask for. Plugin names come
+// from links, subscriptions and imported files, anything else could run any
+// program on disk.
+var knownPlugins = map[string]bool{
+	"obfs-local":   true,
+	"simple-obfs":  true,
+	"v2ray-plugin": true,
+	"xray-plugin":  true,
+	"kcptun":       true,
+	"gost-plugin":  true,
+	"ck-client":    true,
+	"gq-client":    true,
+	"simple-tls":   true,
+}
+
+// checkPluginName refuses plugins that are not known, or given as a path.
+func checkPluginName(name string) error {
+	if strings.ContainsAny(name, `/\`) || strings.Contains(name, "..") || filepath.IsAbs(name) {
+		return fmt.Errorf("无效的插件名: %s", name)
+	}
+	if !knownPlugins[strings.TrimSuffix(name, ".exe")] {
+		return fmt.Errorf("不支持的插件: %s", name)
+	}
+	return nil
+}
+
+type pluginProcess struct {
+	name   string
+	local  string // address the plugin listens on
+	remote string
+
+	mu      sync.Mutex
+	cmd     *exec.Cmd
+	stopped bool
+}
+
+func findPlugin(name string) (string, error) {
+	if err := checkPluginName(name); err != nil {
+		return "", err
+	}
+	for _, path := range []string{GetStorageFile(name), GetStorageFile(name + ".exe")} {
+		if isPathExist(path) {
+			return path, nil
+		}
+	}
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return "", errPluginNotFound
+	}
+	return path, nil
+}
+
+// freeLocalPort finds a port nobody listens on, for the plugin to take.
+func freeLocalPort() (int, error) {
+	ln, err := net.Listen("tcp", proxyHost+":0")
+	if err != nil {
+		return 0, err
+	}
+	defer ln.Close()
+	return ln.Addr().(*net.TCPAddr).Port, nil
+}
+
+// startPlugin launches the plugin of tunnel and keeps it running, restarting
+// it when it exits, until stop is called.
+func startPlugin(tunnel *SSTunnel) (*pluginProcess, error) {
+	path, err := findPlugin(tunnel.Plugin)
+	if err != nil {
+		return nil, err
+	}
+	port, err := freeLocalPort()
+	if err != nil {
+		return nil, err
+	}
+	p := &pluginProcess{
+		name:   tunnel.Plugin,
+		local:  net.JoinHostPort(proxyHost, strconv.Itoa(port)),
+		remote: net.JoinHostPort(tunnel.Ip, tunnel.Port),
+	}
+	env := append(os.Environ(),
+		"SS_REMOTE_HOST="+tunnel.Ip,
+		"SS_REMOTE_PORT="+tunnel.Port,
+		"SS_LOCAL_HOST="+proxyHost,
+		"SS_LOCAL_PORT="+strconv.Itoa(port),
+		"SS_PLUGIN_OPTIONS="+tunnel.PluginOpts,
+	)
+	newCmd := func() *exec.Cmd {
+		cmd := exec.Command(path)
+		cmd.Env = env
+		cmd.Dir = filepath.Dir(path)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		return cmd
+	}
+	cmd := newCmd()
+	if err = cmd.Start(); err != nil {
+		return nil, err
+	}
+	p.cmd = cmd
+	log.Printf("plugin %s for %s started at %s", p.name, p.remote, p.local)
+	go p.supervise(newCmd)
+	return p, nil
+}
+
+func (p *pluginProcess) supervise(newCmd func() *exec.Cmd) {
+	for {
+		p.mu.Lock()
+		cmd := p.cmd
+		p.mu.Unlock()
+		err := cmd.Wait()
+		if p.isStopped() {
+			return
+		}
+		log.Printf("plugin %s for %s exited: %v, restarting", p.name, p.remote, err)
+
+		time.Sleep(pluginRestartDelay)
+		p.mu.Lock()
+		if p.stopped {
+			p.mu.Unlock()
+			return
+		}
+		cmd = newCmd()
+		if err = cmd.Start(); err != nil {
+			p.stopped = true
+			p.mu.Unlock()
+			log.Printf("plugin %s for %s failed to restart: %v", p.name, p.remote, err)
+			return
+		}
+		p.cmd = cmd
+		p.mu.Unlock()
+	}
+}
+
+func (p *pluginProcess) isStopped() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stopped
+}
+
+func (p *pluginProcess) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped {
+		return
+	}
+	p.stopped = true
+	if p.cmd.Process != nil {
+		p.cmd.Process.Kill()
+	}
+	log.Printf("plugin %s for %s stopped", p.name, p.remote)
+}
+
+// StopPlugins stops the plugins of all servers, on exit.
+func StopPlugins() {
+	servers.RLock()
+	defer servers.RUnlock()
+	for _, se := range servers.srvCipher {
+		if se.plugin != nil {
+			se.plugin.stop()
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestStartPluginEnv(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin stand-in is a shell script")
+	}
+	dir, err := ioutil.TempDir("", "plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	out := filepath.Join(dir, "env")
+	script := "#!/bin/sh\nenv > " + out + ".tmp && mv " + out + ".tmp " + out + "\nexec sleep 60\n"
+	if err = ioutil.WriteFile(filepath.Join(dir, "v2ray-plugin"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	tunnel := &SSTunnel{Ip: "1.2.3.4", Port: "8388", Plugin: "v2ray-plugin", PluginOpts: "obfs=http"}
+	p, err := startPlugin(tunnel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.stop()
+
+	var env []byte
+	for i := 0; i < 50 && env == nil; i++ {
+		time.Sleep(20 * time.Millisecond)
+		env, _ = ioutil.ReadFile(out)
+	}
+	_, port, _ := net.SplitHostPort(p.local)
+	for _, want := range []string{"SS_REMOTE_HOST=1.2.3.4", "SS_REMOTE_PORT=8388", "SS_LOCAL_HOST=127.0.0.1", "SS_LOCAL_PORT=" + port, "SS_PLUGIN_OPTIONS=obfs=http"} {
+		if !strings.Contains(string(env), want+"\n") {
+			t.Errorf("plugin environment lacks %s", want)
+		}
+	}
+}
+
+func TestSetTunnelsConcurrentReloadsStopPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin stand-in is a shell script")
+	}
+	dir, err := ioutil.TempDir("", "plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	script := "#!/bin/sh\necho $$ > " + dir + "/pid.$$\nexec sleep 60\n"
+	if err = ioutil.WriteFile(filepath.Join(dir, "v2ray-plugin"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	sets := [][]*SSTunnel{
+		{{Ip: "1.1.1.1", Port: "8388", Password: "pass", Method: "aes-256-gcm", Plugin: "v2ray-plugin"}},
+		{{Ip: "2.2.2.2", Port: "8388", Password: "pass", Method: "aes-256-gcm", Plugin: "v2ray-plugin"}},
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(tunnels []*SSTunnel) {
+			defer wg.Done()
+			SetTunnels(tunnels)
+		}(sets[i%2])
+	}
+	wg.Wait()
+	if err = SetTunnels(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// every plugin started went away with the servers
+	var alive []string
+	for i := 0; i < 50; i++ {
+		alive = nil
+		files, _ := filepath.Glob(filepath.Join(dir, "pid.*"))
+		for _, f := range files {
+			pid, _ := strconv.Atoi(strings.TrimPrefix(filepath.Ext(f), "."))
+			if syscall.Kill(pid, 0) == nil {
+				alive = append(alive, f)
+			}
+		}
+		if len(alive) == 0 {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Errorf("%d plugins left running: %v", len(alive), alive)
+}
+
+func TestNewSSTunnelRefusesUnknownPlugins(t *testing.T) {
+	for _, name := range []string{"../../../../usr/bin/x", "/tmp/payload", `..\\payload.exe`, "C:\\payload.exe", "sh", "obfs-local/../sh"} {
+		uri := "ss://aes-256-gcm:pass@1.2.3.4:8388/?plugin=" + url.QueryEscape(name+";opt=1")
+		if _, err := NewSSTunnel(uri); err == nil {
+			t.Errorf("plugin %q accepted", name)
+		}
+		if _, err := findPlugin(name); err == nil {
+			t.Errorf("plugin %q looked up", name)
+		}
+	}
+	for _, name := range []string{"obfs-local;obfs=http", "v2ray-plugin", "v2ray-plugin.exe"} {
+		uri := "ss://aes-256-gcm:pass@1.2.3.4:8388/?plugin=" + url.QueryEscape(name)
+		if _, err := NewSSTunnel(uri); err != nil {
+			t.Errorf("plugin %q refused: %v", name, err)
+		}
+	}
+}
+
+func TestSetTunnelsLeavesOutMissingPlugins(t *testing.T) {
+	missing := &SSTunnel{Ip: "1.1.1.1", Port: "8388", Password: "pass", Method: "aes-256-gcm", Plugin: "gost-plugin"}
+	if _, err := findPlugin(missing.Plugin); err != errPluginNotFound {
+		t.Skip("gost-plugin is installed")
+	}
+	if _, err := NewTunnelRecord(missing.ToString()); err == nil {
+		t.Error("tunnel with a missing plugin accepted")
+	}
+	good := &SSTunnel{Ip: "2.2.2.2", Port: "8388", Password: "pass", Method: "aes-256-gcm"}
+	err := SetTunnels([]*SSTunnel{missing, good})
+	defer SetTunnels(nil)
+	if err == nil || !strings.Contains(err.Error(), "gost-plugin") {
+		t.Errorf("missing plugin not reported: %v", err)
+	}
+	servers.RLock()
+	defer servers.RUnlock()
+	if len(servers.srvCipher) != 1 || servers.srvCipher[0].server != "2.2.2.2:8388" {
+		t.Errorf("working tunnel not kept: %v", servers.srvCipher)
+	}
+}
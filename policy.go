@@ -0,0 +1,224 @@
+package main
+
+import (
+	"errors"
+	"hash/fnv"
+	"log"
+	"math/rand"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// A selectionPolicy decides the order servers are tried in for a connection
+// to host. Implementations are called with servers.RLock() held.
+type selectionPolicy interface {
+	Order(host string) []int
+}
+
+const defaultPolicy = "failover"
+
+var policies = map[string]selectionPolicy{
+	"failover":    failoverPolicy{},
+	"fastest":     fastestPolicy{},
+	"round_robin": &roundRobinPolicy{},
+	"random":      randomPolicy{},
+	"least_conn":  leastConnPolicy{},
+	"hash":        &hashPolicy{},
+}
+
+var errPolicy = errors.New("unknown server selection policy")
+
+var currentPolicy atomic.Value
+
+func init() {
+	currentPolicy.Store(policies[defaultPolicy])
+}
+
+// checkPolicy tells whether name is a policy SetPolicy accepts.
+func checkPolicy(name string) error {
+	if _, ok := policies[name]; !ok && name != "" {
+		return errPolicy
+	}
+	return nil
+}
+
+// SetPolicy selects the server selection policy by name, an empty name
+// selects the default one.
+func SetPolicy(name string) error {
+	if err := checkPolicy(name); err != nil {
+		return err
+	}
+	if name == "" {
+		name = defaultPolicy
+	}
+	policy := policies[name]
+	currentPolicy.Store(policy)
+	log.Printf("Server selection policy is %s", name)
+	return nil
+}
+
+// serverOrder returns the order servers are tried in for a connection to
+// rawaddr. Callers hold servers.RLock().
+func serverOrder(rawaddr []byte) []int {
+	return currentPolicy.Load().(selectionPolicy).Order(rawAddrHost(rawaddr))
+}
+
+// rawAddrHost returns the host part of a socks style raw address.
+func rawAddrHost(rawaddr []byte) string {
+	if len(rawaddr) < 2 {
+		return ""
+	}
+	switch rawaddr[0] {
+	case socksAddrIPv4:
+		if len(rawaddr) >= 1+net.IPv4len {
+			return net.IP(rawaddr[1 : 1+net.IPv4len]).String()
+		}
+	case socksAddrIPv6:
+		if len(rawaddr) >= 1+net.IPv6len {
+			return net.IP(rawaddr[1 : 1+net.IPv6len]).String()
+		}
+	case socksAddrDomain:
+		if len(rawaddr) >= 2+int(rawaddr[1]) {
+			return string(rawaddr[2 : 2+int(rawaddr[1])])
+		}
+	}
+	return ""
+}
+
+func configOrder() []int {
+	order := make([]int, len(servers.srvCipher))
+	for i := range order {
+		order[i] = i
+	}
+	return order
+}
+
+// failoverPolicy tries servers in config order.
+type failoverPolicy struct{}
+
+func (failoverPolicy) Order(host string) []int {
+	return configOrder()
+}
+
+// fastestPolicy tries healthy servers first, fastest first, as measured by
+// the prober.
+type fastestPolicy struct{}
+
+func (fastestPolicy) Order(host string) []int {
+	return fastestOrder(configOrder())
+}
+
+// roundRobinPolicy starts each connection at the server after the one the
+// previous connection started at.
+type roundRobinPolicy struct {
+	next uint32
+}
+
+func (p *roundRobinPolicy) Order(host string) []int {
+	n := len(servers.srvCipher)
+	if n == 0 {
+		return nil
+	}
+	start := int(atomic.AddUint32(&p.next, 1) % uint32(n))
+	order := make([]int, n)
+	for i := range order {
+		order[i] = (start + i) % n
+	}
+	return order
+}
+
+type randomPolicy struct{}
+
+func (randomPolicy) Order(host string) []int {
+	return rand.Perm(len(servers.srvCipher))
+}
+
+// leastConnPolicy tries the servers with the fewest open connections first.
+type leastConnPolicy struct{}
+
+func (leastConnPolicy) Order(host string) []int {
+	order := configOrder()
+	active := make([]int64, len(order))
+	for i, se := range servers.srvCipher {
+		active[i] = atomic.LoadInt64(&se.active)
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return active[order[a]] < active[order[b]]
+	})
+	return order
+}
+
+// hashPolicy places servers on a consistent hash ring, so connections to a
+// host keep going out through the same server, and adding or removing a
+// server only moves the hosts next to it.
+type hashPolicy struct {
+	mu      sync.Mutex
+	servers []*ServerCipher // servers the ring was built for
+	ring    []hashNode
+}
+
+type hashNode struct {
+	hash   uint32
+	server int
+}
+
+const hashReplicas = 100 // virtual nodes per server
+
+func hashKey(key string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return h.Sum32()
+}
+
+func (p *hashPolicy) getRing() []hashNode {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if sameServers(p.servers, servers.srvCipher) {
+		return p.ring
+	}
+	ring := make([]hashNode, 0, len(servers.srvCipher)*hashReplicas)
+	for i, se := range servers.srvCipher {
+		for r := 0; r < hashReplicas; r++ {
+			ring = append(ring, hashNode{hashKey(se.server + "#" + strconv.Itoa(r)), i})
+		}
+	}
+	sort.Slice(ring, func(a, b int) bool { return ring[a].hash < ring[b].hash })
+	p.servers, p.ring = servers.srvCipher, ring
+	return ring
+}
+
+func (p *hashPolicy) Order(host string) []int {
+	ring := p.getRing()
+	if len(ring) == 0 {
+		return nil
+	}
+	h := hashKey(host)
+	start := sort.Search(len(ring), func(i int) bool { return ring[i].hash >= h })
+	// walk the ring from the host on, every server once
+	n := len(servers.srvCipher)
+	order := make([]int, 0, n)
+	seen := make([]bool, n)
+	for i := 0; i < len(ring) && len(order) < n; i++ {
+		node := ring[(start+i)%len(ring)]
+		if !seen[node.server] {
+			seen[node.server] = true
+			order = append(order, node.server)
+		}
+	}
+	return order
+}
+
+func sameServers(a, b []*ServerCipher) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func withServers(t *testing.T, addrs ...string) {
+	srvCipher := make([]*ServerCipher, len(addrs))
+	for i, addr := range addrs {
+		srvCipher[i] = &ServerCipher{key: addr, server: addr, breaker: &circuitBreaker{server: addr}}
+	}
+	servers.Lock()
+	servers.srvCipher = srvCipher
+	servers.Unlock()
+}
+
+func TestRoundRobinPolicy(t *testing.T) {
+	withServers(t, "a:1", "b:1", "c:1")
+	p := &roundRobinPolicy{}
+	first, second := p.Order(""), p.Order("")
+	if first[0] == second[0] || len(first) != 3 {
+		t.Errorf("round robin did not rotate: %v %v", first, second)
+	}
+}
+
+func TestHashPolicy(t *testing.T) {
+	withServers(t, "a:1", "b:1", "c:1")
+	p := &hashPolicy{}
+	order := p.Order("www.example.com")
+	if len(order) != 3 {
+		t.Fatalf("every server should be in the order: %v", order)
+	}
+	if again := p.Order("www.example.com"); !reflect.DeepEqual(order, again) {
+		t.Errorf("same host got different orders: %v %v", order, again)
+	}
+	// dropping a server that is not the first choice keeps the host in place
+	first := servers.srvCipher[order[0]].server
+	withServers(t, first, servers.srvCipher[order[2]].server)
+	if got := servers.srvCipher[p.Order("www.example.com")[0]].server; got != first {
+		t.Errorf("host moved from %s to %s", first, got)
+	}
+}
+
+func TestRawAddrHost(t *testing.T) {
+	if h := rawAddrHost([]byte{socksAddrDomain, 4, 'a', '.', 'c', 'n', 0, 80}); h != "a.cn" {
+		t.Errorf("unexpected host %q", h)
+	}
+	if h := rawAddrHost([]byte{socksAddrIPv4, 10, 0, 0, 1, 0, 80}); h != "10.0.0.1" {
+		t.Errorf("unexpected host %q", h)
+	}
+}
+
+// callSet posts a setting to the set handler and tells whether it succeeded.
+func callSet(name, value string) bool {
+	form := url.Values{"name": {name}, "value": {value}}
+	r := httptest.NewRequest("POST", "/set", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	set(w, r)
+	var res JsonResponse
+	return json.Unmarshal(w.Body.Bytes(), &res) == nil && res.Succeed
+}
+
+func TestSetRefusesUnknownPolicy(t *testing.T) {
+	defer tempStorage(t)()
+	if callSet("lb_policy", "fastets") {
+		t.Error("unknown policy set")
+	}
+	if config, _ := LoadConfig(); config.Get("lb_policy") != "" {
+		t.Errorf("unknown policy saved: %s", config.Get("lb_policy"))
+	}
+}
+
+func TestMigratePolicy(t *testing.T) {
+	c := &Config{Config: map[string]string{"prefer_fastest": "on"}}
+	if !c.migratePolicy() || c.Get("lb_policy") != "fastest" || c.migratePolicy() {
+		t.Errorf("prefer_fastest not migrated: %v", c.Config)
+	}
+	c = &Config{Config: map[string]string{"prefer_fastest": "off", "lb_policy": "random"}}
+	if !c.migratePolicy() || c.Get("lb_policy") != "random" {
+		t.Errorf("policy changed: %v", c.Config)
+	}
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"io"
+	"log"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ss "github.com/dawei101/shadowsocks-go/shadowsocks"
+)
+
+const (
+	probeTimeout         = 5 * time.Second
+	probeSamples         = 10 // samples kept per server for the rolling stats
+	defaultProbeInterval = 60 // seconds
+	probeRequest         = "HEAD /generate_204 HTTP/1.1\r\nHost: www.gstatic.com\r\nConnection: close\r\n\r\n"
+)
+
+// probeTarget is where probes connect to through the servers.
+var probeTarget = "www.gstatic.com:80"
+
+type probeSample struct {
+	ok  bool
+	tcp time.Duration // tcp connect to the server
+	ss  time.Duration // connect through the server up to the first response byte
+	at  time.Time
+}
+
+// probes holds the latest samples of every server, keyed by server address
+// so they survive tunnel reloads.
+var probes = struct {
+	sync.RWMutex
+	samples map[string][]probeSample
+}{samples: map[string][]probeSample{}}
+
+type ProbeStat struct {
+	Server     string     `json:"server"`
+	Healthy    bool       `json:"healthy"`
+	TcpLatency int64      `json:"tcp_latency"` // milliseconds
+	SsLatency  int64      `json:"ss_latency"`  // milliseconds
+	Loss       float64    `json:"loss"`
+	Samples    int        `json:"samples"`
+	LastProbe  *Timestamp `json:"last_probe"`
+}
+
+func newProbeStat(server string, samples []probeSample) *ProbeStat {
+	stat := &ProbeStat{Server: server, Samples: len(samples)}
+	if len(samples) == 0 {
+		return stat
+	}
+	var tcp, ssLat time.Duration
+	okCnt := 0
+	for _, s := range samples {
+		if s.ok {
+			tcp += s.tcp
+			ssLat += s.ss
+			okCnt++
+		}
+	}
+	last := samples[len(samples)-1]
+	at := Timestamp(last.at)
+	stat.LastProbe = &at
+	stat.Healthy = last.ok
+	stat.Loss = float64(len(samples)-okCnt) / float64(len(samples))
+	if okCnt > 0 {
+		stat.TcpLatency = int64(tcp / time.Duration(okCnt) / time.Millisecond)
+		stat.SsLatency = int64(ssLat / time.Duration(okCnt) / time.Millisecond)
+	}
+	return stat
+}
+
+// GetProbeStats returns the probe stats of the configured servers, in config
+// order.
+func GetProbeStats() []*ProbeStat {
+	servers.RLock()
+	srvCipher := servers.srvCipher
+	servers.RUnlock()
+	probes.RLock()
+	defer probes.RUnlock()
+	stats := []*ProbeStat{}
+	for _, se := range srvCipher {
+		stats = append(stats, newProbeStat(se.server, probes.samples[se.server]))
+	}
+	return stats
+}
+
+func probeServer(se *ServerCipher) probeSample {
+	s := probeSample{at: time.Now()}
+	start := time.Now()
+	conn, err := dialServerTCP(se.server)
+	if err != nil {
+		log.Printf("probe %s failed: %v", se.server, err)
+		return s
+	}
+	s.tcp = time.Since(start)
+	conn.Close()
+
+	rawaddr, err := ss.RawAddr(probeTarget)
+	if err != nil {
+		return s
+	}
+	start = time.Now()
+	remote, err := dialServer(se, rawaddr)
+	if err != nil {
+		log.Printf("probe %s failed: %v", se.server, err)
+		return s
+	}
+	defer remote.Close()
+	remote.SetDeadline(time.Now().Add(probeTimeout))
+	if _, err = remote.Write([]byte(probeRequest)); err != nil {
+		log.Printf("probe %s failed: %v", se.server, err)
+		return s
+	}
+	buf := make([]byte, 1)
+	if _, err = io.ReadFull(remote, buf); err != nil {
+		log.Printf("probe %s failed: %v", se.server, err)
+		return s
+	}
+	s.ss = time.Since(start)
+	s.ok = true
+	return s
+}
+
+func probeAll() {
+	servers.RLock()
+	srvCipher := servers.srvCipher
+	servers.RUnlock()
+
+	results := make([]probeSample, len(srvCipher))
+	var wg sync.WaitGroup
+	for i, se := range srvCipher {
+		wg.Add(1)
+		go func(i int, se *ServerCipher) {
+			defer wg.Done()
+			results[i] = probeServer(se)
+		}(i, se)
+	}
+	wg.Wait()
+
+	probes.Lock()
+	defer probes.Unlock()
+	samples := make(map[string][]probeSample, len(srvCipher))
+	for i, se := range srvCipher {
+		kept := append(probes.samples[se.server], results[i])
+		if len(kept) > probeSamples {
+			kept = kept[len(kept)-probeSamples:]
+		}
+		samples[se.server] = kept
+	}
+	probes.samples = samples
+}
+
+var probeInterval int64 = defaultProbeInterval
+var probeWake = make(chan struct{}, 1)
+
+// SetProbeInterval sets the seconds between two probe rounds, 0 stops
+// probing.
+func SetProbeInterval(seconds int) {
+	atomic.StoreInt64(&probeInterval, int64(seconds))
+	WakeProber()
+}
+
+// WakeProber starts a probe round right away, e.g. when the servers changed.
+func WakeProber() {
+	select {
+	case probeWake <- struct{}{}:
+	default:
+	}
+}
+
+func StartProber() {
+	for {
+		interval := time.Duration(atomic.LoadInt64(&probeInterval)) * time.Second
+		if interval > 0 {
+			probeAll()
+		} else {
+			interval = defaultProbeInterval * time.Second
+		}
+		select {
+		case <-time.After(interval):
+		case <-probeWake:
+		}
+	}
+}
+
+// fastestOrder sorts server indexes so that healthy servers come first,
+// fastest first, followed by the others in their original order. Callers
+// hold servers.RLock().
+func fastestOrder(order []int) []int {
+	type score struct {
+		healthy bool
+		latency time.Duration
+	}
+	scores := make(map[int]score, len(order))
+	probes.RLock()
+	for _, i := range order {
+		stat := newProbeStat("", probes.samples[servers.srvCipher[i].server])
+		scores[i] = score{stat.Healthy, time.Duration(stat.SsLatency) * time.Millisecond}
+	}
+	probes.RUnlock()
+	sorted := append([]int{}, order...)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		sa, sb := scores[sorted[a]], scores[sorted[b]]
+		if sa.healthy != sb.healthy {
+			return sa.healthy
+		}
+		return sa.healthy && sa.latency < sb.latency
+	})
+	return sorted
+}
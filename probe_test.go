@@ -0,0 +1,103 @@
+package main
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewProbeStat(t *testing.T) {
+	now := time.Now()
+	samples := []probeSample{
+		{ok: true, tcp: 10 * time.Millisecond, ss: 30 * time.Millisecond, at: now},
+		{ok: false, at: now},
+		{ok: true, tcp: 20 * time.Millisecond, ss: 50 * time.Millisecond, at: now},
+		{ok: true, tcp: 30 * time.Millisecond, ss: 70 * time.Millisecond, at: now},
+	}
+	stat := newProbeStat("a:1", samples)
+	if !stat.Healthy || stat.Samples != 4 || stat.Loss != 0.25 || stat.TcpLatency != 20 || stat.SsLatency != 50 {
+		t.Errorf("unexpected stat %+v", stat)
+	}
+	if stat = newProbeStat("a:1", samples[:2]); stat.Healthy || stat.SsLatency != 30 {
+		t.Errorf("last failed probe not unhealthy %+v", stat)
+	}
+}
+
+// probeHTTP is a target answering probes, the way generate_204 does.
+func probeHTTP(t *testing.T) net.Listener {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, len(probeRequest))
+				conn.Read(buf)
+				conn.Write([]byte("HTTP/1.1 204 No Content\r\n\r\n"))
+			}()
+		}
+	}()
+	return ln
+}
+
+func TestProbeAllOrdersFastestFirst(t *testing.T) {
+	candidates, stop := startRaceServers(t, 3)
+	defer stop()
+	defer SetTunnels(nil)
+	slow, dead := candidates[0], candidates[2]
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln.Close()
+	dead.server = ln.Addr().String()
+
+	upstreamLn, _ := slowUpstream(t, slow.server, 50*time.Millisecond)
+	defer upstreamLn.Close()
+	if err := SetUpstreamProxy("http://" + upstreamLn.Addr().String()); err != nil {
+		t.Fatal(err)
+	}
+	defer SetUpstreamProxy("")
+	target := probeHTTP(t)
+	defer target.Close()
+	defer func(addr string) { probeTarget = addr }(probeTarget)
+	probeTarget = target.Addr().String()
+	defer func() {
+		probes.Lock()
+		probes.samples = map[string][]probeSample{}
+		probes.Unlock()
+	}()
+
+	for i := 0; i < probeSamples+2; i++ {
+		probeAll()
+	}
+	stats := GetProbeStats()
+	for _, stat := range stats {
+		if stat.Samples != probeSamples {
+			t.Errorf("%s kept %d samples, want %d", stat.Server, stat.Samples, probeSamples)
+		}
+	}
+	if !stats[0].Healthy || stats[0].Loss != 0 || stats[0].SsLatency < 50 {
+		t.Errorf("unexpected stat of the slow server %+v", stats[0])
+	}
+	if !stats[1].Healthy || stats[1].SsLatency >= stats[0].SsLatency {
+		t.Errorf("unexpected stat of the fast server %+v", stats[1])
+	}
+	if stats[2].Healthy || stats[2].Loss != 1 {
+		t.Errorf("unexpected stat of the dead server %+v", stats[2])
+	}
+
+	servers.RLock()
+	order := fastestOrder([]int{2, 0, 1})
+	servers.RUnlock()
+	if !reflect.DeepEqual(order, []int{1, 0, 2}) {
+		t.Errorf("got order %v, want fast, slow, dead", order)
+	}
+}
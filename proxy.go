@@ -1,6 +1,9 @@
 package main
 
 import (
+	"bufio"
+	"crypto/subtle"
+	"encoding/base64"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -12,8 +15,10 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"syscall"
 	"time"
 
 	ss "github.com/dawei101/shadowsocks-go/shadowsocks"
@@ -28,6 +33,10 @@ var (
 	errAuthExtraData = errors.New("socks authentication get extra data")
 	errReqExtraData  = errors.New("socks request get extra data")
 	errCmd           = errors.New("socks command not supported")
+	errAuthMethod    = errors.New("socks no acceptable authentication method")
+	errAuthVer       = errors.New("socks authentication version not supported")
+	errAuthFailed    = errors.New("socks authentication failed")
+	errNoServer      = errors.New("no shadowsocks server available")
 )
 
 type TrafficListener struct {
@@ -55,6 +64,7 @@ func (t *TrafficListener) Sync() {
 		return
 	}
 	config.AddTraffic(in, out)
+	config.updateLastUsed()
 	err = SaveConfig(config)
 	if err != nil {
 		log.Printf("Save config failed, when sync traffic, error is: %v", err)
@@ -64,11 +74,62 @@ func (t *TrafficListener) Sync() {
 	atomic.StoreInt64(&t.out, 0)
 }
 
+// StartSync saves the traffic and when the tunnels were last used every
+// trafficSyncInterval, when there is something new. Light use never gets to
+// the amount of traffic that syncs on its own.
+func (t *TrafficListener) StartSync() {
+	synced := time.Now()
+	for range time.Tick(trafficSyncInterval) {
+		if t.pending(synced) {
+			synced = time.Now()
+			t.Sync()
+		}
+	}
+}
+
+// pending tells whether there is traffic or a server use since last.
+func (t *TrafficListener) pending(last time.Time) bool {
+	if atomic.LoadInt64(&t.in) > 0 || atomic.LoadInt64(&t.out) > 0 {
+		return true
+	}
+	for _, at := range serversLastUsed() {
+		if at.Unix() >= last.Unix() {
+			return true
+		}
+	}
+	return false
+}
+
 var TrafficCounter *TrafficListener
 
+const trafficSyncInterval = 5 * time.Minute
+
 const (
-	socksVer5       = 5
-	socksCmdConnect = 1
+	socksVer5            = 5
+	socksCmdConnect      = 1
+	socksCmdUDPAssociate = 3
+
+	socksAddrIPv4   = 1
+	socksAddrDomain = 3
+	socksAddrIPv6   = 4
+
+	socksMethodNoAuth       = 0
+	socksMethodUserPass     = 2
+	socksMethodNoAcceptable = 0xff
+
+	socksUserPassVer     = 1
+	socksUserPassSucceed = 0
+	socksUserPassFailure = 1
+
+	socksRepSucceeded        = 0
+	socksRepFailure          = 1
+	socksRepNotAllowed       = 2
+	socksRepNetUnreachable   = 3
+	socksRepHostUnreachable  = 4
+	socksRepConnRefused      = 5
+	socksRepTTLExpired       = 6
+	socksRepCmdNotSupported  = 7
+	socksRepAddrNotSupported = 8
 )
 
 func init() {
@@ -76,11 +137,6 @@ func init() {
 	rand.Seed(time.Now().Unix())
 }
 
-func fatalf(fmtStr string, args interface{}) {
-	fmt.Fprintf(os.Stderr, fmtStr, args)
-	os.Exit(-1)
-}
-
 func handShake(conn net.Conn) (err error) {
 	const (
 		idVer     = 0
@@ -113,12 +169,214 @@ func handShake(conn net.Conn) (err error) {
 	} else { // error, should not get extra data
 		return errAuthExtraData
 	}
-	// send confirmation: version 5, no authentication required
-	_, err = conn.Write([]byte{socksVer5, 0})
+	method := selectAuthMethod(buf[idNmethod+1:msgLen], socksAuthRequired(conn))
+	if _, err = conn.Write([]byte{socksVer5, method}); err != nil {
+		return
+	}
+	switch method {
+	case socksMethodNoAcceptable:
+		return errAuthMethod
+	case socksMethodUserPass:
+		return userPassAuth(conn)
+	}
+	return
+}
+
+var socksAuth struct {
+	sync.RWMutex
+	users map[string]string // username -> password
+}
+
+func SetSocksUsers(users map[string]string) {
+	copied := make(map[string]string, len(users))
+	for u, p := range users {
+		copied[u] = p
+	}
+	socksAuth.Lock()
+	socksAuth.users = copied
+	socksAuth.Unlock()
+	log.Printf("Reset %d socks users", len(copied))
+}
+
+func hasSocksUsers() bool {
+	socksAuth.RLock()
+	defer socksAuth.RUnlock()
+	return len(socksAuth.users) > 0
+}
+
+// Clients from other machines have to authenticate once any socks user is
+// configured, on the socks, http and mixed ports alike. Loopback clients keep
+// working without credentials.
+func authRequired(remote string) bool {
+	if !hasSocksUsers() {
+		return false
+	}
+	host, _, err := net.SplitHostPort(remote)
+	ip := net.ParseIP(host)
+	return err != nil || ip == nil || !ip.IsLoopback()
+}
+
+func socksAuthRequired(conn net.Conn) bool {
+	return authRequired(conn.RemoteAddr().String())
+}
+
+func checkSocksUser(user string, password []byte) bool {
+	socksAuth.RLock()
+	expected, ok := socksAuth.users[user]
+	socksAuth.RUnlock()
+	return ok && subtle.ConstantTimeCompare([]byte(expected), password) == 1
+}
+
+func selectAuthMethod(methods []byte, authRequired bool) byte {
+	offered := func(m byte) bool {
+		for _, method := range methods {
+			if method == m {
+				return true
+			}
+		}
+		return false
+	}
+	if authRequired {
+		if offered(socksMethodUserPass) {
+			return socksMethodUserPass
+		}
+		return socksMethodNoAcceptable
+	}
+	if !offered(socksMethodNoAuth) && offered(socksMethodUserPass) && hasSocksUsers() {
+		return socksMethodUserPass
+	}
+	// no authentication required, answered even to clients that did not
+	// offer it, as we always did
+	return socksMethodNoAuth
+}
+
+// userPassAuth runs the username/password sub-negotiation of RFC 1929.
+//
+//	+----+------+----------+------+----------+
+//	|VER | ULEN |  UNAME   | PLEN |  PASSWD  |
+//	+----+------+----------+------+----------+
+//	| 1  |  1   | 1 to 255 |  1   | 1 to 255 |
+//	+----+------+----------+------+----------+
+func userPassAuth(conn net.Conn) (err error) {
+	buf := make([]byte, 513)
+	if _, err = io.ReadFull(conn, buf[:2]); err != nil {
+		return
+	}
+	if buf[0] != socksUserPassVer {
+		return errAuthVer
+	}
+	ulen := int(buf[1])
+	if _, err = io.ReadFull(conn, buf[:ulen+1]); err != nil {
+		return
+	}
+	user := string(buf[:ulen])
+	plen := int(buf[ulen])
+	if _, err = io.ReadFull(conn, buf[:plen]); err != nil {
+		return
+	}
+	password := buf[:plen]
+
+	if !checkSocksUser(user, password) {
+		conn.Write([]byte{socksUserPassVer, socksUserPassFailure})
+		log.Printf("socks authentication failed for user %q from %s", user, conn.RemoteAddr())
+		return errAuthFailed
+	}
+	_, err = conn.Write([]byte{socksUserPassVer, socksUserPassSucceed})
 	return
 }
 
-func getRequest(conn net.Conn) (rawaddr []byte, host string, err error) {
+// socksReply builds a SOCKS5 reply with the given reply code and bound
+// address. A nil or unknown addr is reported as 0.0.0.0:0.
+func socksReply(rep byte, addr net.Addr) []byte {
+	ip, port := net.IPv4zero, 0
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		ip, port = a.IP, a.Port
+	case *net.UDPAddr:
+		ip, port = a.IP, a.Port
+	}
+	buf := []byte{socksVer5, rep, 0}
+	if ip4 := ip.To4(); ip4 != nil {
+		buf = append(buf, socksAddrIPv4)
+		buf = append(buf, ip4...)
+	} else {
+		buf = append(buf, socksAddrIPv6)
+		buf = append(buf, ip.To16()...)
+	}
+	return append(buf, byte(port>>8), byte(port))
+}
+
+// The bound address sent with an optimistic reply, before any server is
+// connected.
+var optimisticBound = &net.TCPAddr{IP: net.IPv4zero, Port: 0x0843}
+
+func socks5Reply(err error, bound net.Addr) []byte {
+	if err != nil {
+		return socksReply(socksReplyCode(err), nil)
+	}
+	return socksReply(socksRepSucceeded, bound)
+}
+
+// socksReplyCode maps an error met while serving a request to the RFC 1928
+// reply code reported to the client. The specific codes are only meant for
+// errors dialing the destination itself, directly or by the rules, as errors
+// dialing the shadowsocks servers say nothing about the destination.
+func socksReplyCode(err error) byte {
+	switch err {
+	case errCmd:
+		return socksRepCmdNotSupported
+	case errAddrType:
+		return socksRepAddrNotSupported
+	case errRuleReject:
+		return socksRepNotAllowed
+	}
+	if ne, ok := err.(net.Error); ok && ne.Timeout() {
+		return socksRepTTLExpired
+	}
+	if _, ok := err.(*net.DNSError); ok {
+		return socksRepHostUnreachable
+	}
+	if oe, ok := err.(*net.OpError); ok {
+		err = oe.Err
+		if _, ok := err.(*net.DNSError); ok {
+			return socksRepHostUnreachable
+		}
+	}
+	if se, ok := err.(*os.SyscallError); ok {
+		err = se.Err
+	}
+	switch err {
+	case syscall.ECONNREFUSED:
+		return socksRepConnRefused
+	case syscall.ENETUNREACH:
+		return socksRepNetUnreachable
+	case syscall.EHOSTUNREACH:
+		return socksRepHostUnreachable
+	}
+	return socksRepFailure
+}
+
+// In deferred reply mode the reply to a connect request is only sent once the
+// remote end is connected, at the cost of a round trip. Connections dialed
+// directly get the reply code of the dial and the bound address of the socket
+// to the destination. Through the tunnels a failure is a general one and the
+// bound address is the one of the socket to the shadowsocks server, the
+// destination is only reached by the server.
+var deferredReply int32
+
+func SetDeferredReply(on bool) {
+	var v int32
+	if on {
+		v = 1
+	}
+	atomic.StoreInt32(&deferredReply, v)
+}
+
+func isDeferredReply() bool {
+	return atomic.LoadInt32(&deferredReply) == 1
+}
+
+func getRequest(conn net.Conn) (cmd byte, rawaddr []byte, host string, err error) {
 	const (
 		idVer   = 0
 		idCmd   = 1
@@ -148,7 +406,8 @@ func getRequest(conn net.Conn) (rawaddr []byte, host string, err error) {
 		err = errVer
 		return
 	}
-	if buf[idCmd] != socksCmdConnect {
+	cmd = buf[idCmd]
+	if cmd != socksCmdConnect && cmd != socksCmdUDPAssociate {
 		err = errCmd
 		return
 	}
@@ -195,55 +454,152 @@ func getRequest(conn net.Conn) (rawaddr []byte, host string, err error) {
 	return
 }
 
+// bufferedConn lets the first bytes of a connection be peeked at without
+// losing them for whoever reads the connection afterwards.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func newBufferedConn(c net.Conn) *bufferedConn {
+	return &bufferedConn{c, bufio.NewReader(c)}
+}
+
+func (c *bufferedConn) Peek(n int) ([]byte, error) {
+	return c.r.Peek(n)
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
 type ServerCipher struct {
-	server string
-	cipher *ss.Cipher
+	key      string
+	server   string
+	cipher   *ss.Cipher  // stream ciphers
+	aead     *aeadCipher // AEAD ciphers, cipher is nil then
+	ota      bool        // one time auth, "-auth" methods
+	plugin   *pluginProcess
+	obfs     *obfsConfig // built-in simple-obfs, instead of a plugin
+	breaker  *circuitBreaker
+	active   int64 // open connections, updated atomically
+	lastUsed int64 // unix time of the last connection, updated atomically
+}
+
+// dialAddr is where connections to the server go, the local port of its
+// plugin if it has one.
+func (se *ServerCipher) dialAddr() string {
+	if se.plugin != nil {
+		return se.plugin.local
+	}
+	return se.server
+}
+
+// trafficConn reports the traffic of a connection to its listener.
+type trafficConn struct {
+	net.Conn
+	TrafficListener *TrafficListener
+}
+
+func (c *trafficConn) Read(b []byte) (n int, err error) {
+	n, err = c.Conn.Read(b)
+	if n > 0 && c.TrafficListener != nil {
+		c.TrafficListener.WhenIn(n)
+	}
+	return
+}
+
+func (c *trafficConn) Write(b []byte) (n int, err error) {
+	n, err = c.Conn.Write(b)
+	if n > 0 && c.TrafficListener != nil {
+		c.TrafficListener.WhenOut(n)
+	}
+	return
+}
+
+// serverConn is a connection through a shadowsocks server, it keeps the
+// server's count of open connections up to date and reports traffic to its
+// listener.
+type serverConn struct {
+	trafficConn
+	se   *ServerCipher
+	once sync.Once
+}
+
+func newServerConn(c net.Conn, se *ServerCipher) *serverConn {
+	atomic.AddInt64(&se.active, 1)
+	return &serverConn{trafficConn: trafficConn{Conn: c}, se: se}
+}
+
+func (c *serverConn) Close() error {
+	c.once.Do(func() { atomic.AddInt64(&c.se.active, -1) })
+	return c.Conn.Close()
 }
 
 var servers struct {
 	sync.RWMutex
 	srvCipher []*ServerCipher
-	failCnt   []int // failed connection count
 }
 
-func connectToServer(serverId int, rawaddr []byte, addr string) (remote *ss.Conn, err error) {
-	se := servers.srvCipher[serverId]
-	remote, err = ss.DialWithRawAddr(rawaddr, se.server, se.cipher.Copy())
+// serversLastUsed returns when the servers were last connected to, by
+// tunnel key.
+func serversLastUsed() map[string]time.Time {
+	servers.RLock()
+	defer servers.RUnlock()
+	used := make(map[string]time.Time)
+	for _, se := range servers.srvCipher {
+		if at := atomic.LoadInt64(&se.lastUsed); at > 0 {
+			used[se.key] = time.Unix(at, 0)
+		}
+	}
+	return used
+}
+
+func connectToServer(se *ServerCipher, rawaddr []byte, addr string) (remote *serverConn, err error) {
+	c, err := dialServer(se, rawaddr)
 	if err != nil {
 		log.Println("error connecting to shadowsocks server:", err)
-		const maxFailCnt = 30
-		if servers.failCnt[serverId] < maxFailCnt {
-			servers.failCnt[serverId]++
-		}
+		se.breaker.Failure()
 		return nil, err
 	}
 	log.Printf("connected to %s via %s\n", addr, se.server)
-	servers.failCnt[serverId] = 0
-	return
+	se.breaker.Success()
+	atomic.StoreInt64(&se.lastUsed, time.Now().Unix())
+	return newServerConn(c, se), nil
 }
 
-// Connection to the server in the order specified in the config. On
-// connection failure, try the next server. A failed server will be tried with
-// some probability according to its fail count, so we can discover recovered
-// servers.
-func createServerConn(rawaddr []byte, addr string) (remote *ss.Conn, err error) {
-	const baseFailCnt = 20
-	n := len(servers.srvCipher)
-	skipped := make([]int, 0)
-	for i := 0; i < n; i++ {
-		// skip failed server, but try it with some probability
-		if servers.failCnt[i] > 0 && rand.Intn(servers.failCnt[i]+baseFailCnt) != 0 {
-			skipped = append(skipped, i)
-			continue
-		}
-		remote, err = connectToServer(i, rawaddr, addr)
+// Connection to the server in the order given by the selection policy, one
+// after another or racing a few of them. On connection failure, try the next
+// server. Servers whose circuit is open are skipped until their backoff ends,
+// so we can discover recovered servers without paying for dead ones on every
+// connection.
+func createServerConn(rawaddr []byte, addr string) (remote *serverConn, err error) {
+	order := serverOrder(rawaddr)
+	candidates := make([]*ServerCipher, len(order))
+	for i, id := range order {
+		candidates[i] = servers.srvCipher[id]
+	}
+	var skipped []*ServerCipher
+	if isRaceDial() {
+		remote, skipped, err = raceServerConn(candidates, rawaddr, addr)
 		if err == nil {
 			return
 		}
+	} else {
+		for _, se := range candidates {
+			if !se.breaker.Allow() {
+				skipped = append(skipped, se)
+				continue
+			}
+			remote, err = connectToServer(se, rawaddr, addr)
+			if err == nil {
+				return
+			}
+		}
 	}
 	// last resort, try skipped servers, not likely to succeed
-	for _, i := range skipped {
-		remote, err = connectToServer(i, rawaddr, addr)
+	for _, se := range skipped {
+		remote, err = connectToServer(se, rawaddr, addr)
 		if err == nil {
 			return
 		}
@@ -263,31 +619,93 @@ func handleConnection(conn net.Conn, tl *TrafficListener) {
 	}()
 
 	var err error = nil
-	if err = handShake(conn); err != nil {
-		log.Println("socks handshake:", err)
-		return
-	}
-	rawaddr, addr, err := getRequest(conn)
+	bc := newBufferedConn(conn)
+	conn = bc
+	ss.SetReadTimeout(conn)
+	ver, err := bc.Peek(1)
 	if err != nil {
-		log.Println("error getting request:", err)
+		log.Println("socks read version:", err)
 		return
 	}
-	// Sending connection established message immediately to client.
-	// This some round trip time for creating socks connection with the client.
-	// But if connection failed, the client will get connection reset error.
-	_, err = conn.Write([]byte{0x05, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x08, 0x43})
-	if err != nil {
-		log.Println("send connection confirmation:", err)
+	var (
+		rawaddr []byte
+		addr    string
+		reply   func(err error, bound net.Addr) []byte
+	)
+	if ver[0] == socksVer4 {
+		reply = socks4Reply
+		if socksAuthRequired(conn) {
+			log.Println("socks4 refused, authentication required for", conn.RemoteAddr())
+			conn.Write(reply(errAuthMethod, nil))
+			return
+		}
+		if rawaddr, addr, err = getRequest4(bc); err != nil {
+			log.Println("error getting socks4 request:", err)
+			conn.Write(reply(err, nil))
+			return
+		}
+	} else {
+		reply = socks5Reply
+		if err = handShake(conn); err != nil {
+			log.Println("socks handshake:", err)
+			return
+		}
+		var cmd byte
+		cmd, rawaddr, addr, err = getRequest(conn)
+		if err != nil {
+			log.Println("error getting request:", err)
+			if err == errCmd || err == errAddrType {
+				conn.Write(reply(err, nil))
+			}
+			return
+		}
+		if cmd == socksCmdUDPAssociate {
+			handleUDPAssociate(conn, tl)
+			return
+		}
+	}
+	action := routeAddr(rawAddrString(rawaddr))
+	if action == ruleReject {
+		log.Println("rejected connection to", addr)
+		conn.Write(reply(errRuleReject, nil))
 		return
 	}
+	deferred := isDeferredReply()
+	if !deferred {
+		// Sending connection established message immediately to client.
+		// This some round trip time for creating socks connection with the client.
+		// But if connection failed, the client will get connection reset error.
+		_, err = conn.Write(reply(nil, optimisticBound))
+		if err != nil {
+			log.Println("send connection confirmation:", err)
+			return
+		}
+	}
 
-	servers.RLock()
-	remote, err := createServerConn(rawaddr, addr)
-	servers.RUnlock()
-	if err != nil || remote == nil {
-		if len(servers.srvCipher) > 1 {
+	var remote net.Conn
+	if action == ruleDirect {
+		remote, err = net.DialTimeout("tcp", rawAddrString(rawaddr), directDialTimeout)
+	} else {
+		var sc *serverConn
+		servers.RLock()
+		sc, err = createServerConn(rawaddr, addr)
+		servers.RUnlock()
+		if err == nil && sc != nil {
+			// only traffic through the servers is counted
+			sc.TrafficListener = tl
+			remote = sc
+		} else if len(servers.srvCipher) > 1 {
 			log.Println("Failed connect to all avaiable shadowsocks server")
 		}
+	}
+	if err != nil || remote == nil {
+		if deferred {
+			if err == nil || action != ruleDirect {
+				// not a failure of the destination, see deferredReply
+				err = errNoServer
+			}
+			conn.Write(reply(err, nil))
+		}
 		return
 	}
 	defer func() {
@@ -295,7 +713,13 @@ func handleConnection(conn net.Conn, tl *TrafficListener) {
 			remote.Close()
 		}
 	}()
-	remote.TrafficListener = tl
+	if deferred {
+		_, err = conn.Write(reply(nil, remote.LocalAddr()))
+		if err != nil {
+			log.Println("send connection confirmation:", err)
+			return
+		}
+	}
 
 	go ss.PipeThenClose(conn, remote)
 	ss.PipeThenClose(remote, conn)
@@ -304,14 +728,20 @@ func handleConnection(conn net.Conn, tl *TrafficListener) {
 }
 
 func StartSS() {
-	ln, err := net.Listen("tcp", SocksProxy)
+	config, _ := LoadConfig()
+	addr := bindAddr(config, GetSocksAddr(config))
+	ln, err := listen("socks_port", addr)
 	if err != nil {
-		log.Fatal(err)
+		log.Printf("Failed to start socks server at %s: %v", addr, err)
+		return
 	}
-	log.Printf("starting local socks5 server at %v ...\n", SocksProxy)
+	log.Printf("starting local socks5 server at %v ...\n", addr)
 	for {
 		conn, err := ln.Accept()
 		if err != nil {
+			if !listenerActive("socks_port", ln) {
+				return
+			}
 			log.Println("accept:", err)
 			continue
 		}
@@ -319,56 +749,275 @@ func StartSS() {
 	}
 }
 
-func SetTunnels(tunnels []*SSTunnel) {
-	if len(tunnels) == 0 {
-		return
+// tunnelKey identifies a tunnel, servers are reused across reloads as long as
+// their key does not change. Remarks are left out, renaming keeps the server.
+func tunnelKey(tunnel *SSTunnel) string {
+	t := *tunnel
+	t.Remark = ""
+	return t.ToString()
+}
+
+// checkTunnelCipher tells whether a cipher can be made for the tunnel.
+func checkTunnelCipher(tunnel *SSTunnel) error {
+	if isAEADMethod(tunnel.Method) {
+		_, err := newAEADCipher(tunnel.Method, tunnel.Password)
+		return err
 	}
-	srvCipher := make([]*ServerCipher, len(tunnels))
+	_, err := ss.NewCipher(tunnel.Method, tunnel.Password)
+	return err
+}
 
+// checkTunnel tells whether SetTunnels can use the tunnel, checked before
+// a tunnel is saved: a cipher can be made for it and its plugin, unless
+// built in, is installed.
+func checkTunnel(tunnel *SSTunnel) error {
+	if err := checkTunnelCipher(tunnel); err != nil {
+		return err
+	}
+	if tunnel.Plugin == "" || isBuiltinObfs(tunnel) {
+		return nil
+	}
+	if _, err := findPlugin(tunnel.Plugin); err == errPluginNotFound {
+		return fmt.Errorf("%v: %s", err, tunnel.Plugin)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// reloadTunnels serializes SetTunnels, so a reload does not keep servers
+// whose plugins another one stopped, nor lose track of the plugins it started.
+var reloadTunnels sync.Mutex
+
+// SetTunnels replaces the servers new connections go through. Servers whose
+// tunnel did not change are kept with their health state and connection
+// count; connections already made keep running on whatever server they use.
+// Tunnels that cannot be used are left out, the error tells which.
+func SetTunnels(tunnels []*SSTunnel) error {
+	reloadTunnels.Lock()
+	defer reloadTunnels.Unlock()
+	servers.RLock()
+	current := make(map[string]*ServerCipher, len(servers.srvCipher))
+	for _, se := range servers.srvCipher {
+		current[se.key] = se
+	}
+	servers.RUnlock()
+
+	srvCipher := make([]*ServerCipher, len(tunnels))
 	cipherCache := make(map[string]*ss.Cipher)
+	kept := 0
+	var failed []string
 	for i, tunnel := range tunnels {
+		key := tunnelKey(tunnel)
+		if se, ok := current[key]; ok {
+			srvCipher[i] = se
+			kept++
+			continue
+		}
+		hostPort := net.JoinHostPort(tunnel.Ip, tunnel.Port)
+		if isAEADMethod(tunnel.Method) {
+			aead, err := newAEADCipher(tunnel.Method, tunnel.Password)
+			if err != nil {
+				log.Printf("Failed generating cipher for %s:%s: %v", tunnel.Ip, tunnel.Port, err)
+				failed = append(failed, fmt.Sprintf("无法为%s:%s生成加密: %v", tunnel.Ip, tunnel.Port, err))
+				continue
+			}
+			srvCipher[i] = &ServerCipher{
+				key:     key,
+				server:  hostPort,
+				aead:    aead,
+				breaker: &circuitBreaker{server: hostPort},
+			}
+			continue
+		}
 		cacheKey := tunnel.Method + "|" + tunnel.Password
 		cipher, ok := cipherCache[cacheKey]
 		if !ok {
 			var err error
 			cipher, err = ss.NewCipher(tunnel.Method, tunnel.Password)
 			if err != nil {
-				log.Fatal("Failed generating ciphers:", err)
+				log.Printf("Failed generating cipher for %s:%s: %v", tunnel.Ip, tunnel.Port, err)
+				failed = append(failed, fmt.Sprintf("无法为%s:%s生成加密: %v", tunnel.Ip, tunnel.Port, err))
+				continue
 			}
 			cipherCache[cacheKey] = cipher
 		}
-		hostPort := fmt.Sprintf("%s:%s", tunnel.Ip, tunnel.Port)
-		srvCipher[i] = &ServerCipher{hostPort, cipher}
+		srvCipher[i] = &ServerCipher{
+			key:     key,
+			server:  hostPort,
+			cipher:  cipher,
+			ota:     strings.HasSuffix(tunnel.Method, "-auth"),
+			breaker: &circuitBreaker{server: hostPort},
+		}
+	}
+	for i, tunnel := range tunnels {
+		se := srvCipher[i]
+		if se == nil || tunnel.Plugin == "" || se.plugin != nil || se.obfs != nil {
+			continue
+		}
+		if isBuiltinObfs(tunnel) {
+			obfs, err := parseObfsOpts(tunnel.PluginOpts)
+			if err != nil {
+				log.Printf("Failed parsing obfs options for %s: %v", se.server, err)
+				failed = append(failed, fmt.Sprintf("%s的obfs参数无效: %v", se.server, err))
+				srvCipher[i] = nil
+				continue
+			}
+			se.obfs = obfs
+			continue
+		}
+		plugin, err := startPlugin(tunnel)
+		if err != nil {
+			log.Printf("Failed starting plugin %s for %s: %v", tunnel.Plugin, se.server, err)
+			failed = append(failed, fmt.Sprintf("无法为%s启动插件%s: %v", se.server, tunnel.Plugin, err))
+			srvCipher[i] = nil
+			continue
+		}
+		se.plugin = plugin
 	}
-	log.Printf("Reset %d tunnels", len(tunnels))
+	usable := srvCipher[:0]
+	for _, se := range srvCipher {
+		if se != nil {
+			usable = append(usable, se)
+		}
+	}
+	srvCipher = usable
+	log.Printf("Reset %d tunnels, %d unchanged, %d left out", len(srvCipher), kept, len(failed))
 	servers.Lock()
 	servers.srvCipher = srvCipher
-	servers.failCnt = make([]int, len(servers.srvCipher))
 	servers.Unlock()
+	// plugins of removed servers go, connections through them break
+	for _, se := range srvCipher {
+		delete(current, se.key)
+	}
+	for _, se := range current {
+		if se.plugin != nil {
+			se.plugin.stop()
+		}
+	}
+	WakeProber()
+	if len(failed) > 0 {
+		return errors.New(strings.Join(failed, "; "))
+	}
+	return nil
 }
 
-func StartHttpProxy() {
-	parentProxy, err := url.Parse(fmt.Sprintf("socks5://%s", SocksProxy))
+// dialViaSocks dials addr through the local socks server, wherever it is
+// listening now.
+func dialViaSocks(network, addr string) (net.Conn, error) {
+	socksAddr := listenerAddr("socks_port")
+	if socksAddr == "" {
+		return nil, errNoSocksListener
+	}
+	dialer, err := proxy.SOCKS5("tcp", socksAddr, nil, proxy.Direct)
+	if err != nil {
+		return nil, err
+	}
+	return dialer.Dial(network, addr)
+}
 
+// dialShadowsocks dials addr through the shadowsocks servers in process,
+// sharing server selection and traffic accounting with the socks server.
+func dialShadowsocks(network, addr string) (net.Conn, error) {
+	rawaddr, err := ss.RawAddr(addr)
 	if err != nil {
-		fatalf("Failed to parse proxy URL: %v\n", err)
+		return nil, err
 	}
+	servers.RLock()
+	remote, err := createServerConn(rawaddr, addr)
+	servers.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if remote == nil {
+		return nil, errNoServer
+	}
+	remote.TrafficListener = TrafficCounter
+	return remote, nil
+}
 
-	tbDialer, err := proxy.FromURL(parentProxy, proxy.Direct)
+var errNoSocksListener = errors.New("socks proxy not listening")
+
+// dialForHttp dials for the http proxy following the routing rules. Proxied
+// connections go through the shadowsocks servers in process, the local socks
+// server, the way they used to, is only tried when that fails.
+func dialForHttp(network, addr string) (net.Conn, error) {
+	switch routeAddr(addr) {
+	case ruleReject:
+		return nil, errRuleReject
+	case ruleDirect:
+		return net.DialTimeout(network, addr, directDialTimeout)
+	}
+	conn, err := dialShadowsocks(network, addr)
+	if err == nil {
+		return conn, nil
+	}
+	log.Printf("http proxy dial to %s failed, trying the socks proxy: %v", addr, err)
+	if conn, serr := dialViaSocks(network, addr); serr == nil {
+		return conn, nil
+	}
+	return nil, err
+}
+
+// proxyAuth asks http proxy clients for the credentials of a socks user
+// with Proxy-Authorization, when they have to authenticate.
+func proxyAuth(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if authRequired(r.RemoteAddr) {
+			user, password, ok := proxyBasicAuth(r)
+			if !ok || !checkSocksUser(user, []byte(password)) {
+				log.Printf("http proxy authentication failed for user %q from %s", user, r.RemoteAddr)
+				w.Header().Set("Proxy-Authenticate", `Basic realm="tongshe"`)
+				w.WriteHeader(http.StatusProxyAuthRequired)
+				return
+			}
+		}
+		r.Header.Del("Proxy-Authorization")
+		h.ServeHTTP(w, r)
+	})
+}
+
+func proxyBasicAuth(r *http.Request) (user, password string, ok bool) {
+	auth := r.Header.Get("Proxy-Authorization")
+	const prefix = "Basic "
+	if len(auth) < len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return
+	}
+	decoded, err := base64.StdEncoding.DecodeString(auth[len(prefix):])
 	if err != nil {
-		fatalf("Failed to obtain proxy dialer: %v\n", err)
+		return
+	}
+	i := strings.IndexByte(string(decoded), ':')
+	if i < 0 {
+		return
 	}
+	return string(decoded[:i]), string(decoded[i+1:]), true
+}
+
+func newHttpProxy() http.Handler {
 	server := goproxy.NewProxyHttpServer()
-	server.Tr = &http.Transport{Dial: tbDialer.Dial}
-	log.Printf("start http proxy at: %s", HttpProxy)
-	err = http.ListenAndServe(HttpProxy, server)
+	server.Tr = &http.Transport{Dial: dialForHttp}
+	return server
+}
+
+func StartHttpProxy() {
+	config, _ := LoadConfig()
+	addr := bindAddr(config, GetHttpAddr(config))
+	ln, err := listen("http_port", addr)
 	if err != nil {
-		fatalf("Failed to start http proxy: %v\n", err)
+		log.Printf("Failed to start http proxy at %s: %v", addr, err)
+		return
+	}
+	log.Printf("start http proxy at: %s", addr)
+	err = http.Serve(ln, proxyAuth(newHttpProxy()))
+	if listenerActive("http_port", ln) {
+		log.Printf("http proxy stopped: %v", err)
 	}
 }
 
 func MakeProxyClient() *http.Client {
-	proxyUrl, _ := url.Parse(fmt.Sprintf("http://%s", HttpProxy))
+	config, _ := LoadConfig()
+	proxyUrl, _ := url.Parse(fmt.Sprintf("http://%s", GetHttpAddr(config)))
 	return &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyUrl)}, Timeout: 10 * time.Second}
 }
 
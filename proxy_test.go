@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHandShakeUserPass(t *testing.T) {
+	SetSocksUsers(map[string]string{"alice": "secret"})
+	defer SetSocksUsers(nil)
+
+	try := func(user, password string) ([]byte, error) {
+		client, server := net.Pipe()
+		defer client.Close()
+		errc := make(chan error, 1)
+		go func() {
+			errc <- handShake(server)
+			server.Close()
+		}()
+		client.Write([]byte{socksVer5, 2, socksMethodNoAuth, socksMethodUserPass})
+		reply := make([]byte, 4)
+		io.ReadFull(client, reply[:2])
+		req := []byte{socksUserPassVer, byte(len(user))}
+		req = append(req, user...)
+		req = append(req, byte(len(password)))
+		req = append(req, password...)
+		client.Write(req)
+		io.ReadFull(client, reply[2:])
+		return reply, <-errc
+	}
+
+	// net.Pipe has no loopback address, so credentials are required
+	reply, err := try("alice", "secret")
+	if err != nil || !bytes.Equal(reply, []byte{socksVer5, socksMethodUserPass, socksUserPassVer, socksUserPassSucceed}) {
+		t.Errorf("valid credentials rejected: %v %v", reply, err)
+	}
+	reply, err = try("alice", "wrong")
+	if err != errAuthFailed || reply[3] != socksUserPassFailure {
+		t.Errorf("invalid credentials accepted: %v %v", reply, err)
+	}
+}
+
+// otherMachineDialer dials from a non-loopback address of this machine, the
+// way a client on the local network would look to the proxies.
+func otherMachineDialer(t *testing.T) (net.IP, *net.Dialer) {
+	addrs, _ := net.InterfaceAddrs()
+	for _, a := range addrs {
+		if ipnet, ok := a.(*net.IPNet); ok && ipnet.IP.To4() != nil && !ipnet.IP.IsLoopback() {
+			return ipnet.IP, &net.Dialer{LocalAddr: &net.TCPAddr{IP: ipnet.IP}}
+		}
+	}
+	t.Skip("no non-loopback address")
+	return nil, nil
+}
+
+func TestProxyAuthFromOtherMachines(t *testing.T) {
+	ip, dialer := otherMachineDialer(t)
+	SetSocksUsers(map[string]string{"alice": "secret"})
+	defer SetSocksUsers(nil)
+
+	socksLn, err := net.Listen("tcp", net.JoinHostPort(ip.String(), "0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer socksLn.Close()
+	go func() {
+		for {
+			conn, err := socksLn.Accept()
+			if err != nil {
+				return
+			}
+			go handleConnection(conn, TrafficCounter)
+		}
+	}()
+	exchange := func(req []byte, n int) []byte {
+		conn, err := dialer.Dial("tcp", socksLn.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		conn.Write(req)
+		reply := make([]byte, n)
+		io.ReadFull(conn, reply)
+		return reply
+	}
+	if reply := exchange([]byte{socksVer5, 1, socksMethodNoAuth}, 2); reply[1] != socksMethodNoAcceptable {
+		t.Errorf("socks5 client without credentials accepted: %v", reply)
+	}
+	socks4 := []byte{socksVer4, 1, 0, 80, 1, 2, 3, 4, 0}
+	if reply := exchange(socks4, 8); reply[1] != socks4RepRejected {
+		t.Errorf("socks4 client accepted while authentication is required: %v", reply)
+	}
+
+	httpLn, err := net.Listen("tcp", net.JoinHostPort(ip.String(), "0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer httpLn.Close()
+	go http.Serve(httpLn, proxyAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Proxy-Authorization") != "" {
+			t.Error("credentials passed on to the proxied request")
+		}
+	})))
+	get := func(user *url.Userinfo) int {
+		proxyUrl := &url.URL{Scheme: "http", Host: httpLn.Addr().String(), User: user}
+		client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyUrl), Dial: dialer.Dial}}
+		resp, err := client.Get("http://example.com/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	if code := get(nil); code != http.StatusProxyAuthRequired {
+		t.Errorf("http client without credentials got %d", code)
+	}
+	if code := get(url.UserPassword("alice", "wrong")); code != http.StatusProxyAuthRequired {
+		t.Errorf("http client with a wrong password got %d", code)
+	}
+	if code := get(url.UserPassword("alice", "secret")); code != http.StatusOK {
+		t.Errorf("http client with credentials got %d", code)
+	}
+}
+
+func TestDeferredReplyCodes(t *testing.T) {
+	SetDeferredReply(true)
+	defer SetDeferredReply(false)
+	rules, _ := parseRules("IP-CIDR,127.0.0.0/8,direct\nMATCH,proxy")
+	currentRules.Store(rules)
+	defer currentRules.Store([]*Rule{})
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := ln.Addr().(*net.TCPAddr)
+	ln.Close()
+	servers.RLock()
+	saved := servers.srvCipher
+	servers.RUnlock()
+	defer func() {
+		servers.Lock()
+		servers.srvCipher = saved
+		servers.Unlock()
+	}()
+	// the server refuses, which says nothing about the destination
+	tunnel := &SSTunnel{Ip: "127.0.0.1", Port: strconv.Itoa(closed.Port), Password: "pass", Method: "chacha20-ietf-poly1305"}
+	if err := SetTunnels([]*SSTunnel{tunnel}); err != nil {
+		t.Fatal(err)
+	}
+
+	connect := func(ip net.IP, port int) byte {
+		client, server := net.Pipe()
+		defer client.Close()
+		go handleConnection(server, TrafficCounter)
+		client.Write([]byte{socksVer5, 1, socksMethodNoAuth})
+		reply := make([]byte, 10)
+		io.ReadFull(client, reply[:2])
+		req := append([]byte{socksVer5, socksCmdConnect, 0, socksAddrIPv4}, ip.To4()...)
+		client.Write(append(req, byte(port>>8), byte(port)))
+		io.ReadFull(client, reply)
+		return reply[1]
+	}
+	if code := connect(closed.IP, closed.Port); code != socksRepConnRefused {
+		t.Errorf("direct dial to a closed port replied %d", code)
+	}
+	if code := connect(net.IPv4(192, 0, 2, 1), 80); code != socksRepFailure {
+		t.Errorf("failed tunnel replied %d", code)
+	}
+}
+
+func TestSelectAuthMethod(t *testing.T) {
+	if m := selectAuthMethod([]byte{socksMethodNoAuth}, true); m != socksMethodNoAcceptable {
+		t.Errorf("client without credentials should be refused, got %d", m)
+	}
+	if m := selectAuthMethod([]byte{socksMethodNoAuth, socksMethodUserPass}, false); m != socksMethodNoAuth {
+		t.Errorf("no authentication should be preferred when not required, got %d", m)
+	}
+}
+
+func TestSetTunnelsKeepsUnchangedServers(t *testing.T) {
+	a := &SSTunnel{Ip: "1.1.1.1", Port: "8388", Password: "pass", Method: "aes-256-cfb"}
+	b := &SSTunnel{Ip: "2.2.2.2", Port: "8388", Password: "pass", Method: "aes-256-cfb"}
+	if err := SetTunnels([]*SSTunnel{a}); err != nil {
+		t.Fatal(err)
+	}
+	old := servers.srvCipher[0]
+	old.breaker.Failure()
+
+	if err := SetTunnels([]*SSTunnel{b, a}); err != nil {
+		t.Fatal(err)
+	}
+	if servers.srvCipher[1] != old || old.breaker.failures != 1 {
+		t.Error("unchanged tunnel lost its server state")
+	}
+	if servers.srvCipher[0] == old {
+		t.Error("new tunnel reused another server")
+	}
+}
+
+func TestHttpProxyDialsInProcess(t *testing.T) {
+	_, stop := startRaceServers(t, 1)
+	defer stop()
+	defer SetTunnels(nil)
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer echo.Close()
+	go func() {
+		for {
+			conn, err := echo.Accept()
+			if err != nil {
+				return
+			}
+			go io.Copy(conn, conn)
+		}
+	}()
+	// no socks server to loop through
+	closeListener("socks_port")
+	srv := httptest.NewServer(newHttpProxy())
+	defer srv.Close()
+
+	out := atomic.LoadInt64(&TrafficCounter.out)
+	conn, err := net.Dial("tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	target := echo.Addr().String()
+	conn.Write([]byte("CONNECT " + target + " HTTP/1.1\r\nHost: " + target + "\r\n\r\n"))
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, nil)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("CONNECT failed: %v %v", resp, err)
+	}
+	conn.Write([]byte("ping"))
+	buf := make([]byte, 4)
+	if _, err = io.ReadFull(br, buf); err != nil || string(buf) != "ping" {
+		t.Errorf("tunnel does not relay: %q %v", buf, err)
+	}
+	if atomic.LoadInt64(&TrafficCounter.out) <= out {
+		t.Error("traffic of the http proxy not counted")
+	}
+}
+
+func TestHttpProxyFallsBackToSocks(t *testing.T) {
+	SetTunnels(nil)
+	ln, err := listen("socks_port", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeListener("socks_port")
+	go dialForHttp("tcp", "1.2.3.4:80")
+	ln.(*net.TCPListener).SetDeadline(time.Now().Add(2 * time.Second))
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatalf("socks proxy not tried: %v", err)
+	}
+	defer conn.Close()
+	buf := make([]byte, 1)
+	if _, err = io.ReadFull(conn, buf); err != nil || buf[0] != socksVer5 {
+		t.Errorf("unexpected greeting %v %v", buf, err)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/makiuchi-d/gozxing"
+	zxqrcode "github.com/makiuchi-d/gozxing/qrcode"
+	"github.com/skip2/go-qrcode"
+)
+
+// QR codes of ss:// uris, the way mobile clients share tunnels.
+
+const (
+	qrCodeSize    = 256
+	qrCodeMaxSize = 1024
+
+	// limits of the images DecodeQRCode accepts, a small compressed file
+	// can still decode to gigabytes of pixels
+	qrImageMaxBytes  = 10 << 20
+	qrImageMaxPixels = 4096
+)
+
+var (
+	errQRCode      = errors.New("未能识别二维码")
+	errQRImageSize = errors.New("二维码图片过大")
+)
+
+// TunnelQRCode renders the uri of tunnel as a PNG QR code of size pixels.
+func TunnelQRCode(tunnel *SSTunnel, size int) ([]byte, error) {
+	if size <= 0 || size > qrCodeMaxSize {
+		size = qrCodeSize
+	}
+	return qrcode.Encode(tunnel.ToString(), qrcode.Medium, size)
+}
+
+// DecodeQRCode reads the tunnel out of a PNG or JPEG image of its QR code,
+// like a screenshot of a phone sharing it.
+func DecodeQRCode(r io.Reader) (*SSTunnel, error) {
+	bt, err := ioutil.ReadAll(io.LimitReader(r, qrImageMaxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(bt) > qrImageMaxBytes {
+		return nil, errQRImageSize
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(bt))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width > qrImageMaxPixels || cfg.Height > qrImageMaxPixels {
+		return nil, errQRImageSize
+	}
+	img, _, err := image.Decode(bytes.NewReader(bt))
+	if err != nil {
+		return nil, err
+	}
+	bmp, err := gozxing.NewBinaryBitmapFromImage(img)
+	if err != nil {
+		return nil, err
+	}
+	hints := map[gozxing.DecodeHintType]interface{}{gozxing.DecodeHintType_TRY_HARDER: true}
+	result, err := zxqrcode.NewQRCodeReader().Decode(bmp, hints)
+	if err != nil {
+		return nil, errQRCode
+	}
+	return NewSSTunnel(strings.TrimSpace(result.GetText()))
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+)
+
+func TestQRCode(t *testing.T) {
+	tunnel, _ := NewSSTunnel("ss://aes-128-gcm:pass@tokyo.example.com:443/?plugin=obfs-local%3Bobfs%3Dtls#tokyo")
+	png, err := TunnelQRCode(tunnel, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodeQRCode(bytes.NewReader(png))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.ToString() != tunnel.ToString() {
+		t.Errorf("got %s, want %s", decoded.ToString(), tunnel.ToString())
+	}
+	if _, err := DecodeQRCode(bytes.NewReader([]byte("not an image"))); err == nil {
+		t.Error("garbage decoded")
+	}
+}
+
+func TestDecodeQRCodeRefusesHugeImages(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, qrImageMaxPixels+1, 1))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecodeQRCode(&buf); err != errQRImageSize {
+		t.Errorf("too wide image: %v", err)
+	}
+	big := bytes.Repeat([]byte{0}, qrImageMaxBytes+1)
+	if _, err := DecodeQRCode(bytes.NewReader(big)); err != errQRImageSize {
+		t.Errorf("too large file: %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+const defaultRaceDelay = 300 // milliseconds
+
+var (
+	raceDial  int32
+	raceDelay int64 = int64(defaultRaceDelay * time.Millisecond)
+)
+
+func SetRaceDial(on bool) {
+	var v int32
+	if on {
+		v = 1
+	}
+	atomic.StoreInt32(&raceDial, v)
+}
+
+func isRaceDial() bool {
+	return atomic.LoadInt32(&raceDial) == 1
+}
+
+// SetRaceDelay sets how long a dial may take before the next server joins
+// the race.
+func SetRaceDelay(ms int) {
+	if ms <= 0 {
+		ms = defaultRaceDelay
+	}
+	atomic.StoreInt64(&raceDelay, int64(time.Duration(ms)*time.Millisecond))
+}
+
+// raceServerConn dials the candidates happy eyeballs style: the first one
+// right away, the next one whenever the dials in flight got slower than the
+// race delay or one of them failed. The first connection made wins, the
+// others are closed once they complete. Candidates whose circuit is open are
+// returned as skipped.
+func raceServerConn(candidates []*ServerCipher, rawaddr []byte, addr string) (remote *serverConn, skipped []*ServerCipher, err error) {
+	type result struct {
+		conn *serverConn
+		err  error
+	}
+	results := make(chan result, len(candidates))
+	next, pending := 0, 0
+	start := func() bool {
+		for next < len(candidates) {
+			se := candidates[next]
+			next++
+			if !se.breaker.Allow() {
+				skipped = append(skipped, se)
+				continue
+			}
+			pending++
+			go func() {
+				c, err := connectToServer(se, rawaddr, addr)
+				results <- result{c, err}
+			}()
+			return true
+		}
+		return false
+	}
+
+	if !start() {
+		return nil, skipped, errNoServer
+	}
+	delay := time.Duration(atomic.LoadInt64(&raceDelay))
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	err = errNoServer
+	for pending > 0 {
+		select {
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				go func(n int) {
+					for ; n > 0; n-- {
+						if r := <-results; r.err == nil {
+							r.conn.Close()
+						}
+					}
+				}(pending)
+				return r.conn, skipped, nil
+			}
+			err = r.err
+			start()
+		case <-timer.C:
+			if start() {
+				timer.Reset(delay)
+			}
+		}
+	}
+	return nil, skipped, err
+}
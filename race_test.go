@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// startRaceServers starts in process servers and makes them the tunnels,
+// returning their ServerCiphers in order and a func stopping the servers.
+func startRaceServers(t *testing.T, n int) ([]*ServerCipher, func()) {
+	var (
+		tunnels []*SSTunnel
+		started []*Server
+	)
+	stop := func() {
+		for _, s := range started {
+			s.Close()
+		}
+	}
+	for i := 0; i < n; i++ {
+		s, err := NewServer(&serverConfig{Server: "127.0.0.1", Method: "aes-256-gcm", PortPassword: map[string]string{"0": "secret"}})
+		if err != nil {
+			stop()
+			t.Fatal(err)
+		}
+		started = append(started, s)
+		go s.Serve()
+		host, port, _ := net.SplitHostPort(s.Addrs()[0])
+		tunnels = append(tunnels, &SSTunnel{Ip: host, Port: port, Password: "secret", Method: "aes-256-gcm"})
+	}
+	if err := SetTunnels(tunnels); err != nil {
+		stop()
+		t.Fatal(err)
+	}
+	servers.RLock()
+	defer servers.RUnlock()
+	return append([]*ServerCipher(nil), servers.srvCipher...), stop
+}
+
+// slowUpstream is an http proxy that holds CONNECT requests to slow for a
+// while, and reports when a connection to slow is closed by the client.
+func slowUpstream(t *testing.T, slow string, delay time.Duration) (ln net.Listener, slowClosed chan struct{}) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	slowClosed = make(chan struct{}, 1)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				br := bufio.NewReader(conn)
+				req, err := http.ReadRequest(br)
+				if err != nil {
+					return
+				}
+				if req.Host == slow {
+					time.Sleep(delay)
+				}
+				remote, err := net.Dial("tcp", req.Host)
+				if err != nil {
+					conn.Write([]byte("HTTP/1.1 502 Bad Gateway\r\n\r\n"))
+					return
+				}
+				defer remote.Close()
+				conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+				go io.Copy(conn, remote)
+				io.Copy(remote, br)
+				if req.Host == slow {
+					slowClosed <- struct{}{}
+				}
+			}()
+		}
+	}()
+	return ln, slowClosed
+}
+
+func TestRaceServerConnFastestWins(t *testing.T) {
+	candidates, stop := startRaceServers(t, 2)
+	defer stop()
+	slow, fast := candidates[0], candidates[1]
+	upstreamLn, slowClosed := slowUpstream(t, slow.server, 300*time.Millisecond)
+	defer upstreamLn.Close()
+	if err := SetUpstreamProxy("http://" + upstreamLn.Addr().String()); err != nil {
+		t.Fatal(err)
+	}
+	defer SetUpstreamProxy("")
+	SetRaceDelay(20)
+	defer SetRaceDelay(0)
+
+	// an echo server keeps the relayed connections open
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer echo.Close()
+	go func() {
+		for {
+			conn, err := echo.Accept()
+			if err != nil {
+				return
+			}
+			go io.Copy(conn, conn)
+		}
+	}()
+	target := echo.Addr().(*net.TCPAddr)
+	rawaddr := append([]byte{socksAddrIPv4}, target.IP.To4()...)
+	rawaddr = append(rawaddr, byte(target.Port>>8), byte(target.Port))
+	remote, skipped, err := raceServerConn(candidates, rawaddr, target.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer remote.Close()
+	if remote.se != fast {
+		t.Errorf("won by %s, not the fast server %s", remote.se.server, fast.server)
+	}
+	remote.Write([]byte("ping"))
+	buf := make([]byte, 4)
+	if _, err = io.ReadFull(remote, buf); err != nil || string(buf) != "ping" {
+		t.Errorf("winning connection does not relay: %q %v", buf, err)
+	}
+	if len(skipped) != 0 {
+		t.Errorf("no server should be skipped: %v", skipped)
+	}
+	select {
+	case <-slowClosed:
+	case <-time.After(2 * time.Second):
+		t.Error("connection of the losing server was not closed")
+	}
+}
+
+func TestRaceServerConnAllFail(t *testing.T) {
+	started, stop := startRaceServers(t, 2)
+	defer stop()
+	var candidates []*ServerCipher
+	for _, se := range started {
+		// nothing listens there any more
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ln.Close()
+		se.server = ln.Addr().String()
+		candidates = append(candidates, se)
+	}
+	SetRaceDelay(20)
+	defer SetRaceDelay(0)
+
+	rawaddr := []byte{socksAddrIPv4, 127, 0, 0, 1, 0, 80}
+	remote, _, err := raceServerConn(candidates, rawaddr, "127.0.0.1:80")
+	if err == nil || err == errNoServer || remote != nil {
+		t.Errorf("expected the dial error, got %v %v", remote, err)
+	}
+	for _, se := range candidates {
+		if se.breaker.Stat().Failures == 0 {
+			t.Errorf("failure of %s not recorded", se.server)
+		}
+	}
+}
@@ -1,53 +1,86 @@
-dnsDomainIs = function(h, d) {
-    return h.indexOf(d, h.length - d.length) !== -1 && (d.length === h.length || h.indexOf("." + d, h.length - ("." + d).length) !== -1)
-}
+var rules = __RULES__;
 
-function FindProxyForURL(u, h) {
-    h = h.toLowerCase();
-    var a;
-    a = ["127.0.0.1", "localhost", "192.168.*"];
-    for (i = 0; i < a.length; i++) {
-        if (shExpMatch(h, a[i])) {
-            return "DIRECT"
-        }
-    }
-    var isGlobal=__IS_GLOBAL__;
-    if (isGlobal) {
-        return "__PROXY__"
-    }
-    a=__DOMAINS__;
-    for (i = 0; i < a.length; i++) {
-        if (shExpMatch(h, a[i])) {
-            return "__PROXY__"
+// DOMAIN-REGEX values are RE2, the ones JavaScript cannot compile are left out
+(function() {
+    var i, r;
+    for (i = 0; i < rules.length; i++) {
+        r = rules[i];
+        if (r.t === "DOMAIN-REGEX") {
+            try {
+                r.re = new RegExp(r.v[0]);
+            } catch (e) {
+                r.re = null;
+            }
         }
     }
-    if (h === "iosapps.itunes.apple.com") {
-        return "DIRECT"
-    }
-    a = ["*91.108.*", "*109.239.140.*", "*149.154.160.*"];
-    for (i = 0; i < a.length; i++) {
-        if (shExpMatch(h, a[i])) {
-            return "__PROXY__"
+})();
+
+// inSuffixes tells whether h or one of its parent domains is a key of s
+inSuffixes = function(h, s) {
+    var i;
+    for (;;) {
+        if (Object.prototype.hasOwnProperty.call(s, h)) {
+            return true
         }
-    }
-    a = ["www.appledaily.com.tw", "38.media.tumblr.com", "repo.goagent.org", "torrentkittycn.com", "www.pcdvd.com.tw", "deviantart.net", "deviantart.com", "www.dtm.com", "webcache.googleusercontent.com", "playpcesor.com", "blogspot.com", "blogblog.com", "blogger.com", "fqok.org", "ltn.com.tw", "dl-ssl.google.com", "rrys123.com", "superkiki77.blog99.fc2.com", "media-cache-ak0.pinimg.com", "line.me", "apk.tw", "xnife.net", "youjizzlive.com", "r.search.yahoo.com", "tw.gigacircle.com", "playstation.com", "e-hentai.org", "geilitoday.org", "www.longvisit.com", "sourceforge.net", "chuantu.biz", "hkatvnews.com", "uc.udn.com.tw", "weibonews.weebly.com", "pixiv.net", "i2.pixiv.net", "glass8.eu", "cdn.akamai.steamstatic.com", "watch.porn-station.tv", "saurik.com", "m.freemyapps.com", "featurepoints.com", "spotify.com", "deviantart.net", "sndcdn.com", " jwpcdn.com", "tagxedo.com", "webrtc.org"];
-    for (i = 0; i < a.length; i++) {
-        if (dnsDomainIs(h, a[i])) {
-            return "__PROXY__"
+        i = h.indexOf(".");
+        if (i < 0) {
+            return false
         }
+        h = h.substring(i + 1);
     }
-    if (/\.?blogspot\.\w{2}$/.test(h) || /\.google\.com\.\w{2}$/.test(h) || /(google\.\w{2}$|google\.co\.\w{2}$)/.test(h) || /rdio[^\.]*\.akamaihd\.net/.test(h) || /.*rdio.com/.test(h)) return "__PROXY__";
-    a = ["tudou.com", "weibo.com", "xunlei.com", "pptv.com", "sohu.com", "taobao.com", "qq.com", "163.com", "sina.cn", "alipay.com", "youku.com"];
-    for (i = 0; i < a.length; i++) {
-        if (dnsDomainIs(h, a[i])) {
-            return "DIRECT"
-        }
+}
+
+urlPort = function(u) {
+    var m = /^([a-z]+):\/\/(\[[^\]]*\]|[^\/:]*)(:(\d+))?/i.exec(u);
+    if (m && m[4]) {
+        return parseInt(m[4], 10)
     }
-    a = ["gimpshop.com", "bobulate.com", "tetronics.com", "speedpluss.org", "mingpaovan.com", "stoweboyd.com", "tw.myblog.yahoo.com", "joachims.org", "maiio.net", "m.slandr.net", "idv.tw", "mail-archive.com", "surfeasy.com.au", "hihistory.net", "bitcointalk.org", "tweete.net", "cn.dayabook.com", "alexlur.org", "finalion.jp", "nrk.no", "nyt.com", "transparency.org", "uberproxy.net", "embedly.com", "vox-cdn.com", "cmule.com", "gappp.org", "givemesomethingtoread.com", "qq.co.za", "thelifeyoucansave.com", "perfspot.com", "ugo.com", "army.mil", "amoiist.com", "uderzo.it", "tinychat.com", "placemix.com", "twitstat.com", "erabaru.net", "zh.pokerstrategy.com", "zhongmeng.org", "tinypaste.com", "taipei.gov.tw", "youtu.be", "vineapp.com", "tiananmenuniv.com", "freemorenews.com", "penchinese.net", "mesotw.com", "privacybox.de", "liaowangxizang.net", "firstfivefollowers.com", "rfamobile.org", "xanga.com", "godfootsteps.org", "dalailama.com", "bigsound.org", "gtricks.com", "bbg.gov", "imagezilla.net", "feedly.com", "myforum.com.hk", "rthk.hk.edgesuite.net", "imlive.com", "webshots.com", "ptt.cc", "lsforum.net", "bigfools.com", "ziplib.com", "wlx.sowiki.net", "makemymood.com", "foxdie.us", "juliereyc.com", "5i01.com", "beijingspring.com", "drewolanoff.com", "twiffo.com", "blinkx.com", "michaelmarketl.com", "views.fm", "acgkj.com", "branch.com", "soupofmedia.com", "pioneer-worker.forums-free.com", "po2b.com", "slideshare.net", "dyndns.org", "wikileaks.lu", "sohcradio.com", "allgirlsallowed.org", "pts.org.tw", "twitonmsn.com", "www.klip.me", "5maodang.com", "idouga.com", "whyx.org", "peacehall.com", "rdio.com", "tangben.com", "pure18.com", "mac.com", "feeds2.feedburner.com", "greatfirewallofchina.org", "lagranepoca.com", "paddle.com", "cl.ufree.org", "sstatic.net", "rfa.org", "sokamonline.com", "tripod.com", "wrangl.com", "hulu.com", "twiyia.com", "sethwklein.net", "dupola.com", "dupola.net", "blog.summify.com", "coolaler.com", "ngensis.com", "zh.netlog.com", "zhenlibu.info", "mp", "novelasia.com", "v70.us", "fgmtv.org", "rssmeme.com", "futuremessage.org", "luntan.zaobao.com", "wsj.com", "ieasynews.net", "openleaks.org", "benjaminste.in", "asahichinese.com", "blog.youthwant.com.tw", "twicsy.com", "blog.fizzik.com", "sweux.com", "chrispederick.com", "amzs.me", "lkcn.net", "vpnmore.com", "cn.calameo.com", "woxinghuiguo.com", "wefong.com", "savemedia.com", "etools.ncol.com", "livingstream.com", "intellectuapp.com", "forum.idsam.com", "shangfang.org", "longhair.hk", "hkzone.org", "samsoff.es", "pbworks.com", "twip.me", "zannel.com", "gaopi.net", "tokyocn.com", "chinaaid.org", "internetfreedom.org", "renminbao.com", "fc2.com", "taolun.info", "bestforchina.org", "m-team.cc", "x.xcity.jp", "chinese.soifind.com", "www.wet123.com", "cna.com.tw", "hbogo.com", "wikipedia.org", "sorting-algorithms.com", "minghui-school.org", "kusocity.com", "twttr.com", "backchina.com", "london.neighborhoodr.com", "thespeeder.com", "tuidang.net", "chapm25.com", "robtex.com", "sinopitt.info", "orzistic.org", "golang.org", "betfair.com", "typekit.net", "oursogo.com", "art-or-porn.com", "middle-way.net", "dajiyuan.com", "laqingdan.net", "youmaker.com", "focustaiwan.tw", "anonymizer.com", "cnavista.com.tw", "hkg.westkit.net", "nuvid.com", "startpage.com", "fangong.org", "matsushimakaede.com", "plm.org.hk", "falsefire.com", "fuckgfw.com", "codeskulptor.org", "topnews.in", "ihakka.net", "dadazim.com", "www.dwheeler.com", "yousendit.com", "api.supertweet.net", "lupm.org", "highcharts.com", "zillionk.com", "tweetdeck.com", "t35.com", "plunder.com", "tpi.org.tw", "wistia.com", "networkedblogs.com", "hahlo.com", "theampfactory.com", "snapchat.com", "coolder.com", "civilhrfront.org", "blog.iphone-dev.org", "yahoo.com.hk", "holz.byethost8.com", "rnw.nl", "apiary.io", "e-gold.com", "jitouch.com", "youtubecn.com", "livingonline.us", "your-freedom.net", "pct.org.tw", "fringenetwork.com", "epochtimes.jp", "spinejs.com", "tvants.com", "kagyuoffice.org.tw", "bbs.mychat.to", "womensrightsofchina.org", "tw.voa.mobi", "smhric.org", "6-4.net", "ifanqiang.com", "quadedge.com", "packages.debian.org", "openid.net", "rangzen.org", "page2rss.com", "voacantonese.com", "freedomhouse.org", "footwiball.com", "static.digg.com", "shixiao.org", "desc.se", "sod.co.jp", "google.de", "wo.tc", "twbbs.tw", "chromeadblock.com", "rocmp.org", "tibetonline.com", "gardennetworks.org", "njuice.com", "prozz.net", "oclp.hk", "proxomitron.info", "hkhkhk.com", "appleactionews.com", "whitebear.freebearblog.org", "puffstore.com", "advanscene.com", "onlylady.cn", "miroguide.com", "centurys.net", "planetsuzy.org", "cdpwu.org", "myav.com.tw", "dw-world.com", "fangbinxing.com", "xuchao.org", "usfk.mil", "brandonhutchinson.com", "photofocus.com", "rapidsharedata.com", "diigo.com", "xml-training-guide.com", "freeoz.org", "blogimg.jp", "medium.com", "twitterkr.com", "tanc.org", "anontext.com", "thecoveteur.com", "efcc.org.hk", "fulue.com", "turningtorso.com", "sytes.net", "mk5000.com", "americangreencard.com", "bookshelfporn.com", "zhenghui.org", "cao.im", "vtunnel.com", "nintendium.com", "xiezhua.com", "sex8.cc", "thedw.us", "weekmag.info", "markmail.org", "radioaustralia.net.au", "box.com", "rileyguide.com", "knowledge.yahoo.com", "lerosua.org", "twitgoo.com", "livestream.com", "facesofnyfw.com", "echofon.com", "pdetails.com", "tchrd.org", "destiny.xfiles.to", "fbsbx.com", "fourthinternational.org", "feministteacher.com", "slinkset.com", "app.hkatvnews.com", "chinaxchina.com", "www.bulbous.freeserve.co.uk", "chinasocialdemocraticparty.com", "marines.mil", "chinese-hermit.net", "pinporn.com", "nextmedia.com", "relaxbbs.com", "shadowsocks.org", "tweetboner.biz", "vimperator.org", "writer.zoho.com", "furl.net", "wordsandturds.com", "cnn.com", "dvorak.org", "buy.yahoo.com.tw", "suoluo.org", "ntdtv.org", "lsm.org", "nytimes.com", "navigeaters.com", "geohot.com", "wiki.keso.cn", "heqinglian.net", "xinmiao.com.hk", "skype.com", "retweetist.com", "uniteddaily.com.my", "bill2-software.com", "gstatic.com", "nydus.ca", "brizzly.com", "wallpapercasa.com", "bonbonme.com", "mypopescu.com", "ezpc.tk", "justfreevpn.com", "bestofyoutube.com", "fxnetworks.com", "cl.ly", "csdparty.com", "snooper.co.uk", "labiennale.org", "cpj.org", "fw.cm", "sogclub.com", "tw.jiepang.com", "weeewooo.net", "pornrapidshare.com", "qtweeter.com", "fuckcnnic.net", "|https:", "92ccav.com", "soundofhope.org", "news.sina.com.hk", "tibetanyouthcongress.org", "eriversoft.com", "hrcir.com", "emule-ed2k.com", "ghost.org", "one.xthost.info", "apps.admob.com", "vevo.com", "blog.dribbble.com", "huaxia-news.com", "giga-web.jp", "broadbook.com", "static.apple.nextmedia.com", "goldwave.com", "dok-forum.net", "ied2k.net", "chinesen.de", "ustream.tv", "myactimes.com", "hugoroy.eu", "browserscope.org", "izaobao.us", "anonym.to", "alwaysdata.net", "xvideos.com", "blog.path.com", "iicns.com", "video.tiscali.it", "torrentcrazy.com", "xinsheng.net", "scmp.com", "zozotown.com", "googlepages.com", "xjp.cc", "xa.yimg.com", "wp.com", "matainja.com", "democrats.org", "ghut.org", "geometrictools.com", "chinainterimgov.org", "ibros.org", "gentlecuff.com", "shellmix.com", "gov.tw", "icl-fi.org", "a-normal-day.com", "a5.com.ru", "apetube.com", "biantailajiao.com", "assembla.com", "lookatgame.com", "philly.com", "usinfo.state.gov", "basetimesheightdividedby2.com", "laogai.org", "mingpaonews.com", "gopetition.com", "longtermly.net", "gongwt.com", "fooooo.com", "bbs.ecstart.com", "gamebase.com.tw", "life.fly4ever.me", "api.path.com", "vidoemo.com", "morningsun.org", "tibetalk.com", "blogtd.org", "helpzhuling.org", "htmldog.com", "api.proxlet.com", "mimivip.com", "freeweibo.com", "tap11.com", "yilubbs.com", "pengyulong.com", "provideocoalition.com", "fan-qiang.com", "dy24k.info", "yasni.co.uk", "chinainperspective.com", "cms.gov", "xinqimeng.over-blog.com", "lookpic.com", "mingpaocanada.com", "webs-tv.net", "hakkatv.org.tw", "wretch.cc", "mobileways.de", "nde.de", "c-est-simple.com", "myfreshnet.com", "plays.com.tw", "journalofdemocracy.org", "chaos.e-spacy.com", "cookingtothegoodlife.com", "blog.sogoo.org", "|http:", "legaltech.law.com", "taiwandaily.net", "bloomberg.de", "popapp.in", "thevivekspot.com", "mingpaomonthly.com", "getprismatic.com", "plus28.com", "tunnelbear.com", "line.me", "twt.fm", "twipple.jp", "premeforwindows7.com", "ladbrokes.com", "dayaarmongol.ning.com", "wikimapia.org", "catfightpayperview.xxx", "www.macrovpn.com", "dw.de", "blog.topify.com", "www.somee.com", "blog.foolsmountain.com", "bloodshed.net", "catholic.org.hk", "news.msn.com.tw", "chuizi.net", "uygur.org", "ka-wai.com", "wujie.net", "hkepc.com", "bjzc.org", "nexton-net.jp", "entermap.com", "brightkite.com", "twistory.net", "bwsj.hk", "pdproxy.com", "googletagmanager.com", "lyricsquote.com", "psblog.name", "flightcaster.com", "mad-ar.ch", "gdzf.org", "bbs.kimy.com.tw", "unblock.cn.com", "whereiswerner.com", "cdpweb.org", "sugarsync.com", "nuzcom.com", "llnwd.net", "want-daily.com", "helloqueer.com", "cuiweiping.net", "dotplane.com", "ccdtr.org", "wikileaks.pl", "bot.nu", "dalianmeng.org", "vansky.com", "wforum.com", "blog.syx86.com", "google.co.jp", "tvunetworks.com", "futurechinaforum.org", "hikinggfw.org", "akiba-online.com", "target.com", "wiki.jqueryui.com", "hk.search.yahoo.com", "chinarightsia.org", "guomin.us", "cyberghostvpn.com", "billywr.com", "ssense.com", "felixcat.net", "cdn.staticstuff.net", "blog.mongodb.org", "zh.wikinews.org", "yam.com", "porn.com", "akamaihd.net", "stoptibetcrisis.net", "xuchao.net", "generesis.com", "vlog.xuite.net", "minimalmac.com", "yidio.com", "www.6v6dota.com", "newcenturymc.com", "newcenturynews.com", "shaunthesheep.com", "newsminer.com", "jwmusic.org", "www.ned.org", "m.oulove.org", "drtuber.com", "devio.us", "gmbd.cn", "hkgreenradio.org", "mtw.tl", "bbc.co.uk", "siteks.uk.to", "internationalrivers.org", "akamai.net", "tv.com", "securitykiss.com", "coolloud.org.tw", "wikimedia.org", "forum.setty.com.tw", "aculo.us", "breakingtweets.com", "sinomontreal.ca", "secretchina.com", "news.omy.sg", "scmpchinese.com", "voagd.com", "bebo.com", "mobatek.net", "atgfw.org", "weiboleak.com", "support", "osfoora.com", "hrichina.org", "tibetwrites.org", "mingpaony.com", "chinatweeps.com", "theappleblog.com", "songjianjun.com", "monitorchina.org", "twitter.jp", "slickvpn.com", "blog.openinkpot.org", "stuffimreading.com", "video.aol.ca", "mizzmona.com", "uyghurcongress.org", "bullog.org", "24smile.org", "megurineluka.com", "friendfeed.com", "liudejun.com", "fanswong.com", "sexinsex.net", "forum.omy.sg", "boxun.com", "twittertim.es", "tiandixing.org", "oizoblog.com", "blogs.icerocket.com", "roodo.com", "tbpic.info", "googletagservices.com", "putlocker.com", "eevpn.com", "toodoc.com", "dafagood.com", "sinocast.com", "mpfinance.com", "pornvisit.com", "taiwannation.com.tw", "chengmingmag.com", "slavasoft.com", "prosiben.de", "dongde.com", "slacker.com", "myforum.com.uk", "bayvoice.net", "referer.us", "summify.com", "iask.bz", "readingtimes.com.tw", "1pondo.tv", "tweets.seraph.me", "post.anyu.org", "zyzc9.com", "tui.orzdream.com", "ntd.tv", "googleplus.com", "kt.kcome.org", "w.idaiwan.com", "newtaiwan.com.tw", "epochtimes-romania.com", "cbs.ntu.edu.tw", "4chan.org", "urlparser.com", "peopo.org", "ipicture.ru", "ch.shvoong.com", "dotsub.com", "mathiew-badimon.com", "cdninstagram.com", "blog.ifttt.com", "twittbot.net", "sockslist.net", "www.vpncup.com", "keepandshare.com", "avoision.com", "coveringweb.com", "unix100.com", "lemonde.fr", "wanderinghorse.net", "vot.org", "chinacomments.org", "wikileaks.ch", "ronjoneswriter.com", "bbsfeed.com", "facebook.net", "sha7.info", "gravatar.com", "tubecao.com", "dontmovetochina.com", "hidemyass.com", "myparagliding.com", "pandora.com", "getcloudapp.com", "blog.expofutures.com", "imagevenue.com", "status.twhirl.org", "connectedchina.reuters.com", "lists.w3.org", "chinafreepress.org", "wenku.com", "myeclipseide.com", "xh4n.cn", "botanwang.com", "dropbox.com", "ianslive.in", "hellouk.org", "taiwanyes.ning.com", "animecrazy.net", "12vpn.com", "www.aolnews.com", "s8forum.com", "picturesocial.com", "bullogger.com", "888.com", "offbeatchina.com", "seezone.net", "frontlinedefenders.org", "theblemish.com", "anthonycalzadilla.com", "xizang-zhiye.org", "feelssh.com", "rsf.org", "rthk.hk", "boardreader.com", "blog.pentalogic.net", "geocities.com", "wiredbytes.com", "nobelprize.org", "pornmm.net", "wuerkaixi.com", "hkreporter.loved.hk", "heiyo.info", "tnaflix.com", "tuidang.org", "blog.tiney.com", "paperb.us", "sino-monthly.com", "zvereff.com", "zh.uncyclopedia.wikia.com", "openvpn.net", "pastebin.com", "kaiyuan.de", "www.stackfile.com", "vimeocdn.com", "ameblo.jp", "findbook.tw", "ccthere.com", "markmilian.com", "goagentplus.com", "cdpusa.org", "sinoants.com", "newgrounds.com", "xpdo.net", "ourdearamy.com", "rapbull.net", "innermongolia.org", "feedbooks.mobi", "tumblweed.org", "electionsmeter.com", "www.getyouram.com", "blogcatalog.com", "xcritic.com", "lsmchinese.org", "mysinablog.com", "www.ajsands.com", "hwinfo.com", "hk.jiepang.com", "t.co", "svwind.com", "tsemtulku.com", "spb.com", "i1.hk", "parislemon.com", "vimeo.com", "minus.com", "epochtimes.com", "yuming.flnet.org", "archive.is", "android.com", "nobodycanstop.us", "starp2p.com", "dalailamaworld.com", "lastfm.es", "hkfront.org", "ziddu.com", "pbxes.com", "dnscrypt.org", "getfreedur.com", "rapidshare8.com", "sevenload.com", "lockdown.com", "twitter4j.org", "liansi.org", "metacafe.com", "spankwire.com", "zaozon.com", "hk.news.yahoo.com", "peeasian.com", "mixero.com", "thepiratebay.org", "toutfr.com", "antiwave.net", "twreg.info", "twitzap.com", "bignews.org", "tora.to", "fileserve.com", "muzu.tv", "shitaotv.org", "tvboxnow.com", "wisevid.com", "gongmeng.info", "china21.com", "wenhui.ch", "uni.cc", "tttan.com", "webfee.tk", "sharecool.org", "sex-11.com", "vanemu.cn", "twitturk.com", "marc.info", "fapdu.com", "verizon.net", "sacom.hk", "wikiwiki.jp", "wufi.org.tw", "bt95.com", "hackthatphone.net", "googledrive.com", "neverforget8964.org", "yong.hu", "israbox.com", "iask.ca", "duckduckgo.com", "riku.me", "prestige-av.com", "cloudfront.net", "alwaysdata.com", "ogaoga.org", "expatshield.com", "zh.wikipedia.org", "gardennetworks.com", "ntdtv.co", "actimes.com.au", "tjholowaychuk.com", "tube.com", "zh.m.wikipedia.org", "huanghuagang.org", "vanilla-jp.com", "nsc.gov.tw", "ntdtv.ca", "wwitv.com", "pinimg.com", "privatetunnel.com", "kinghost.com", "subacme.rerouted.org", "cdig.info", "hkreporter.com", "newscn.org", "simpleproductivityblog.com", "freexinwen.com", "scriptspot.com", "xuzhuoer.com", "chenguangcheng.com", "karayou.com", "twimg.edgesuite.net", "dmcdn.net", "forum.cyberctm.com", "saiq.me", "heungkongdiscuss.com", "ggssl.com", "zhuichaguoji.org", "china101.com", "hideipvpn.com", "orn.jp", "gartlive.com", "clientsfromhell.net", "allinfa.com", "static.byhours.com", "twittergadget.com", "cecc.gov", "opendemocracy.net", "clipfish.de", "xskywalker.com", "paint.net", "vcfbuilder.org", "procopytips.com", "internetdefenseleague.org", "pidown.com", "mingjingnews.com", "cdnews.com.tw", "lovequicksilver.com", "soc.mil", "woeser.com", "oopsforum.com", "doubleaf.com", "hanunyi.com", "sndcdn.com", "mp3ye.eu", "9bis.com", "qkshare.com", "time.com", "wiredpen.com", "newspeak.cc", "cn2.streetvoice.com", "dl.box.net", "topshare.us", "chevronwp7.com", "wordpres.org", "w.org", "wordpress.com", "hen.bao.li", "c-spanvideo.org", "daxa.cn", "zonaeuropa.com", "c1521.biz.tm", "qiwen.lu", "stupidvideos.com", "mingpaosf.com", "trouw.nl", "hongzhi.li", "hihiforum.com", "bbs.skykiwi.com", "slheng.com", "shifeike.blog125.fc2blog.net", "angularjs.org", "teashark.com", "chinayouth.org.hk", "twitiq.com", "sneakme.net", "fbcdn.net", "vaayoo.com", "bloglines.com", "whydidyoubuymethat.com", "pixnet.net", "zhongguotese.net", "seevpn.com", "edgecastcdn.net", "laomiu.com", "lsmkorean.org", "transgressionism.org", "t66y.com", "files2me.com", "blogs.tampabay.com", "bestvpnservice.com", "killwall.com", "chinese.rnw.nl", "chinamz.org", "globalrescue.net", "calameo.com", "wattpad.com", "wow-life.net", "twitvid.com", "rd.io", "kzeng.info", "thebodyshop-usa.com", "youxu.info", "lianyue.net", "blog.bitly.com", "tw.streetvoice.com", "hk.rd.yahoo.com", "youtube.com", "zhreader.com", "netme.cc", "ncn.org", "ultravpn.fr", "12bet.com", "zhao.jinhai.de", "tweeplike.me", "analyze-v.com", "edubridge.com", "www.urbanoutfitters.com", "cari.com.my", "ezpeer.com", "2000fun.com", "bnrmetal.com", "nf.id.au", "sowers.org.hk", "yzzk.com", "danke4china.net", "epochtimes.co.kr", "nowtorrents.com", "174.142.105.153", "xinhuanet.org", "atlaspost.com", "chinaway.org", "jyzj.waqn.com", "stickam.com", "dotheyfolloweachother.com", "asianews.it", "9001700.com", "showtime.jp", "thetrotskymovie.com", "vimgolf.com", "tweepmag.com", "kendincos.net", "kurtmunger.com", "igvita.com", "maruta.be", "xtube.com", "qusi8.net", "hk-pub.com", "pwned.com", "nurgo-software.com", "orientaldaily.com.my", "touch99.com", "dimitrik.free.fr", "spapps.co", "hsjp.net", "linux-engineer.net", "great-roc.org", "lvhai.org", "chinainperspective.net", "ozchinese.com", "istockphoto.com", "www.forum4hk.com", "goodreaders.com", "tw.news.yahoo.com", "asiaharvest.org", "lidecheng.com", "haproxy.org", "twstar.net", "ddc.com.tw", "holyspiritspeaks.org", "mcfog.com", "new-akiba.com", "xmusic.fm", "hk.video.news.yahoo.com", "zh.wikisource.org", "nic.cz.cc", "ntdtv.ru", "gaeproxy.com", "geek-art.net", "infinitylist.com", "zsrhao.com", "bet365.com", "power.com", "ernestmandel.org", "boxunblog.com", "news.singtao.ca", "geocities.jp", "tibetonline.tv", "fanqianghou.com", "modfetish.com", "jobso.tv", "ait.org.tw", "ow.ly", "ignitedetroit.net", "chinalawtranslate.com", "unholyknight.com", "cctongbao.com", "sinocism.com", "twitthat.com", "ranyunfei.com", "sharpdaily.com.hk", "porn2.com", "hyperrate.com", "minzhuhua.net", "ganges.com", "ablwang.com", "ecministry.net", "ipvanish.com", "video.fdbox.com", "graphis.ne.jp", "bitshare.com", "iconpaper.org", "ilove80.be", "theguardian.com", "dajiyuan.eu", "zdnet.com.tw", "www.powerpointninja.com", "tynsoe.org", "khmusic.com.tw", "jp", "blog.birdhouseapp.com", "dabr.me", "hiitch.com", "tmagazine.com", "zuo.la", "kissbbao.cn", "tycool.com", "blog.kangye.org", "kui.name", "eyevio.jp", "cubicle17.com", "yyii.org", "proxy.org", "thomasbernhard.org", "vine.co", "itweet.net", "futureme.org", "greatzhonghua.org", "muzi.net", "alvinalexander.com", "fqrouter.com", "amnesty.org", "skimtube.com", "duckmylife.com", "lenwhite.com", "epochtimes.se", "tokyo-hot.com", "businessinsider.com", "asdfg.jp", "cnd.org", "imageshack.us", "getjetso.com", "pubu.com.tw", "freewallpaper4.me", "seesmic.com", "videomo.com", "en.wikipedia.org", "fillthesquare.org", "retweeteffect.com", "sproutcore.com", "getsmartlinks.com", "s3-eu-west-1.amazonaws.com", "puffinbrowser.com", "telegraph.co.uk", "aboluowang.com", "jayparkinsonmd.com", "fuckgfw.org", "wangafu.net", "bralio.com", "sourceforge.net", "hk.knowledge.yahoo.com", "pornstarclub.com", "twit2d.com", "enewstree.com", "mcadforums.com", "freegao.com", "twibs.com", "ccue.com", "wanglixiong.com", "chinagate.com", "zhanbin.net", "beanstalkapp.com", "himemix.net", "kompozer.net", "plusbb.com", "zh-tw.justin.tv", "tweetymail.com", "simplecd.org", "67.220.91.23", "opml.radiotime.com", "jbtalks.cc", "privatepaste.com", "lalulalu.com", "fastly.net", "globaljihad.net", "googleadservices.com", "nuexpo.com", "businessweek.com", "ssh91.com", "isgreat.org", "666kb.com", "hrw.org", "tidyread.com", "ajaxplorer.info", "caobian.info", "incredibox.fr", "twibbon.com", "isuntv.com", "tvider.com", "helpeachpeople.com", "hutianyi.net", "hua-yue.net", "xys.org", "namsisi.com", "redtube.com", "goldbetsports.com", "blog.taragana.com", "teamseesmic.com", "google.com.au", "xcafe.in", "tibet.org.tw", "md-t.org", "secure.wikimedia.org", "msguancha.com", "perlhowto.com", "heartyit.com", "wengewang.com", "blog.klip.me", "forum.slime.com.tw", "sexhu.com", "0rz.tw", "jkforum.net", "sis001.us", "84000.co", "cotweet.com", "citizenlab.org", "api.linksalpha.com", "oasic.net", "vapurl.com", "value-domain.com", "qoos.com", "erights.net", "anobii.com", "www.radioaustralia.net.au", "pign.net", "blog.dayoneapp.com", "mog.com", "fsurf.com", "fredwilson.vc", "zacebook.com", "npa.go.jp", "hechaji.com", "tkforum.tk", "pagodabox.com", "trendsmap.com", "dl-laby.jp", "thesartorialist.com", "fgmtv.net", "soup.io", "youporn.com", "wrchina.org", "dailidaili.com", "mx981.com", "chinaaid.us", "xfm.pp.ru", "powercx.com", "9bis.net", "duplicati.com", "beta.usejump.com", "141hongkong.com", "bonjourlesgeeks.com", "interestinglaugh.com", "ippotv.com", "peerpong.com", "7capture.com", "uhrp.org", "yymaya.com", "marco.org", "psiphon.civisec.org", "trtc.com.tw", "here4news.com", "mixpod.com", "fengzhenghu.com", "taiwan-sex.com", "packetix.net", "forum.tvb.com", "im88.tw", "epochtimes.ru", "video.foxbusiness.com", "j.mp", "voachinese.com", "space-scape.com", "fc2china.com", "codeshare.io", "dailymotion.com", "new-3lunch.net", "bcc.com.tw", "news100.com.tw", "voachineseblog.com", "eamonnbrennan.com", "topstyle4.com", "wpoforum.com", "freealim.com", "vpngate.net", "x1949x.com", "forum.iset.com.tw", "palacemoon.com", "apidocs.linksalpha.com", "mhradio.org", "webworkerdaily.com", "dfas.mil", "thehousenews.com", "yvesgeleyn.com", "spencertipping.com", "altrec.com", "dontfilter.us", "myboooks.googlepages.com", "catholic.org.tw", "civicparty.hk", "on.cc", "zarias.com", "ccue.ca", "pastie.org", "web2project.net", "aisex.com", "curvefish.com", "s3.amazonaws.com", "marxists.org", "chubun.com", "liuhanyu.com", "boxun.tv", "tiananmenmother.org", "xuzhiyong.net", "newsforums.bbc.co.uk", "joeedelman.com", "dynawebinc.com", "cn.uncyclopedia.wikia.com", "jbtalks.com", "iu45.com", "davidslog.com", "tv-intros.com", "dafahao.com", "mingpaotor.com", "filefactory.com", "epochtimes.de", "freessh.us", "cuhkacs.org", "fanglizhi.info", "sadpanda.us", "ozyoyo.com", "panluan.net", "shenzhoufilm.com", "cafepress.com", "chrome.com", "fanyue.info", "worldcat.org", "dtiserv2.com", "goagent.biz", "mgstage.com", "xnxx.com", "twitpic.com", "upcoming.yahoo.com", "nicovideo.jp", "zeutch.com", "newchen.com", "lrfz.com", "freeopenvpn.com", "pbs.org", "goodreads.com", "blog.jackjia.com", "foxsub.com", "abercrombie.com", "get-digital-help.com", "secretgarden.no", "69.65.19.160", "tw.yahoo.com", "mixx.com", "twibase.com", "uploaded.to", "ialmostlaugh.com", "tzangms.com", "thechinabeat.org", "jingpin.org", "facebook.com", "picturedip.com", "dabr.co.uk", "dadi360.com", "cams.com", "tomsc.com", "babynet.com.hk", "friendfeed-media.com", "newyorktimes.com", "ironbigfools.compython.net", "rxhj.net", "blog.gowalla.com", "nemesis2.qx.net", "uwants.net", "culture.tw", "identi.ca", "list.ly", "news.atebits.com", "duckload.com", "videobam.com", "kineox.free.fr", "zkaip.com", "googlelabs.com", "vpnpronet.com", "latimesblogs.latimes.com", "nps.gov", "wordboner.com", "wqyd.org", "twurl.nl", "epochtimes.co.il", "togetter.com", "t.kun.im", "taipeisociety.org", "torproject.org", "blog.pikchur.com", "gclooney.com", "googlesyndication.com", "hkej.com", "blog.de", "koolsolutions.com", "mmmca.com", "great-firewall.com", "chrlcg-hk.org", "movabletype.com", "ifan.cz.cc", "immigration.gov.tw", "dw-world.de", "anchorfree.com", "gospelherald.com", "purevpn.com", "antidrm.hpg.ig.com.br", "biantailajiao.in", "djangosnippets.org", "hasaowall.com", "www.proxifier.com", "tweetwally.com", "xing.com", "podictionary.com", "stickeraction.com", "derekhsu.homeip.net", "sis.xxx", "wzyboy.im", "buzzurl.jp", "twistar.cc", "taa-usa.org", "appledaily.com", "nysingtao.com", "tuxtraining.com", "xbookcn.com", "witopia.net", "wenyunchao.com", "w3schools.com", "thetibetpost.com", "chinalawandpolicy.com", "blog.boxcar.io", "www.supertweet.net", "xyy69.com", "szbbs.net", "canadameet.com", "backpackers.com.tw", "home.so-net.net.tw", "uncyclopedia.info", "helloandroid.com", "greatfirewallofchina.net", "avdb.in", "alabout.com", "sexandsubmission.com", "pbxes.org", "hutong9.net", "verybs.com", "avidemux.org", "alkasir.com", "shinychan.com", "yasukuni.or.jp", "shenshou.org", "baidu.jp", "typepad.com", "galenwu.com", "areca-backup.org", "heix.pp.ru", "multiupload.com", "greenvpn.net", "blog.usa.gov", "robustnessiskey.com", "cn.streetvoice.com", "thedieline.com", "berlintwitterwall.com", "molihua.org", "hungerstrikeforaids.org", "socialwhale.com", "notes.alexdong.com", "10musume.com", "newlandmagazine.com.au", "my.keso.cn", "gather.com", "ggpht.com", "freepik.com", "bcchinese.net", "sesawe.net", "funp.com", "mh4u.org", "wukangrui.net", "jeanyim.com", "chinaworker.info", "fastpic.ru", "maxgif.com", "pixelqi.com", "sjum.cn", "mgoon.com", "cbsnews.com", "ifjc.org", "all-that-is-interesting.com", "soumo.info", "licdn.com", "xpud.org", "naacoalition.org", "jalan.net", "research.jmsc.hku.hk", "latelinenews.com", "s1heng.com", "www.loiclemeur.com", "e-info.org.tw", "c1522.mooo.com", "old.nabble.com", "nytimg.com", "video.aol.com", "mitbbs.com", "kakao.com", "ruyiseek.com", "qxbbs.org", "popyard.org", "yunchao.net", "dolc.de", "ironpython.net", "demo.opera-mini.net", "alasbarricadas.org", "plurktop.mmdays.com", "tweetmeme.com", "chinese.engadget.com", "disp.cc", "aiweiweiblog.com", "hk.yahoo.com", "123rf.com", "badoo.com", "memrijttm.org", "appspot.com", "mixedmedialabs.com", "frommel.net", "mininova.org", "shahamat-english.com", "yegle.net", "blog.sina.com.tw", "bbsland.com", "blogtd.net", "ventureswell.com", "popularpages.net", "photos.dailyme.com", "savevid.com", "pornoxo.com", "genuitec.com", "vinniev.com", "tcno.net", "squarespace.com", "default.secureserver.net", "hkgolden.com", "voatibetan.com", "speckleapp.com", "g.co", "newtalk.tw", "shkspr.mobi", "forum.my903.com", "politicalchina.org", "ac.jiruan.net", "www.zaurus.org.uk", "bolin.netfirms.com", "thepiratebay.se", "adultkeep.net", "cdp1998.org", "twisternow.com", "881903.com", "blog.lester850.info", "woopie.tv", "uighurbiz.net", "nch.com.tw", "dojin.com", "ibiblio.org", "fakku.net", "wuala.com", "blog.qooza.hk", "yhcw.net", "iphonix.fr", "blogs.yahoo.co.jp", "news.sina.com.tw", "netflix.com", "gutteruncensored.com", "hdtvb.net", "shapeservices.com", "sin1.g.adnxs.com", "westernwolves.com", "usus.cc", "psiphon.ca", "rthk.org.hk", "washeng.net", "bbs.ozchinese.com", "efmoe.com", "purepdf.com", "larsgeorge.com", "beta.iset.com.tw", "twbbs.net.tw", "zgzcjj.net", "linkideo.com", "softether.co.jp", "phuquocservices.com", "drgan.net", "pcdiscuss.com", "sitemaps.org", "abc.pp.ru", "buugaa.com", "linuxtoy.org", "thedailywh.at", "addictedtocoffee.de", "getchu.com", "geekerhome.com", "greatroc.org", "alternate-tools.com", "marxist.com", "global.hkepc.com", "kanzhongguo.com", "help.linksalpha.com", "greatroc.tw", "pk.com", "dlsite.com", "crossthewall.net", "twbbs.org", "xinshijue.com", "ndr.de", "woopie.jp", "hqcdp.org", "freenewscn.com", "hkjp.org", "stoneip.info", "googleusercontent.com", "assets-yammer.com", "amazon.com", "old-cat.net", "shadow.ma", "wiki.phonegap.com", "pinterest.com", "instagram.com", "yx51.net", "sydneytoday.com", "blogsmithmedia.com", "olympicwatch.org", "whippedass.com", "tafaward.com", "newstapa.org", "falunart.org", "unknownspace.org", "tweetrans.com", "jyxf.net", "radiovncr.com", "uushare.com", "wikileaks.de", "flickrhivemind.net", "pullfolio.com", "sis001.com", "workatruna.com", "memedia.cn", "gpass1.com", "megarotic.com", "dev102.com", "zengjinyan.org", "centralnation.com", "pandora.tv", "amiblockedornot.com", "zhinengluyou.com", "bannedbook.org", "chinesenewsnet.com", "nokogiri.org", "twitterfeed.com", "wolfax.com", "hkjp.easyweb.hk", "ea.com.cn", "ifcss.org", "corumcollege.com", "thumbzilla.com", "hecaitou.net", "www.wangruowang.org", "koornk.com", "bbs.sina.com", "leecheukyan.org", "theqii.info", "blip.tv", "scalatest.org", "share.youthwant.com.tw", "gzone-anime.info", "1984bbs.org", "penthouse.com", "lematin.ch", "bbs.tuitui.info", "uploadstation.com", "alliance.org.hk", "wearn.com", "zattoo.com", "ukliferadio.co.uk", "askynz.net", "zaobao.com.sg", "teck.in", "multiproxy.org", "presentationzen.com", "bettween.com", "gun-world.net", "kenengba.com", "china5000.us", "crackle.com", "twftp.org", "zlib.net", "multiply.com", "lipuman.com", "porntube.com", "tunein.com", "uwants.com", "gotw.ca", "tumutanzi.com", "photo.utom.us", "dizhidizhi.com", "pbwiki.com", "epochtimes.fr", "idemocracy.asia", "eltondisney.com", "free.fr", "1bao.org", "extremetube.com", "yeelou.com", "hk32168.com", "swift-tools.net", "merit-times.com.tw", "google.cn", "hkheadline.com", "upload4u.info", "uocn.org", "wikileaks.org", "taiwanyes.com", "chinesepen.org", "itaboo.info", "thisiswhyyouarefat.com", "mmaaxx.com", "theinternetwishlist.com", "twitgether.com", "chromium.org", "collateralmurder.com", "baixing.me", "lesscss.org", "webmproject.org", "realraptalk.com", "nanyang.com", "2shared.com", "chinagreenparty.org", "fireofliberty.org", "isohunt.com", "meirixiaochao.com", "rutube.ru", "erepublik.com", "mike.cz.cc", "www.freetibet.org", "whatblocked.com", "vocn.tv", "ironicsoftware.com", "htxt.it", "googleapis.com", "businesstimes.com.cn", "zomobo.net", "beijing1989.com", "kingdomsalvation.org", "vpnbook.com", "ping.fm", "hudatoriq.web.id", "duihua.org", "bowenpress.com", "sports.williamhill.com", "fotop.net", "blog.syx86.cn", "tweetbackup.com", "liveleak.com", "tvb.com", "faststone.org", "ulike.net", "365singles.com.ar", "calebelston.com", "pao-pao.net", "turbobit.net", "liujianshu.com", "daylife.com", "whylover.com", "allinfo.com", "lsd.org.hk", "mingjinglishi.com", "wepn.info", "freegateget.googlepages.com", "tenacy.com", "parade.com", "mobile01.com", "cynscribe.com", "lockestek.com", "dtic.mil", "rotten.com", "x-art.com", "atchinese.com", "gazotube.com", "nownews.com", "64wiki.com", "bloglovin.com", "idiomconnection.com", "v-state.org", "cocoa.zonble.net", "popyard.com", "forum.baby-kingdom.com", "oiktv.com", "ytht.net", "willw.net", "chinahush.com", "eyespirit.info", "wangjinbo.org", "dowei.org", "tianhuayuan.com", "renyurenquan.org", "noobbox.com", "sephora.com", "gongminliliang.com", "cdjp.org", "code1984.com", "gzm.tv", "taiwannation.50webs.com", "sharebee.com", "dailynews.sina.com", "chinadigitaltimes.net", "tagwalk.com", "chinagfw.org", "aolchannels.aol.com", "wenxuecity.com", "veoh.com", "dongyangjing.com", "lizhizhuangbi.com", "cdp.sinica.edu.tw", "meetup.com", "hk.myblog.yahoo.com", "logbot.net", "techlifeweb.com", "junefourth-20.net", "seapuff.com", "allonlinux.free.fr", "blogspot.hk", "waikeung.org", "nvquan.org", "jinbushe.org", "my-proxy.com", "kun.im", "shodanhq.com", "t.huhaitai.com", "fflick.com", "slutload.com", "globaldelight.com", "postadult.com", "falundafa.org", "yi.org", "lancome.com", "tube8.com", "epochtimes.ie", "opnir.com", "marguerite.su", "chenpokong.com", "2-hand.info", "dit-inc.us", "2008xianzhang.info", "kcsoftwares.com", "emacsblog.org", "englishfromengland.co.uk", "qixianglu.cn", "img.ly", "tuanzt.com", "bit.ly", "makzhou.warehouse333.com", "pornhub.com", "share.ovi.com", "mihua.org", "ck101.com", "wikileaks.eu", "wallornot.org", "softether.org", "twapperkeeper.com", "rushbee.com", "proctoru.com", "sendspace.com", "archdaily.net", "allmovie.com", "readmoo.com", "google.org", "google.com", "badassjs.com", "howtoforge.com", "fanqiangyakexi.net", "googlevideo.com", "shopping.com", "torvpn.com", "qvodzy.org", "hacken.cc", "ismprofessional.net", "gmail.com", "huping.net", "6park.com", "bbs.qmzdd.com", "wengewang.org", "velkaepocha.sk", "myshare.url.com.tw", "chinamule.com", "app.heywire.com", "trulyergonomic.com", "atnext.com", "lists.debian.org", "nccwatch.org.tw", "globalmuseumoncommunism.org", "streamingthe.net", "qanote.com", "falunhr.org", "thehungrydudes.com", "www.voy.com", "nexttv.com.tw", "discuss.com.hk", "usmc.mil", "awardwinningfjords.com", "zuola.com", "wetpussygames.com", "zshare.net", "blog.instapaper.com", "89-64.org", "linglingfa.com", "imgur.com", "juziyue.com", "cn.reuters.com", "cn.voa.mobi", "aiph.net", "skybet.com", "vatn.org", "hypeshell.com", "megavideo.com", "rojo.com", "tibetfund.org", "nordstrom.com", "falundafamuseum.org", "dajusha.baywords.com", "pchome.com.tw", "wiki.oauth.net", "thehun.net", "tistory.com", "nakido.com", "fzh999.net", "creaders.net", "m.plixi.com", "hkbf.org", "www.linksalpha.com", "wqlhw.com", "overlapr.com", "us.to", "hk.geocities.com", "p-cdn.com", "tianzhu.org", "wapedia.mobi", "allaboutalpha.com", "graylog2.org", "metrolife.ca", "gigporno.ru", "moviefap.com", "twtkr.com", "rferl.org", "shwchurch3.com", "sysadmin1138.net", "feedzshare.com", "rsf-chinese.org", "lookingglasstheatre.org", "sex.com", "uploaded.net", "xmovies.com", "duoweitimes.com", "sbnation.com", "chingcheong.com", "xhamster.com", "freemoren.com", "pornbase.org", "blogblog.com", "danwei.org", "greenparty.org.tw", "van698.com", "k2.xrea.com", "mlcool.com", "omgili.com", "sharpdaily.hk", "jiehua.cz", "livevideo.com", "weblagu.com", "nlfreevpn.com", "e123.hk", "panoramio.com", "sopcast.org", "internetpopculture.com", "sogrady.me", "news.cnyes.com", "etizer.org", "westca.com", "oikos.com.tw", "315lz.com", "letscorp.net", "powerapple.com", "vpnfire.com", "51.ca", "salvation.org.hk", "rfi.fr", "getiton.com", "getlantern.org", "i2runner.com", "hidecloud.com", "www.mycould.com", "gcpnews.com", "marxist.net", "twifan.com", "tweetphoto.com", "tokyo-247.com", "greatfire.org", "gabocorp.com", "googlecode.com", "change.org", "turbotwitter.com", "githubusercontent.com", "github.com", "githubapp.com", "chinaaid.me", "thisav.com", "free-ssh.com", "tubewolf.com", "blog.instagram.com", "xgmyd.com", "gfw.org.ua", "chinesedailynews.com", "www.owind.com", "viki.com", "weijingsheng.org", "sinoquebec.com", "puttycm.free.fr", "favorious.com", "srcf.ucam.org", "rhcloud.com", "sthoo.com", "softwarebychuck.com", "illusionfactory.com", "efksoft.com", "staticflickr.com", "freenet-china.org", "chinatimes.com", "21andy.com", "greatfirewall.biz", "islam.org.hk", "grandtrial.org", "mirrorbooks.com", "www.tiffanyarment.com", "chinageeks.org", "dongtaiwang.com", "twimg.com", "hardsextube.com", "malaysiakini.com", "4bluestones.biz", "tweepguide.com", "twitlonger.com", "rconversation.blogs.com", "revleft.com", "october-review.org", "isunaffairs.com", "codeboxapp.com", "zinio.com", "thediplomat.com", "ressim.net", "snaptu.com", "laoyang.info", "ntdtv.com", "sendoid.com", "blog.palm.com", "huaglad.com", "eventful.com", "yeeyi.com", "s.xiaod.in", "forum.sina.com.hk", "chn.chosun.com", "playboy.com", "amnestyusa.org", "appledaily.com.hk", "thelius.org", "imkev.com", "websitepulse.com", "listorious.com", "open.com.hk", "youversion.com", "techparaiso.com", "cyberghost.natado.com", "rlwlw.com", "twitcause.com", "flickr.com", "en.favotter.net", "softether-download.com", "waiwaier.com", "site90.net", "hootsuite.com", "dongtaiwang.net", "telecomspace.com", "delcamp.net", "skyvegas.com", "dalailama.ru", "vpnpop.com", "301works.org", "67.220.91.18", "chinaeweekly.com", "www.zfreet.com", "youpai.org", "mpettis.com", "log.riku.me", "my.opera.com", "nokola.com", "tsctv.net", "tsunagarumon.com", "dropboxusercontent.com", "cherrysave.com", "mondex.org", "ghconduit.com", "dwnews.com", "hjclub.info", "h1n1china.org", "raidcall.com.tw", "goofind.com", "weiming.info", "hotspotshield.com", "sopcast.com", "blog.davidziegler.net", "aobo.com.au", "fangongheike.com", "google.com.tw", "i2p2.de", "kingstone.com.tw", "proxifier.com", "twilog.org", "oursteps.com.au", "twittermail.com", "reflectivecode.com", "freechal.com", "fail.hk", "tt1069.com", "4sqi.net", "piring.com", "exploader.net", "vpngate.jp", "zootool.com", "blog.joeyrobert.org", "huaxin.ph", "melon-peach.com", "cenci.tk", "google.com.hk", "hkptu.org", "bahamut.com.tw", "gamer.com.tw", "wellplacedpixels.com", "recordhistory.org", "exblog.jp", "tsquare.tv", "foxtang.com", "brucewang.net", "blog.rockmelt.com", "sesawe.org", "dl.dropboxusercontent.com", "glue-static.s3-external-3.amazonaws.com", "vincnd.com", "edoors.com", "southnews.com.tw", "etaiwannews.com", "soundcloud.com", "udn.com", "udn.com.tw", "udndata.com", "am730.com.hk", "wexiaobo.org", "unicode.org", "goo.gl", "bd.zhe.la", "favstar.fm", "mayimayi.com", "cl.d0z.net", "braumeister.org", "sammyjs.org", "blog.pathtosharepoint.com", "wujieliulan.com", "revver.com", "twitturly.com", "getsocialscope.com", "webbang.net", "citizensradio.org", "twaud.io", "mockable.io", "chinaaffairs.org", "www.moztw.org", "paper.li", "nighost.org", "sejie.com", "meteorshowersonline.com", "faydao.com", "trialofccp.org", "fb.com", "zmw.cn", "tweetmylast.fm", "anti.anti.cnn.googlepages.com", "omnitalk.com", "muouju.com", "libertytimes.com.tw", "dipity.com", "china21.org", "pypi.python.org", "bloomingdales.com", "bbcchinese.com", "af.mil", "pin6.com", "t.orzdream.com", "gmodules.com", "feer.com", "avaaz.org", "blog.kl.am", "domainhelp.search.com", "bloomfortune.com", "sfileydy.com", "nanyangpost.com", "python.com", "sandnoble.com", "read100.com", "classicalguitarblog.net", "igfw.net", "thereallove.kr", "barnabu.co.uk", "fawanghuihui.org", "x-wall.org", "daolan.net", "taiwanus.net", "blog.pilotmoon.com", "szetowah.org.hk", "connect.facebook.net", "blog.kickstarter.com", "yuanming.net", "geekmanuals.com", "amusingplanet.com", "guancha.org", "chinayuanmin.org", "nga.mil", "fring.com", "archive.org", "tamiaode.tk", "retweetrank.com", "onmoon.com", "jiaoyou8.com", "twibble.de", "gaymap.cc", "btdigg.org", "blog.istef.info", "scribd.com", "blogspot.com", "crowy.net", "rti.org.tw", "magazines.sina.com.tw", "fscked.org", "m.tweete.net", "dwnews.net", "cellulo.info", "truth101.co.tv", "navy.mil", "tibet.net", "uncyclomedia.org", "bipic.net", "gvm.com.tw", "freakshare.com", "news.ghostery.com", "hkbc.net", "youtube-nocookie.com", "winwhispers.info", "savetibet.org", "sohfrance.org", "thegatesnotes.com", "netcolony.com", "kechara.com", "break.com", "cenews.eu", "xxxx.com.au", "h-china.org", "voanews.com", "proxyroad.com", "cytode.us", "dpp.org.tw", "gdbt.net", "hnjhj.com", "thkphoto.com", "la-forum.org", "weiquanwang.org", "im.tv", "okayfreedom.com", "twimbow.com", "cclife.org", "lifemiles.com", "hkday.net", "1984bbs.com", "iphonehacks.com", "changp.com", "solozorro.tk", "justtristan.com", "pmates.com", "waigaobu.com", "naol.ca", "adultfriendfinder.com", "collateralmurder.org", "al-qimmah.net", "twitbrowser.net", "jbtalks.my", "mediafire.com", "theatrum-belli.com", "geocities.co.jp", "listentoyoutube.com", "36rain.com", "somee.com", "aarki.net", "www.wan-press.org", "t.neolee.cn", "sexhuang.com", "stonegames.net", "dzze.com", "python.com.tw", "throughnightsfire.com", "liuxiaotong.com", "times.hinet.net", "artsy.net", "mashable.com", "gnuradio.org", "72.52.81.22", "axureformac.com", "64tianwang.com", "thumbr.io", "domain.club.tw", "imageflea.com", "wangruoshui.net", "dougscripts.com", "yogichen.org", "bewww.net", "gongm.in", "shenyunperformingarts.org", "sheikyermami.com", "sankaizok.com", "pekingduck.org", "video.aol.co.uk", "chinasoul.org", "kwongwah.com.my", "blinw.com", "atj.org.tw", "aenhancers.com", "ytimg.com", "showbiz.omy.sg", "freevpn.nl", "windowsphoneme.com", "xiaochuncnjp.com", "feeds.feedburner.com", "asana.com", "xysblogs.org", "epochtimes-bg.com", "samair.ru", "yyets.com", "taiwankiss.com", "hotpot.hk", "books.com.tw", "gyalwarinpoche.com", "bbci.co.uk", "focusvpn.com", "1024.inc.gs", "dphk.org", "nekoslovakia.net", "iridiumcao.info", "ifanr.com", "urlborg.com", "minzhuzhongguo.org", "meme.yahoo.com", "fb.me", "icij.org", "twindexx.com", "twitreferral.com", "linuxconfig.org", "cts.com.tw", "sanmin.com.tw", "bbc.com", "directcreative.com", "logmike.com", "ultraxs.com", "highrockmedia.com", "home.sina.com", "taiwannews.com.tw", "css.pixnet.in", "twa.sh", "tacem.org", "percy.in", "apigee.com", "itshidden.com", "nordstromimage.com", "mefeedia.com", "embr.in", "clb.org.hk", "chaturbate.com", "strongvpn.com", "asianwomensfilm.de", "feedblitz.com", "bugclub.org", "xvedios.com", "mpinews.com", "x365x.com", "arctosia.com", "tiantibooks.org", "abitno.linpie.com", "worstthingieverate.com", "kodingen.com", "hung-ya.com", "twyac.org", "shizhao.org", "budaedu.org", "rcinet.ca", "soh.tw", "date.fm", "threatchaos.com", "paper-replika.com", "youjizz.com", "farwestchina.com", "inxian.com", "geekmade.co.uk", "xxbbx.com", "taiwantt.org.tw", "huaren.us", "twblogger.com", "penchinese.com", "everyday-carry.com", "hotfile.com", "sitetag.us", "dscn.info", "singtao.com", "chinainperspective.org", "delicious.com", "toonel.net", "city9x.com", "ithelp.ithome.com.tw", "ufreevpn.com", "sufeng.org", "veempiire.com", "thebcomplex.com", "noypf.com", "radiovaticana.org", "blog.youxu.info", "chandoo.org", "tomayko.com", "tonyyan.net", "ucdc1998.org", "hkjc.com", "xiaoma.org", "so-ga.net", "sapikachu.net", "pacificpoker.com", "blog.sparrowmailapp.com", "unpo.org", "sitebro.tw", "blockcn.com", "prayforchina.net", "gmhz.org", "www.tv.com", "gunsamerica.com", "blog.exblog.co.jp", "www.vegorpedersen.com", "liu.lu", "twtr2src.ogaoga.org", "thefourtheye.in", "muselinks.co.jp", "lesoir.be", "travelinlocal.com", "shutterstock.com", "peacefire.org", "nzchinese.net.nz", "ixquick.com", "hongmeimei.com", "waffle1999.com", "globalvoicesonline.org", "vft.com.tw", "huluim.com", "livestation.com", "onmoon.net", "si.wsj.net", "nanzao.com", "tiananmenuniv.net", "wezone.net", "cochina.org", "astonmartinnews.com", "tor.updatestar.com", "twitoaster.com", "eslite.com", "iredmail.org", "nytco.com", "fofg.org", "qtrac.eu", "proxypy.net", "madmenunbuttoned.com", "tweepml.org", "izles.net", "cactusvpn.com", "blogger.com", "have8.com", "mychinamyhome.com", "hellotxt.com", "macys.com", "chrispederick.net", "myspace.com", "cjb.net", "andfaraway.net", "cdp2006.org", "xyy69.info", "youthnetradio.org", "xys.dxiong.com", "tapbots.com", "junauza.com", "mihk.hk", "student.tw", "wiki.cnitter.com", "chrlawyers.hk", "pinoy-n.com", "flipboard.com", "girlbanker.com", "!--isaacmao.com", "usacn.com", "privateinternetaccess.com", "blog.romanandreg.com", "hgseav.com", "advertfan.com", "goo.gl", "cdn.sstatic.net", "skydrive.live.com", "onedrive.live.com", "onedrive.com", "dropbox", "dropboxusercontent.com", "p.sfx.ms", "dowjones.com", "wsj.com", "wsj.net", "m-dot-betaspike.appspot.com", "db.tt", "shutterstock.com", "picdn.net", "wayfair.com", "wfrcdn.com", "box.com", "boxcdn.net", "instagram.com", "nicovideo.jp", "nimg.jp", "bbc.com", "bbc.co.uk", "bbci.co.uk", "android.com", "freeweibo.com", "nordstrom.com", "nordstromimage.com", "edgecastcdn.net", "yyets.com", "tmagazine.com", "lvv2.com", "wp.com", "time.com", "mailboxapp.com", "vjmedia.com.hk", "freeforums.org", "thecim.org", "wikia.com", "uncyclopedia.tw", "gravatar.com", "pao-pao.net", "hitwister.com", "google.co", "google.co.id", "proxy.googlezip.net", "googleapis.com", "video.google.com", "google.com.sg", "foursquare", "4sqi.net", "fastly.net", "googleusercontent.com", "google.com.tw", "cloudflare.com", "vox-cdn.com", "sbnation.com", "theverge.com", "amazonaws.com", "google.fr", "shadowsocks.org", "sourceforge.net", "google.ca", "apps.hloli.net", "www.eulam.com", "xiaohexie.com", "lazarsearlymusic.com", "chinesetalks.net", "bloomberg.com", "fleshbot.com", "higfw.com", "wiki.moegirl.org", "ocsp.digicert.com", "linuxreviews.org", "jieshibaobao.com", "bfsh.hk", "bfnn.org", "faiththedog.info", "googlemail.com", "picidae.net", "epochweekly.com", "free-gate.org", "freelotto.com", "himemix.com", "bbs.morbell.com", "tweetree.com", "rfachina.com", "truthcn.com", "googlesource.com", "newsancai.com", "game735.com", "caochangqing.com", "yorkbbs.ca", "liberal.org.hk", "mrtweet.com", "conoyo.com", "gamez.com.tw", "qi-gong.me", "steel-storm.com", "turntable.fm", "couchdbwiki.com", "flecheinthepeche.fr", "google-analytics.com", "martincartoons.com", "fdc89.jp", "emory.edu", "youthbao.com", "eic-av.com", "topsy.com", "kyohk.net", "pose.com", "blog.lightbox.com", "yeeyan.org", "designerol.com", "knowledgerush.com", "zdassets.com", "ebookee.com", "so-news.com", "mthruf.com", "getfoxyproxy.org", "elpais.com", "line-apps.com", "line.naver.jp", "logiqx.com", "doxygen.org", "say2.info", "deutsche-welle.de", "dabr.mobi", "catch22.net", "digitalnomadsproject.org", "www.orchidbbs.com", "pcdvd.com.tw", "compileheart.com", "hidden-advent.org", "megaporn.com", "hougaige.com", "aboutgfw.com", "comefromchina.com", "heatsale.com", "funf.tw", "worldjournal.com", "olumpo.com", "finler.net", "zoozle.net", "elementaryos.org", "vcf-online.org", "wikimedia.org.mo", "de-sci.org", "stuffimreading.net", "state168.com", "fzh999.com", "ipobar.com", "topshareware.com", "pokerstars.com", "bloomberg.cn", "qstatus.com", "contests.twilio.com", "muzi.com", "tumblr.awflasher.com", "cloudflare.com", "cdn.printfriendly.com", "posterous.com", "interfaceaddiction.com", "developers.box.net", "chinaaid.net", "blog.hotpotato.com", "freeyoutubeproxy.net", "storagenewsletter.com", "video.yahoo.com", "guishan.org", "christusrex.org", "syncback.com", "tweetboard.com", "video.ap.org", "orient-doll.com", "navicat.com", "199.59.148.20", "twittercounter.com", "git-scm.com", "martau.com", "inmediahk.net", "aliengu.com", "pulse.yahoo.com", "taweet.com", "0to255.com", "duping.net", "tkcs-collins.com", "trustedbi.com", "dtiblog.com", "myaudiocast.com", "dollf.com", "epochtimestr.com", "islamicity.com", "hellonewyork.us", "1eew.com", "taiwannation.com", "wezhiyong.org", "csuchen.de", "wahas.com", "furinkan.com", "observechina.net", "chinachannel.hk", "sysresccd.org", "ccavtop10.com", "littlebigdetails.com", "dmm.com", "dmm.co.jp", "turner.com", "voicetube.com", "tapbots.com", "ift.tt", "cdn-redfin.com", "redfin.com", "homeaway.com", "decknetwork.net", "evernote.com", "bstatic.com", "ssyoutube.com", "savefrom.net", "sfrom.net", "isoa.org", "book.com.tw", "kuwata-lab.com", "etsystatic.com", "etsy.com", "wetransfer.com", "wetransfer.net", "editmysite.com", "megavideomovies.com", "xda-developers.com", "lakeshoreplaza.com", "i4u.com", "googletagmanager.com", "pimg.tw", "backpackers.com.tw", "bbkz.net", "phncdn.com", "theqingyun.me", "tunein.com", "gist.github.com", "viber.com", "telegram.me", "telegram.org", "tumblr.com", "economist.com", "static-economist.com", "gscontxt.net", "twitter.com", "chinese-memorial.org", "canyu.org", "worldnews01.com", "jgoodies.com", "china-week.com", "pureconcepts.net", "br.st", "yfrog.com", "mingpao.com", "crd-net.org", "steampowered.com", "ydy.com", "tibet.com", "tor.blingblingsquad.net", "naitik.net", "cuihua.org", "gs-discuss.com", "googleearth.com", "diaoyuislands.org", "wozy.in", "myopenid.com", "hkdailynews.com.hk", "glennhilton.com", "christianstudy.com", "freenetproject.org", "ebookbrowse.com", "tweetedtimes.com", "gaoming.net", "dns2go.com", "laptoplockdown.com", "qienkuen.org", "fangeming.com", "vmixcore.com", "e-traderland.net", "twtrland.com", "topic.youthwant.com.tw", "askstudent.com", "forum.palmislife.com", "yipub.com", "truveo.com", "tabtter.jp", "franklc.com", "weigegebyc.dreamhosters.com", "iblogserv-f.net", "kanzhongguo.eu", "page.bid.yahoo.com", "bb.ttv.com.tw", "blog.xuite.net", "freewebs.com", "edicypages.com", "famunion.com", "173ng.com", "forum.mymaji.com", "minghui.org", "isaacmao.com", "perfectvpn.net", "freeman2.com", "sinonet.ca", "jpopforum.net", "sobees.com", "free4u.com.ar", "bill.zhong.pp.ru", "oyax.com", "fourface.nodesnoop.com", "mobypicture.com", "sharkdolphin.com", "limiao.net", "jimoparty.com", "icu-project.org", "njactb.org", "twiggit.org", "openwebster.com", "earthquake.usgs.gov", "darpa.mil", "gmozomg.izihost.org", "wtfpeople.com", "1-apple.com.tw"];
-    for (i = 0; i < a.length; i++) {
-        if (dnsDomainIs(h, a[i])) {
-            return "__PROXY__"
+    return m && m[1].toLowerCase() === "https" ? 443 : 80
+}
+
+function FindProxyForURL(u, h) {
+    h = h.toLowerCase();
+    var isIPv4 = /^\d+\.\d+\.\d+\.\d+$/.test(h);
+    var isIP = isIPv4 || h.indexOf(":") !== -1;
+    var port = urlPort(u);
+    var i, r;
+    for (i = 0; i < rules.length; i++) {
+        r = rules[i];
+        switch (r.t) {
+        case "DOMAIN":
+            if (!isIP && h === r.v[0]) {
+                return r.a
+            }
+            break;
+        case "DOMAIN-SUFFIX":
+            if (!isIP && inSuffixes(h, r.s)) {
+                return r.a
+            }
+            break;
+        case "DOMAIN-KEYWORD":
+            if (!isIP && h.indexOf(r.v[0]) !== -1) {
+                return r.a
+            }
+            break;
+        case "DOMAIN-REGEX":
+            if (!isIP && r.re && r.re.test(h)) {
+                return r.a
+            }
+            break;
+        case "IP-CIDR":
+            // isInNet would resolve names, only literal addresses are matched
+            if (isIPv4 && isInNet(h, r.v[0], r.m)) {
+                return r.a
+            }
+            break;
+        case "DST-PORT":
+            if (port >= parseInt(r.v[0], 10) && port <= parseInt(r.v[1], 10)) {
+                return r.a
+            }
+            break;
+        case "MATCH":
+            return r.a
         }
     }
-    return "DIRECT"
+    return "__PROXY__"
 }
@@ -71,7 +71,7 @@ angular
   })
   .controller('SettingsCtrl', ['$scope', '$http', function($scope, $http) {
     $scope.config = {};
-    $scope.shadowsocks = [];
+    $scope.tunnels = [];
     function reqSS(url, method, data, errDom){
         var params = {
             method: method,
@@ -88,7 +88,7 @@ angular
             function(res){
                 console.info(res.data)
                 if (res.data.ok) {
-                    $scope.shadowsocks = res.data.data;
+                    $scope.tunnels = res.data.data;
                 } else if (errDom) {
                     errDom.innerText = res.data.message;
                     errDom.className = errDom.className.split('hide').join(' ')
@@ -97,32 +97,140 @@ angular
             function(res){}
         );
     }
-    reqSS(apiUrl + '/shadowsocks', "GET")
+    reqSS(apiUrl + '/tunnels', "GET")
+    $scope.probes = [];
+    $http({
+        method: "GET",
+        url: apiUrl + "/probes"
+    }).then(
+        function(res){
+            if (res.data.ok) {
+                $scope.probes = res.data.data;
+            }
+        },
+        function(res){}
+    );
+    $scope.imported = [];
+    $scope.importStatus = {added: '已导入', duplicate: '已存在', invalid: '无效'};
+    $scope.importTunnels = function(){
+        var ipt = document.getElementsByName('import_data')[0]
+        $http({
+            method: "POST",
+            url: apiUrl + "/import",
+            data: {data: ipt.value},
+            transformRequest: transformReq,
+            headers: {'Content-Type': 'application/x-www-form-urlencoded'}
+        }).then(
+            function(res){
+                $scope.imported = res.data.data || [];
+                $scope.importError = res.data.message;
+                if (res.data.ok) {
+                    ipt.value = "";
+                }
+                reqSS(apiUrl + '/tunnels', "GET")
+            },
+            function(res){}
+        );
+    }
+    $scope.exportRedact = false;
+    $scope.exportUrl = function(format){
+        return apiUrl + '/export?format=' + format + ($scope.exportRedact ? '&redact=on' : '');
+    }
+    $scope.subscriptions = [];
+    function reqSub(url, method, data, errDom){
+        var params = {
+            method: method,
+            url: url,
+        }
+        if (data) {
+            params.data = data;
+            params.transformRequest = transformReq;
+            params.headers = {'Content-Type': 'application/x-www-form-urlencoded'}
+        }
+        return $http(params).then(
+            function(res){
+                $scope.subscriptions = res.data.data;
+                if (!res.data.ok && errDom) {
+                    errDom.innerText = res.data.message;
+                    errDom.className = errDom.className.split('hide').join(' ')
+                }
+            },
+            function(res){}
+        );
+    }
+    reqSub(apiUrl + '/subscriptions', "GET")
+    $scope.subAction = {
+        add: function($event){
+            var tr = ($event.currentTarget || $event.srcElement).closest('tr')
+            var ipt = tr.querySelectorAll('input')[0];
+            var errE = tr.querySelectorAll('.error')[0];
+            errE.innerText = "";
+            reqSub(apiUrl + '/subscriptions', "POST", {url: ipt.value}, errE).then(function(res){
+                if (!errE.innerText) {
+                    ipt.value = "";
+                }
+            })
+        },
+        refresh: function(){
+            reqSub(apiUrl + '/subscriptions', "PUT")
+        },
+        delete: function(url){
+            reqSub(apiUrl + '/subscriptions?url='+encodeURIComponent(url), "DELETE")
+        }
+    }
+    $scope.qrUrl = function(ss){
+        return apiUrl + '/qrcode?ss=' + encodeURIComponent(ss);
+    }
     $scope.ssAction = {
+        scan: function(ipt){
+            var errE = ipt.closest('tr').querySelectorAll('.error')[0];
+            var fd = new FormData();
+            fd.append('file', ipt.files[0]);
+            ipt.value = "";
+            errE.innerText = "";
+            $http({
+                method: "POST",
+                url: apiUrl + "/qrcode",
+                data: fd,
+                transformRequest: angular.identity,
+                headers: {'Content-Type': undefined}
+            }).then(
+                function(res){
+                    reqSS(apiUrl + '/tunnels', "GET")
+                    if (!res.data.ok) {
+                        errE.innerText = res.data.message;
+                        errE.className = errE.className.split('hide').join(' ')
+                    }
+                },
+                function(res){}
+            );
+        },
         add: function($event){
             var tr = ($event.currentTarget || $event.srcElement).closest('tr')
             var ipt = tr.querySelectorAll('input')[0];
             var errE = tr.querySelectorAll('.error')[0];
-            var nss = ipt.value;
-            reqSS(apiUrl + '/shadowsocks', "POST", {ss: nss}, errE).then(function(res){
+            reqSS(apiUrl + '/tunnels', "POST", {uri: ipt.value}, errE).then(function(res){
                 if (!errE.innerText) {
                     ipt.value = "";
                 }
             })
         },
-        save: function($event, ss){
+        save: function($event, tunnel){
             var elem = $event.currentTarget || $event.srcElement
-            var nss = elem.closest('tr').querySelectorAll('input')[0].value;
             var tr = elem.closest('tr');
+            var ipts = tr.querySelectorAll('.edit input');
             var errE = tr.querySelectorAll('.error')[0];
-            console.info("new ss is", nss)
-            if (nss==ss) {
-                return this.cancel($event);
-            }
-            reqSS(apiUrl + '/shadowsocks?ss='+encodeURIComponent(ss), "PUT", {ss: nss}, errE)
+            var params = {name: ipts[0].value, uri: ipts[1].value, remarks: ipts[2].value};
+            reqSS(apiUrl + '/tunnels/' + tunnel.id, "PUT", params, errE)
+        },
+        enable: function(tunnel){
+            reqSS(apiUrl + '/tunnels/' + tunnel.id, "PUT", {enabled: tunnel.enabled ? 'off' : 'on'})
+        },
+        move: function(tunnel, step){
+            reqSS(apiUrl + '/tunnels/' + tunnel.id, "PUT", {order: tunnel.order + step})
         },
-        delete: function(ss){
-            reqSS(apiUrl + '/shadowsocks?ss='+encodeURIComponent(ss), "DELETE")
+        delete: function(tunnel){
+            reqSS(apiUrl + '/tunnels/' + tunnel.id, "DELETE")
         },
         cancel: function($event){
             var elem = $event.currentTarget || $event.srcElement
@@ -162,6 +270,31 @@ angular
         var dd = document.getElementsByName('diy_domains')[0]
         set("diy_domains", dd.value)
     }
+    $scope.setRules = function(){
+        var rules = document.getElementsByName('rules')[0]
+        $http({
+            method: "POST",
+            url: apiUrl + "/set",
+            data: {name: "rules", value: rules.value},
+            transformRequest: transformReq,
+            headers: {'Content-Type': 'application/x-www-form-urlencoded'}
+        }).then(
+            function(res){
+                $scope.rulesError = res.data.ok ? "" : res.data.message;
+                if (res.data.data) {
+                    $scope.config = res.data.data;
+                }
+            },
+            function(res){}
+        )
+    }
+    $scope.setValue = function(name){
+        var ipt = document.getElementsByName(name)[0]
+        set(name, ipt.value)
+    }
+    $scope.setPolicy = function(){
+        set("lb_policy", $scope.config.lb_policy)
+    }
     $scope.toggle = function(name){
         var ele = document.getElementsByName(name)[0]
         var value = ele.checked?'on':'off';
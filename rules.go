@@ -0,0 +1,328 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Routing rules decide whether a connection goes through the shadowsocks
+// servers, straight to its destination or nowhere. The socks and http
+// proxies follow them, and the pac served to browsers is made of the same
+// rules. A rule is a line of TYPE,VALUE,ACTION as in clash:
+//
+//	DOMAIN,example.com,direct
+//	DOMAIN-SUFFIX,google.com,proxy
+//	DOMAIN-KEYWORD,facebook,proxy
+//	DOMAIN-REGEX,^ads?\.,reject
+//	IP-CIDR,10.0.0.0/8,direct
+//	DST-PORT,6000-6100,reject
+//	MATCH,proxy
+//
+// The first rule that matches wins. Domain rules see the name the client
+// asked for and ip rules literal addresses only, nothing is resolved.
+
+const (
+	ruleProxy  = "proxy"
+	ruleDirect = "direct"
+	ruleReject = "reject"
+
+	directDialTimeout = 10 * time.Second
+)
+
+var (
+	errRuleReject = errors.New("connection rejected by routing rules")
+	errRuleValue  = errors.New("invalid rule value")
+)
+
+// localRules keep loopback and private networks off the tunnels, unless the
+// user's rules say otherwise.
+var localRules = []string{
+	"DOMAIN,localhost,direct",
+	"IP-CIDR,127.0.0.0/8,direct",
+	"IP-CIDR,10.0.0.0/8,direct",
+	"IP-CIDR,172.16.0.0/12,direct",
+	"IP-CIDR,192.168.0.0/16,direct",
+	"IP-CIDR,169.254.0.0/16,direct",
+	"IP-CIDR,::1/128,direct",
+	"IP-CIDR,fc00::/7,direct",
+	"IP-CIDR,fe80::/10,direct",
+}
+
+type Rule struct {
+	Type   string `json:"type"`
+	Value  string `json:"value"`
+	Action string `json:"action"`
+
+	re       *regexp.Regexp
+	ipnet    *net.IPNet
+	minPort  int
+	maxPort  int
+	suffixes map[string]bool // DOMAIN-SUFFIX, a run of them after compactRules
+}
+
+func parseRule(line string) (*Rule, error) {
+	fields := strings.Split(line, ",")
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	r := &Rule{Type: strings.ToUpper(fields[0])}
+	if r.Type == "MATCH" && len(fields) == 2 {
+		r.Action = strings.ToLower(fields[1])
+	} else if len(fields) == 3 && r.Type != "MATCH" {
+		r.Value, r.Action = fields[1], strings.ToLower(fields[2])
+	} else {
+		return nil, fmt.Errorf("无效的规则: %s", line)
+	}
+	if r.Action != ruleProxy && r.Action != ruleDirect && r.Action != ruleReject {
+		return nil, fmt.Errorf("规则的动作须为proxy, direct或reject: %s", line)
+	}
+	var err error
+	switch r.Type {
+	case "DOMAIN", "DOMAIN-SUFFIX", "DOMAIN-KEYWORD":
+		r.Value = strings.ToLower(strings.TrimPrefix(r.Value, "."))
+		if r.Value == "" {
+			err = errRuleValue
+		}
+		if r.Type == "DOMAIN-SUFFIX" {
+			r.suffixes = map[string]bool{r.Value: true}
+		}
+	case "DOMAIN-REGEX":
+		r.re, err = regexp.Compile(r.Value)
+	case "IP-CIDR", "IP-CIDR6":
+		r.Type = "IP-CIDR"
+		_, r.ipnet, err = net.ParseCIDR(r.Value)
+	case "DST-PORT":
+		lo, hi := r.Value, r.Value
+		if i := strings.IndexByte(r.Value, '-'); i >= 0 {
+			lo, hi = r.Value[:i], r.Value[i+1:]
+		}
+		if r.minPort, err = strconv.Atoi(lo); err == nil {
+			r.maxPort, err = strconv.Atoi(hi)
+		}
+		if err == nil && (r.minPort <= 0 || r.maxPort > 65535 || r.minPort > r.maxPort) {
+			err = errRuleValue
+		}
+	case "MATCH":
+	default:
+		return nil, fmt.Errorf("不支持的规则类型: %s", line)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("无效的规则: %s", line)
+	}
+	return r, nil
+}
+
+// parseRules reads rules one per line, blank lines and lines starting with #
+// are skipped.
+func parseRules(text string) ([]*Rule, error) {
+	var rules []*Rule
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		r, err := parseRule(line)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// diyDomainRule turns an entry of the custom domain list into a proxy rule,
+// entries were pac shell expressions so *.example.com is a suffix too.
+func diyDomainRule(entry string) string {
+	if _, _, err := net.ParseCIDR(entry); err == nil {
+		return "IP-CIDR," + entry + ",proxy"
+	}
+	if ip := net.ParseIP(entry); ip != nil {
+		if ip.To4() != nil {
+			return "IP-CIDR," + entry + "/32,proxy"
+		}
+		return "IP-CIDR," + entry + "/128,proxy"
+	}
+	entry = strings.TrimPrefix(entry, "*.")
+	if strings.Contains(entry, "*") {
+		re := strings.Replace(regexp.QuoteMeta(entry), `\*`, ".*", -1)
+		return "DOMAIN-REGEX,^" + re + "$,proxy"
+	}
+	return "DOMAIN-SUFFIX," + entry + ",proxy"
+}
+
+// configRules puts the rules of config in order: the user's rules, the
+// local networks, everything when global, the custom domains and the
+// built-in lists. User rules that do not parse are left out.
+func configRules(config *Config) []*Rule {
+	rules, err := parseRules(config.Get("rules"))
+	if err != nil {
+		log.Printf("Skip user rules: %v", err)
+	}
+	local, _ := parseRules(strings.Join(localRules, "\n"))
+	rules = append(rules, local...)
+	if config.Get("is_global") == "on" {
+		rules = append(rules, &Rule{Type: "MATCH", Action: ruleProxy})
+	}
+	var diy []string
+	for _, d := range strings.Split(config.Get("diy_domains"), ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			diy = append(diy, diyDomainRule(d))
+		}
+	}
+	if custom, err := parseRules(strings.Join(diy, "\n")); err == nil {
+		rules = append(rules, custom...)
+	} else {
+		log.Printf("Skip custom domains: %v", err)
+	}
+	builtin, err := parseRules(string(GetRes("rules.txt")))
+	if err != nil {
+		log.Printf("Skip built-in rules: %v", err)
+	}
+	return append(rules, builtin...)
+}
+
+// compactRules merges runs of DOMAIN-SUFFIX rules with the same action into
+// one looked up by map, the built-in lists are thousands of them.
+func compactRules(rules []*Rule) []*Rule {
+	var compact []*Rule
+	for _, r := range rules {
+		if r.Type != "DOMAIN-SUFFIX" {
+			compact = append(compact, r)
+			continue
+		}
+		last := len(compact) - 1
+		if last < 0 || compact[last].Type != r.Type || compact[last].Action != r.Action {
+			compact = append(compact, &Rule{Type: r.Type, Action: r.Action, suffixes: map[string]bool{}})
+			last++
+		}
+		compact[last].suffixes[r.Value] = true
+	}
+	return compact
+}
+
+func (r *Rule) match(host string, ip net.IP, port int) bool {
+	switch r.Type {
+	case "DOMAIN":
+		return ip == nil && host == r.Value
+	case "DOMAIN-SUFFIX":
+		if ip != nil {
+			return false
+		}
+		for h := host; ; {
+			if r.suffixes[h] {
+				return true
+			}
+			i := strings.IndexByte(h, '.')
+			if i < 0 {
+				return false
+			}
+			h = h[i+1:]
+		}
+	case "DOMAIN-KEYWORD":
+		return ip == nil && strings.Contains(host, r.Value)
+	case "DOMAIN-REGEX":
+		return ip == nil && r.re.MatchString(host)
+	case "IP-CIDR":
+		return ip != nil && r.ipnet.Contains(ip)
+	case "DST-PORT":
+		return port >= r.minPort && port <= r.maxPort
+	}
+	return r.Type == "MATCH"
+}
+
+var currentRules atomic.Value // []*Rule
+
+func init() {
+	currentRules.Store([]*Rule{})
+}
+
+// SetRules makes the socks and http proxies follow the rules of config.
+func SetRules(config *Config) {
+	rules := configRules(config)
+	currentRules.Store(compactRules(rules))
+	log.Printf("%d routing rules", len(rules))
+}
+
+// matchRules returns the action of the first rule that matches host and
+// port, connections no rule matches are proxied.
+func matchRules(rules []*Rule, host string, port int) string {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	ip := net.ParseIP(host)
+	for _, r := range rules {
+		if r.match(host, ip, port) {
+			return r.Action
+		}
+	}
+	return ruleProxy
+}
+
+// routeAddr returns what to do with a connection to addr, a host:port.
+func routeAddr(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return ruleProxy
+	}
+	p, _ := strconv.Atoi(port)
+	return matchRules(currentRules.Load().([]*Rule), host, p)
+}
+
+// rawAddrString turns a socks style raw address into host:port.
+func rawAddrString(rawaddr []byte) string {
+	if len(rawaddr) < 2 {
+		return ""
+	}
+	port := int(rawaddr[len(rawaddr)-2])<<8 | int(rawaddr[len(rawaddr)-1])
+	return net.JoinHostPort(rawAddrHost(rawaddr), strconv.Itoa(port))
+}
+
+// pacRule is a rule as the pac evaluates it, with runs of DOMAIN-SUFFIX
+// rules in one object the pac looks the parent domains of a host up in.
+type pacRule struct {
+	Type     string          `json:"t"`
+	Values   []string        `json:"v,omitempty"`
+	Suffixes map[string]bool `json:"s,omitempty"`
+	Mask     string          `json:"m,omitempty"` // IPv4 netmask for isInNet
+	Action   string          `json:"a"`
+}
+
+// pacRules renders rules for the pac, proxy is what the pac returns for
+// proxied connections. Rejected ones go to the proxy as well, which then
+// refuses them.
+func pacRules(rules []*Rule, proxy string) []*pacRule {
+	var out []*pacRule
+	for _, r := range rules {
+		action := proxy
+		if r.Action == ruleDirect {
+			action = "DIRECT"
+		}
+		p := &pacRule{Type: r.Type, Values: []string{r.Value}, Action: action}
+		switch r.Type {
+		case "DOMAIN-SUFFIX":
+			if last := len(out) - 1; last >= 0 && out[last].Type == r.Type && out[last].Action == action {
+				out[last].Suffixes[r.Value] = true
+				continue
+			}
+			p.Values = nil
+			p.Suffixes = map[string]bool{r.Value: true}
+		case "IP-CIDR":
+			if r.ipnet.IP.To4() == nil {
+				continue // isInNet is IPv4 only
+			}
+			p.Values = []string{r.ipnet.IP.String()}
+			p.Mask = net.IP(r.ipnet.Mask).String()
+		case "DST-PORT":
+			p.Values = []string{strconv.Itoa(r.minPort), strconv.Itoa(r.maxPort)}
+		case "MATCH":
+			p.Values = nil
+		}
+		out = append(out, p)
+	}
+	return out
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseRules(t *testing.T) {
+	for _, line := range []string{
+		"DOMAIN,example.com",
+		"DOMAIN-SUFFIX,example.com,tunnel",
+		"GEOIP,CN,direct",
+		"DOMAIN-REGEX,(,proxy",
+		"IP-CIDR,10.0.0.0/33,direct",
+		"DST-PORT,9000-8000,reject",
+		"DOMAIN-SUFFIX,,proxy",
+	} {
+		if _, err := parseRule(line); err == nil {
+			t.Errorf("%s accepted", line)
+		}
+	}
+	rules, err := parseRules("# comment\n\n domain-suffix, Example.COM , PROXY \nMATCH,direct")
+	if err != nil || len(rules) != 2 || rules[0].Type != "DOMAIN-SUFFIX" || rules[0].Value != "example.com" || rules[0].Action != ruleProxy {
+		t.Errorf("got %v, %v", rules, err)
+	}
+}
+
+func TestMatchRules(t *testing.T) {
+	rules, err := parseRules(`DOMAIN,exact.example.com,reject
+DOMAIN-SUFFIX,example.com,direct
+DOMAIN-SUFFIX,example.org,direct
+DOMAIN-KEYWORD,google,proxy
+DOMAIN-REGEX,^ads?\.,reject
+IP-CIDR,10.0.0.0/8,direct
+IP-CIDR,2001:db8::/32,reject
+DST-PORT,25,reject
+DST-PORT,6000-6100,direct`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules = compactRules(rules)
+	if len(rules) != 8 {
+		t.Errorf("suffix rules not merged: %d rules", len(rules))
+	}
+	tests := []struct {
+		host   string
+		port   int
+		action string
+	}{
+		{"exact.example.com", 443, ruleReject},
+		{"www.Example.com.", 443, ruleDirect},
+		{"example.org", 443, ruleDirect},
+		{"notexample.org", 443, ruleProxy},
+		{"www.google.co.jp", 443, ruleProxy},
+		{"ad.example.net", 443, ruleReject},
+		{"10.1.2.3", 443, ruleDirect},
+		{"2001:db8::1", 443, ruleReject},
+		{"mail.example.net", 25, ruleReject},
+		{"x11.example.net", 6010, ruleDirect},
+		{"11.1.2.3", 80, ruleProxy},
+	}
+	for _, test := range tests {
+		if action := matchRules(rules, test.host, test.port); action != test.action {
+			t.Errorf("%s:%d got %s, want %s", test.host, test.port, action, test.action)
+		}
+	}
+}
+
+func TestConfigRules(t *testing.T) {
+	config := &Config{Config: map[string]string{
+		"rules":       "DOMAIN-SUFFIX,intranet.example.com,direct",
+		"is_global":   "on",
+		"diy_domains": "*.youtube.com, foo*.example.net, 12.12.12.12",
+	}}
+	rules := compactRules(configRules(config))
+	if action := matchRules(rules, "wiki.intranet.example.com", 443); action != ruleDirect {
+		t.Errorf("user rule lost to global mode: %s", action)
+	}
+	if action := matchRules(rules, "192.168.1.1", 80); action != ruleDirect {
+		t.Errorf("local network proxied: %s", action)
+	}
+	if action := matchRules(rules, "example.cn", 80); action != ruleProxy {
+		t.Errorf("global mode not proxying: %s", action)
+	}
+
+	delete(config.Config, "is_global")
+	rules = compactRules(configRules(config))
+	for _, host := range []string{"www.youtube.com", "foobar.example.net", "12.12.12.12"} {
+		if action := matchRules(rules, host, 443); action != ruleProxy {
+			t.Errorf("custom domain %s not proxied: %s", host, action)
+		}
+	}
+
+	delete(config.Config, "rules")
+	pac := pacRules(configRules(config), "SOCKS5 127.0.0.1:1271")
+	if pac[1].Type != "IP-CIDR" || pac[1].Values[0] != "127.0.0.0" || pac[1].Mask != "255.0.0.0" || pac[1].Action != "DIRECT" {
+		t.Errorf("unexpected pac rule %+v", pac[1])
+	}
+	suffixes, youtube := 0, false
+	for _, r := range pac {
+		if r.Type == "IP-CIDR" && strings.Contains(r.Values[0], ":") {
+			t.Errorf("IPv6 rule in pac %+v", r)
+		}
+		if r.Type == "DOMAIN-SUFFIX" {
+			if r.Values != nil {
+				t.Errorf("suffixes of pac rule in a list %+v", r)
+			}
+			suffixes += len(r.Suffixes)
+			youtube = youtube || r.Suffixes["youtube.com"]
+		}
+	}
+	if !youtube || suffixes < 100 {
+		t.Errorf("suffixes missing in the pac, %d of them", suffixes)
+	}
+}
+
+func TestBuiltinRules(t *testing.T) {
+	rules, err := parseRules(string(GetRes("rules.txt")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules = compactRules(rules)
+	for host, want := range map[string]string{
+		"www.google.co.uk":         ruleProxy,
+		"google.ru":                ruleProxy,
+		"www.google.com.hk":        ruleProxy,
+		"blogspot.jp":              ruleProxy,
+		"www.rdio.com":             ruleProxy,
+		"www.tudou.com":            ruleDirect,
+		"www.baidu.com":            ruleDirect,
+		"iosapps.itunes.apple.com": ruleDirect,
+	} {
+		if action := matchRules(rules, host, 443); action != want {
+			t.Errorf("%s: got %s, want %s", host, action, want)
+		}
+	}
+}
+
+func TestServedPac(t *testing.T) {
+	defer tempStorage(t)()
+	if len(GetRes("rules.txt")) == 0 {
+		t.Fatal("no built-in rules in bindata")
+	}
+	w := httptest.NewRecorder()
+	getPac(w, httptest.NewRequest("GET", "/pac", nil))
+	pac := w.Body.String()
+	if !strings.Contains(pac, "FindProxyForURL") || strings.Contains(pac, "__") {
+		t.Errorf("pac left with placeholders:\n%s", pac)
+	}
+}
+
+func TestSocksFollowsRules(t *testing.T) {
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer echo.Close()
+	go func() {
+		conn, err := echo.Accept()
+		if err == nil {
+			io.Copy(conn, conn)
+			conn.Close()
+		}
+	}()
+	target := echo.Addr().(*net.TCPAddr)
+	request := append([]byte{socksVer5, socksCmdConnect, 0, socksAddrIPv4}, target.IP.To4()...)
+	request = append(request, byte(target.Port>>8), byte(target.Port))
+
+	connect := func() (net.Conn, []byte) {
+		client, server := net.Pipe()
+		go handleConnection(server, TrafficCounter)
+		client.Write([]byte{socksVer5, 1, socksMethodNoAuth})
+		reply := make([]byte, 2)
+		io.ReadFull(client, reply)
+		client.Write(request)
+		reply = make([]byte, 10)
+		io.ReadFull(client, reply)
+		return client, reply
+	}
+
+	// loopback goes direct, without any server
+	SetRules(&Config{})
+	client, reply := connect()
+	if reply[1] != socksRepSucceeded {
+		t.Fatalf("direct connection failed: %v", reply)
+	}
+	client.Write([]byte("hello"))
+	got := make([]byte, 5)
+	if _, err = io.ReadFull(client, got); err != nil || !bytes.Equal(got, []byte("hello")) {
+		t.Errorf("no echo over direct connection: %q %v", got, err)
+	}
+	client.Close()
+
+	SetRules(&Config{Config: map[string]string{"rules": "DST-PORT," + strconv.Itoa(target.Port) + ",reject"}})
+	defer SetRules(&Config{})
+	client, reply = connect()
+	if reply[1] != socksRepNotAllowed {
+		t.Errorf("rejected connection got reply %v", reply)
+	}
+	client.Close()
+	if _, err = dialForHttp("tcp", target.String()); err != errRuleReject {
+		t.Errorf("http proxy dialed a rejected address: %v", err)
+	}
+}
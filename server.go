@@ -0,0 +1,238 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	ss "github.com/dawei101/shadowsocks-go/shadowsocks"
+)
+
+// A shadowsocks server, for relays run with `tongshe server -c config.json`
+// and for tests that need a server in process. The config is the one of
+// shadowsocks-go: one server_port and password, or port_password for several
+// ports, with one method for all of them.
+
+const serverDialTimeout = 10 * time.Second
+
+var (
+	errServerConfig = errors.New("服务端配置中没有端口")
+	errServerMethod = errors.New("服务端不支持该加密方式")
+)
+
+type serverConfig struct {
+	Server       string            `json:"server"`
+	ServerPort   int               `json:"server_port"`
+	Password     string            `json:"password"`
+	Method       string            `json:"method"`
+	PortPassword map[string]string `json:"port_password"`
+}
+
+func loadServerConfig(file string) (*serverConfig, error) {
+	bt, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	config := &serverConfig{}
+	if err = json.Unmarshal(bt, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// serverPort is one listening port with its cipher.
+type serverPort struct {
+	listener net.Listener
+	cipher   *ss.Cipher  // stream ciphers
+	aead     *aeadCipher // AEAD ciphers, cipher is nil then
+	traffic  *TrafficListener
+}
+
+func (p *serverPort) wrap(conn net.Conn) net.Conn {
+	if p.aead != nil {
+		conn = newAEADConn(conn, p.aead)
+	} else {
+		conn = ss.NewConn(conn, p.cipher.Copy())
+	}
+	return &trafficConn{Conn: conn, TrafficListener: p.traffic}
+}
+
+type Server struct {
+	mu     sync.Mutex
+	ports  []*serverPort
+	closed bool
+}
+
+// NewServer listens on the ports of config, the connections are served once
+// Serve is called.
+func NewServer(config *serverConfig) (*Server, error) {
+	passwords := config.PortPassword
+	if len(passwords) == 0 && config.ServerPort > 0 {
+		passwords = map[string]string{strconv.Itoa(config.ServerPort): config.Password}
+	}
+	if len(passwords) == 0 {
+		return nil, errServerConfig
+	}
+	method := strings.ToLower(config.Method)
+	// 2022 servers check headers the client side does not have, one time
+	// auth needs the shadowsocks package's own server
+	if isSS2022Method(method) || strings.HasSuffix(method, "-auth") {
+		return nil, errServerMethod
+	}
+	s := &Server{}
+	for port, password := range passwords {
+		p := &serverPort{traffic: TrafficCounter}
+		var err error
+		if isAEADMethod(method) {
+			p.aead, err = newAEADCipher(method, password)
+		} else {
+			p.cipher, err = ss.NewCipher(method, password)
+		}
+		if err != nil {
+			s.Close()
+			return nil, fmt.Errorf("无法为端口%s生成加密: %v", port, err)
+		}
+		if p.listener, err = net.Listen("tcp", net.JoinHostPort(config.Server, port)); err != nil {
+			s.Close()
+			return nil, err
+		}
+		s.ports = append(s.ports, p)
+	}
+	return s, nil
+}
+
+// Addrs returns the addresses the server listens on.
+func (s *Server) Addrs() []string {
+	addrs := make([]string, len(s.ports))
+	for i, p := range s.ports {
+		addrs[i] = p.listener.Addr().String()
+	}
+	return addrs
+}
+
+// Serve relays the connections of all ports until the server is closed.
+func (s *Server) Serve() {
+	var wg sync.WaitGroup
+	for _, p := range s.ports {
+		wg.Add(1)
+		go func(p *serverPort) {
+			defer wg.Done()
+			for {
+				conn, err := p.listener.Accept()
+				if err != nil {
+					if !s.isClosed() {
+						log.Printf("accept on %s: %v", p.listener.Addr(), err)
+					}
+					return
+				}
+				go handleServerConnection(p.wrap(conn))
+			}
+		}(p)
+	}
+	wg.Wait()
+}
+
+func (s *Server) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+// Close stops listening, relayed connections keep running.
+func (s *Server) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+	for _, p := range s.ports {
+		p.listener.Close()
+	}
+}
+
+// readRawAddr reads the socks style address a client sends first.
+func readRawAddr(conn net.Conn) ([]byte, error) {
+	buf := make([]byte, 1+1+255+2)
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		return nil, err
+	}
+	var addrLen int
+	switch buf[0] {
+	case socksAddrIPv4:
+		addrLen = 1 + net.IPv4len + 2
+	case socksAddrIPv6:
+		addrLen = 1 + net.IPv6len + 2
+	case socksAddrDomain:
+		addrLen = 1 + 1 + int(buf[1]) + 2
+	default:
+		return nil, errAddrType
+	}
+	if _, err := io.ReadFull(conn, buf[2:addrLen]); err != nil {
+		return nil, err
+	}
+	return buf[:addrLen], nil
+}
+
+func handleServerConnection(conn net.Conn) {
+	closed := false
+	defer func() {
+		if !closed {
+			conn.Close()
+		}
+	}()
+	ss.SetReadTimeout(conn)
+	rawaddr, err := readRawAddr(conn)
+	if err != nil {
+		log.Printf("error getting request from %s: %v", conn.RemoteAddr(), err)
+		return
+	}
+	port := binary.BigEndian.Uint16(rawaddr[len(rawaddr)-2:])
+	host := net.JoinHostPort(rawAddrHost(rawaddr), strconv.Itoa(int(port)))
+	if debug {
+		log.Printf("server connecting to %s\n", host)
+	}
+	remote, err := net.DialTimeout("tcp", host, serverDialTimeout)
+	if err != nil {
+		log.Printf("error connecting to %s: %v", host, err)
+		return
+	}
+	go ss.PipeThenClose(conn, remote)
+	ss.PipeThenClose(remote, conn)
+	closed = true
+}
+
+// serverCommand runs a server with the config in file until it is killed.
+func serverCommand(file string) int {
+	config, err := loadServerConfig(file)
+	if err != nil {
+		log.Printf("Could not read %s: %v", file, err)
+		return 1
+	}
+	s, err := NewServer(config)
+	if err != nil {
+		log.Printf("Could not start server: %v", err)
+		return 1
+	}
+	log.Printf("Shadowsocks server listening on %s", strings.Join(s.Addrs(), ", "))
+	// the relayed traffic is saved like the client's, and once more on exit
+	go TrafficCounter.StartSync()
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-stop
+		TrafficCounter.Sync()
+		s.Close()
+	}()
+	s.Serve()
+	return 0
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+)
+
+func TestServerRelay(t *testing.T) {
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer echo.Close()
+	go func() {
+		for {
+			conn, err := echo.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+
+	s, err := NewServer(&serverConfig{
+		Server:       "127.0.0.1",
+		Method:       "aes-256-gcm",
+		PortPassword: map[string]string{"0": "secret"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	go s.Serve()
+	host, port, _ := net.SplitHostPort(s.Addrs()[0])
+	if err = SetTunnels([]*SSTunnel{{Ip: host, Port: port, Password: "secret", Method: "aes-256-gcm"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	target := echo.Addr().(*net.TCPAddr)
+	rawaddr := append([]byte{socksAddrIPv4}, target.IP.To4()...)
+	rawaddr = append(rawaddr, byte(target.Port>>8), byte(target.Port))
+	in := atomic.LoadInt64(&TrafficCounter.in)
+	servers.RLock()
+	remote, err := createServerConn(rawaddr, target.String())
+	servers.RUnlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer remote.Close()
+	payload := bytes.Repeat([]byte("relay"), 10000)
+	go remote.Write(payload)
+	got := make([]byte, len(payload))
+	if _, err = io.ReadFull(remote, got); err != nil {
+		t.Fatalf("read echo: %v", err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Error("echo does not match")
+	}
+	if atomic.LoadInt64(&TrafficCounter.in) < in+int64(len(payload)) {
+		t.Error("server traffic not counted")
+	}
+}
+
+func TestNewServerRejects(t *testing.T) {
+	if _, err := NewServer(&serverConfig{Method: "aes-256-gcm"}); err != errServerConfig {
+		t.Errorf("server without ports: %v", err)
+	}
+	config := &serverConfig{Server: "127.0.0.1", ServerPort: 8388, Password: "secret", Method: "2022-blake3-aes-128-gcm"}
+	if _, err := NewServer(config); err != errServerMethod {
+		t.Errorf("2022 method: %v", err)
+	}
+}
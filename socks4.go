@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+)
+
+const (
+	socksVer4         = 4
+	socks4RepGranted  = 90
+	socks4RepRejected = 91
+)
+
+// getRequest4 reads a SOCKS4 or SOCKS4a CONNECT request and turns the
+// destination into the same rawaddr shape getRequest returns for SOCKS5.
+//
+//	+----+----+----+----+----+----+----+----+----+----+....+----+
+//	| VN | CD | DSTPORT |      DSTIP        | USERID       |NULL|
+//	+----+----+----+----+----+----+----+----+----+----+....+----+
+//	  1    1      2              4           variable       1
+//
+// A SOCKS4a client that cannot resolve the host itself sets DSTIP to
+// 0.0.0.x (x != 0) and appends the host name, also NULL terminated.
+func getRequest4(conn *bufferedConn) (rawaddr []byte, host string, err error) {
+	const (
+		idVer  = 0
+		idCmd  = 1
+		idPort = 2
+		idIP0  = 4
+
+		lenFixed = 1 + 1 + 2 + net.IPv4len
+	)
+	buf := make([]byte, lenFixed)
+	if _, err = io.ReadFull(conn, buf); err != nil {
+		return
+	}
+	if buf[idVer] != socksVer4 {
+		err = errVer
+		return
+	}
+	if buf[idCmd] != socksCmdConnect {
+		err = errCmd
+		return
+	}
+	// user id is not used, the reader's buffer bounds its length
+	if _, err = conn.r.ReadSlice(0); err != nil {
+		return
+	}
+	ip := net.IP(buf[idIP0 : idIP0+net.IPv4len])
+	port := buf[idPort : idPort+2]
+	if ip[0] == 0 && ip[1] == 0 && ip[2] == 0 && ip[3] != 0 {
+		var name []byte
+		if name, err = conn.r.ReadSlice(0); err != nil {
+			return
+		}
+		name = name[:len(name)-1]
+		if len(name) == 0 || len(name) > 255 {
+			err = errAddrType
+			return
+		}
+		rawaddr = append([]byte{socksAddrDomain, byte(len(name))}, name...)
+		host = string(name)
+	} else {
+		rawaddr = append([]byte{socksAddrIPv4}, ip...)
+		host = ip.String()
+	}
+	rawaddr = append(rawaddr, port...)
+	host = net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port))))
+	return
+}
+
+// socks4Reply builds a SOCKS4 reply. SOCKS4 has a single failure code and
+// can only report an IPv4 bound address.
+func socks4Reply(err error, bound net.Addr) []byte {
+	buf := []byte{0, socks4RepGranted, 0, 0, 0, 0, 0, 0}
+	if err != nil {
+		buf[1] = socks4RepRejected
+		return buf
+	}
+	if a, ok := bound.(*net.TCPAddr); ok {
+		if ip4 := a.IP.To4(); ip4 != nil {
+			binary.BigEndian.PutUint16(buf[2:4], uint16(a.Port))
+			copy(buf[4:], ip4)
+		}
+	}
+	return buf
+}
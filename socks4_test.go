@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"net"
+	"testing"
+)
+
+func TestGetRequest4(t *testing.T) {
+	tests := []struct {
+		req     []byte
+		rawaddr []byte
+		host    string
+	}{
+		{
+			[]byte{4, 1, 0, 80, 93, 184, 216, 34, 'u', 0},
+			[]byte{socksAddrIPv4, 93, 184, 216, 34, 0, 80},
+			"93.184.216.34:80",
+		},
+		{
+			[]byte{4, 1, 1, 187, 0, 0, 0, 1, 0, 'a', '.', 'c', 'n', 0},
+			[]byte{socksAddrDomain, 4, 'a', '.', 'c', 'n', 1, 187},
+			"a.cn:443",
+		},
+	}
+	for _, test := range tests {
+		client, server := net.Pipe()
+		go client.Write(test.req)
+		rawaddr, host, err := getRequest4(newBufferedConn(server))
+		if err != nil {
+			t.Errorf("request %v: %v", test.req, err)
+		} else if !bytes.Equal(rawaddr, test.rawaddr) || host != test.host {
+			t.Errorf("request %v parsed to %v %s", test.req, rawaddr, host)
+		}
+		client.Close()
+		server.Close()
+	}
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"lukechampine.com/blake3"
+)
+
+// Shadowsocks 2022 (SIP022) over tcp. The AEAD chunks are the same as
+// SIP004, the differences are the pre-shared key used as is, the BLAKE3 key
+// derivation, and the headers starting each direction: they carry a
+// timestamp, and the response repeats the request salt, so neither side can
+// be replayed.
+//
+// request:  salt | fixed header: type, timestamp, length | variable header:
+//           address, padding length, padding | chunks...
+// response: salt | fixed header: type, timestamp, request salt, length |
+//           chunk of length | chunks...
+
+const (
+	ss2022MaxPayload = 0xFFFF
+
+	ss2022TypeRequest  = 0
+	ss2022TypeResponse = 1
+
+	ss2022MaxTimeDiff = 30 * time.Second
+	ss2022SaltTTL     = 60 * time.Second
+	ss2022MaxPadding  = 900
+)
+
+var (
+	errSS2022PSK       = errors.New("shadowsocks 2022 key must be base64 of the method's key size")
+	errSS2022Header    = errors.New("shadowsocks 2022 response header malformed")
+	errSS2022Timestamp = errors.New("shadowsocks 2022 response too old or from the future")
+	errSS2022Replay    = errors.New("shadowsocks 2022 response replayed")
+	errSS2022UDP       = errors.New("udp relay is not supported for shadowsocks 2022 servers")
+)
+
+func isSS2022Method(method string) bool {
+	return strings.HasPrefix(method, "2022-")
+}
+
+func decodePSK(password string, keySize int) ([]byte, error) {
+	psk, err := base64.StdEncoding.DecodeString(password)
+	if err != nil || len(psk) != keySize {
+		return nil, errSS2022PSK
+	}
+	return psk, nil
+}
+
+func blake3Subkey(key, salt []byte) ([]byte, error) {
+	material := make([]byte, 0, len(key)+len(salt))
+	material = append(append(material, key...), salt...)
+	subkey := make([]byte, len(key))
+	blake3.DeriveKey(subkey, "shadowsocks 2022 session subkey", material)
+	return subkey, nil
+}
+
+// saltPool remembers the salts seen lately, a salt coming back within
+// ss2022SaltTTL is a replay.
+type saltPool struct {
+	mu    sync.Mutex
+	salts map[string]time.Time
+}
+
+var responseSalts = &saltPool{salts: make(map[string]time.Time)}
+
+// add records salt, it returns false when salt was seen already.
+func (p *saltPool) add(salt []byte) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	for s, seen := range p.salts {
+		if now.Sub(seen) > ss2022SaltTTL {
+			delete(p.salts, s)
+		}
+	}
+	if _, ok := p.salts[string(salt)]; ok {
+		return false
+	}
+	p.salts[string(salt)] = now
+	return true
+}
+
+// ss2022Conn is the client side of a shadowsocks 2022 tcp connection.
+type ss2022Conn struct {
+	*aeadConn
+	reqSalt []byte
+}
+
+// newSS2022Conn sends the request header for rawaddr on c.
+func newSS2022Conn(c net.Conn, cipher *aeadCipher, rawaddr []byte) (*ss2022Conn, error) {
+	salt := make([]byte, cipher.saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	enc, err := cipher.sessionAEAD(salt)
+	if err != nil {
+		return nil, err
+	}
+	conn := &ss2022Conn{aeadConn: newAEADConn(c, cipher), reqSalt: salt}
+	conn.enc, conn.encNonce = enc, make([]byte, enc.NonceSize())
+
+	// nothing is sent along with the header, so it has to be padded
+	n, err := rand.Int(rand.Reader, big.NewInt(ss2022MaxPadding))
+	if err != nil {
+		return nil, err
+	}
+	padding := int(n.Int64()) + 1
+	variable := make([]byte, 0, len(rawaddr)+2+padding)
+	variable = append(variable, rawaddr...)
+	variable = append(variable, byte(padding>>8), byte(padding))
+	variable = append(variable, make([]byte, padding)...)
+
+	fixed := make([]byte, 1+8+2)
+	fixed[0] = ss2022TypeRequest
+	binary.BigEndian.PutUint64(fixed[1:], uint64(time.Now().Unix()))
+	binary.BigEndian.PutUint16(fixed[9:], uint16(len(variable)))
+
+	out := append([]byte{}, salt...)
+	out = enc.Seal(out, conn.encNonce, fixed, nil)
+	increment(conn.encNonce)
+	out = enc.Seal(out, conn.encNonce, variable, nil)
+	increment(conn.encNonce)
+	if _, err = c.Write(out); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (c *ss2022Conn) readResponseHeader() error {
+	salt := make([]byte, c.cipher.saltSize)
+	if _, err := io.ReadFull(c.Conn, salt); err != nil {
+		return err
+	}
+	if !responseSalts.add(salt) {
+		return errSS2022Replay
+	}
+	dec, err := c.cipher.sessionAEAD(salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, dec.NonceSize())
+	buf := make([]byte, c.cipher.maxPayload+dec.Overhead())
+
+	fixed := buf[:1+8+len(c.reqSalt)+2+dec.Overhead()]
+	if _, err = io.ReadFull(c.Conn, fixed); err != nil {
+		return err
+	}
+	header, err := dec.Open(fixed[:0], nonce, fixed, nil)
+	if err != nil {
+		return err
+	}
+	increment(nonce)
+	if header[0] != ss2022TypeResponse {
+		return errSS2022Header
+	}
+	ts := time.Unix(int64(binary.BigEndian.Uint64(header[1:9])), 0)
+	if diff := time.Since(ts); diff > ss2022MaxTimeDiff || diff < -ss2022MaxTimeDiff {
+		return errSS2022Timestamp
+	}
+	if !bytes.Equal(header[9:9+len(c.reqSalt)], c.reqSalt) {
+		return errSS2022Header
+	}
+	size := int(binary.BigEndian.Uint16(header[9+len(c.reqSalt):]))
+
+	// the first chunk has no length of its own, the header carried it
+	first := buf[:size+dec.Overhead()]
+	if _, err = io.ReadFull(c.Conn, first); err != nil {
+		return err
+	}
+	if c.readBuf, err = dec.Open(first[:0], nonce, first, nil); err != nil {
+		return err
+	}
+	increment(nonce)
+	c.dec, c.decNonce, c.buf = dec, nonce, buf
+	return nil
+}
+
+func (c *ss2022Conn) Read(b []byte) (int, error) {
+	if c.dec == nil {
+		if err := c.readResponseHeader(); err != nil {
+			return 0, err
+		}
+	}
+	return c.aeadConn.Read(b)
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+// serveSS2022 is a stand-in shadowsocks 2022 server for one connection: it
+// checks the request header for rawaddr, then echoes back whatever follows.
+func serveSS2022(t *testing.T, ln net.Listener, cipher *aeadCipher, rawaddr []byte) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	fail := func(format string, args ...interface{}) {
+		t.Errorf("server: "+format, args...)
+	}
+
+	reqSalt := make([]byte, cipher.saltSize)
+	if _, err = io.ReadFull(conn, reqSalt); err != nil {
+		fail("read salt: %v", err)
+		return
+	}
+	dec, _ := cipher.sessionAEAD(reqSalt)
+	nonce := make([]byte, dec.NonceSize())
+	fixed := make([]byte, 1+8+2+dec.Overhead())
+	if _, err = io.ReadFull(conn, fixed); err != nil {
+		fail("read fixed header: %v", err)
+		return
+	}
+	header, err := dec.Open(nil, nonce, fixed, nil)
+	if err != nil {
+		fail("open fixed header: %v", err)
+		return
+	}
+	increment(nonce)
+	ts := time.Unix(int64(binary.BigEndian.Uint64(header[1:9])), 0)
+	if header[0] != ss2022TypeRequest || time.Since(ts) > ss2022MaxTimeDiff {
+		fail("bad fixed header %v", header)
+		return
+	}
+	variable := make([]byte, int(binary.BigEndian.Uint16(header[9:]))+dec.Overhead())
+	if _, err = io.ReadFull(conn, variable); err != nil {
+		fail("read variable header: %v", err)
+		return
+	}
+	if variable, err = dec.Open(nil, nonce, variable, nil); err != nil {
+		fail("open variable header: %v", err)
+		return
+	}
+	increment(nonce)
+	if !bytes.HasPrefix(variable, rawaddr) {
+		fail("got address %v, want %v", variable, rawaddr)
+		return
+	}
+	padding := int(binary.BigEndian.Uint16(variable[len(rawaddr):]))
+	if padding == 0 || len(variable) != len(rawaddr)+2+padding {
+		fail("bad padding %d", padding)
+		return
+	}
+
+	// the rest of the request is plain chunks
+	in := newAEADConn(conn, cipher)
+	in.dec, in.decNonce, in.buf = dec, nonce, make([]byte, cipher.maxPayload+dec.Overhead())
+	data := make([]byte, 5)
+	if _, err = io.ReadFull(in, data); err != nil {
+		fail("read data: %v", err)
+		return
+	}
+
+	salt := make([]byte, cipher.saltSize)
+	rand.Read(salt)
+	enc, _ := cipher.sessionAEAD(salt)
+	encNonce := make([]byte, enc.NonceSize())
+	resp := make([]byte, 1+8, 1+8+len(reqSalt)+2)
+	resp[0] = ss2022TypeResponse
+	binary.BigEndian.PutUint64(resp[1:], uint64(time.Now().Unix()))
+	resp = append(resp, reqSalt...)
+	resp = append(resp, 0, byte(len(data)))
+	out := enc.Seal(salt, encNonce, resp, nil)
+	increment(encNonce)
+	out = enc.Seal(out, encNonce, data, nil)
+	increment(encNonce)
+	conn.Write(out)
+
+	w := newAEADConn(conn, cipher)
+	w.enc, w.encNonce = enc, encNonce
+	w.Write([]byte(" again"))
+}
+
+func TestSS2022ServerConn(t *testing.T) {
+	rawaddr := []byte{socksAddrIPv4, 1, 2, 3, 4, 0, 80}
+	for method, spec := range aeadSpecs {
+		if !isSS2022Method(method) {
+			continue
+		}
+		psk := make([]byte, spec.keySize)
+		rand.Read(psk)
+		cipher, err := newAEADCipher(method, base64.StdEncoding.EncodeToString(psk))
+		if err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		go serveSS2022(t, ln, cipher, rawaddr)
+
+		se := &ServerCipher{server: ln.Addr().String(), aead: cipher, breaker: &circuitBreaker{}}
+		conn, err := dialServer(se, rawaddr)
+		if err != nil {
+			t.Fatalf("%s: dial: %v", method, err)
+		}
+		conn.Write([]byte("hello"))
+		got := make([]byte, len("hello again"))
+		if _, err = io.ReadFull(conn, got); err != nil {
+			t.Errorf("%s: read: %v", method, err)
+		} else if string(got) != "hello again" {
+			t.Errorf("%s: got %q", method, got)
+		}
+		conn.Close()
+		ln.Close()
+	}
+}
+
+func TestSS2022PSK(t *testing.T) {
+	if _, err := newAEADCipher("2022-blake3-aes-256-gcm", base64.StdEncoding.EncodeToString(make([]byte, 16))); err != errSS2022PSK {
+		t.Errorf("short key accepted: %v", err)
+	}
+	if _, err := newAEADCipher("2022-blake3-aes-128-gcm", "not base64!"); err != errSS2022PSK {
+		t.Errorf("invalid key accepted: %v", err)
+	}
+	tunnel, err := NewSSTunnel("ss://2022-blake3-aes-128-gcm:AAAAAAAAAAAAAAAAAAAAAA==@1.2.3.4:8388")
+	if err != nil || tunnel.Password != "AAAAAAAAAAAAAAAAAAAAAA==" {
+		t.Errorf("unexpected tunnel %v: %v", tunnel, err)
+	}
+}
+
+func TestSaltPool(t *testing.T) {
+	p := &saltPool{salts: make(map[string]time.Time)}
+	if !p.add([]byte("salt")) {
+		t.Error("fresh salt refused")
+	}
+	if p.add([]byte("salt")) {
+		t.Error("replayed salt accepted")
+	}
+	p.salts["salt"] = time.Now().Add(-2 * ss2022SaltTTL)
+	if !p.add([]byte("salt")) {
+		t.Error("expired salt refused")
+	}
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Subscriptions are urls serving a SIP008 online config, or a plain or base64
+// encoded list of ss:// uris. They are fetched on a schedule and their
+// tunnels used along with the ones added by hand.
+
+const (
+	defaultSubscriptionInterval = 24 // hours
+	subscriptionTimeout         = 30 * time.Second
+	subscriptionMaxSize         = 4 << 20
+)
+
+var errSubscriptionEmpty = errors.New("订阅中没有可用的账号")
+
+// sip008Config is the SIP008 online config document.
+type sip008Config struct {
+	Version int             `json:"version"`
+	Servers []*sip008Server `json:"servers"`
+}
+
+type sip008Server struct {
+	Id         string `json:"id"`
+	Remarks    string `json:"remarks"`
+	Server     string `json:"server"`
+	ServerPort int    `json:"server_port"`
+	Password   string `json:"password"`
+	Method     string `json:"method"`
+	Plugin     string `json:"plugin"`
+	PluginOpts string `json:"plugin_opts"`
+}
+
+// parseSubscription returns the tunnels of a subscription document, entries
+// that can not be used are skipped.
+func parseSubscription(body []byte) ([]*SSTunnel, error) {
+	body = bytes.TrimSpace(body)
+	var uris []string
+	if bytes.HasPrefix(body, []byte("{")) {
+		config := &sip008Config{}
+		if err := json.Unmarshal(body, config); err != nil {
+			return nil, err
+		}
+		for _, s := range config.Servers {
+			tunnel := &SSTunnel{
+				Ip:         s.Server,
+				Port:       strconv.Itoa(s.ServerPort),
+				Password:   s.Password,
+				Method:     s.Method,
+				Plugin:     s.Plugin,
+				PluginOpts: s.PluginOpts,
+				Remark:     s.Remarks,
+			}
+			uris = append(uris, tunnel.ToString())
+		}
+	} else {
+		if !bytes.Contains(body, []byte("ss://")) {
+			decoded, err := decodeBase64(string(body))
+			if err != nil {
+				return nil, errSubscriptionEmpty
+			}
+			body = decoded
+		}
+		uris = strings.Fields(string(body))
+	}
+	tunnels := []*SSTunnel{}
+	for i, uri := range uris {
+		tunnel, err := NewSSTunnel(uri)
+		if err == nil {
+			err = checkTunnel(tunnel)
+		}
+		if err != nil {
+			log.Printf("Skip subscription entry %d: %v", i+1, err)
+			continue
+		}
+		tunnels = append(tunnels, tunnel)
+	}
+	if len(tunnels) == 0 {
+		return nil, errSubscriptionEmpty
+	}
+	return tunnels, nil
+}
+
+func fetchSubscription(u string, viaProxy bool) ([]*SSTunnel, error) {
+	client := &http.Client{Timeout: subscriptionTimeout}
+	if viaProxy {
+		client.Transport = &http.Transport{Dial: dialShadowsocks}
+	}
+	resp, err := client.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("订阅返回%s", resp.Status)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, subscriptionMaxSize))
+	if err != nil {
+		return nil, err
+	}
+	return parseSubscription(body)
+}
+
+type SubscriptionStatus struct {
+	Url       string     `json:"url"`
+	LastFetch *Timestamp `json:"last_fetch"`
+	Succeed   bool       `json:"ok"`
+	Error     string     `json:"error"`
+	Tunnels   int        `json:"tunnels"`
+	Added     int        `json:"added"`
+	Removed   int        `json:"removed"`
+}
+
+var subStatus = struct {
+	sync.RWMutex
+	m map[string]*SubscriptionStatus
+}{m: make(map[string]*SubscriptionStatus)}
+
+// GetSubscriptionStatus returns the last fetch status of the configured
+// subscriptions, in config order.
+func GetSubscriptionStatus() []*SubscriptionStatus {
+	config, _ := LoadConfig()
+	subStatus.RLock()
+	defer subStatus.RUnlock()
+	stats := []*SubscriptionStatus{}
+	for _, u := range config.Subscriptions {
+		if s, ok := subStatus.m[u]; ok {
+			stat := *s
+			stats = append(stats, &stat)
+		} else {
+			stats = append(stats, &SubscriptionStatus{Url: u, Tunnels: len(config.SubTunnels[u])})
+		}
+	}
+	return stats
+}
+
+// refreshSubscriptions fetches every subscription and applies the tunnels
+// that changed.
+func refreshSubscriptions() {
+	config, err := LoadConfig()
+	if err != nil || len(config.Subscriptions) == 0 {
+		return
+	}
+	before := len(config.GetSSTunnels())
+	// without servers yet there is no proxy to go through
+	viaProxy := config.Get("subscription_via_proxy") == "on" && before > 0
+	fetched := make(map[string][]*SSTunnel)
+	errs := make(map[string]error)
+	for _, u := range config.Subscriptions {
+		fetched[u], errs[u] = fetchSubscription(u, viaProxy)
+	}
+
+	// fetching takes a while, apply to the config as it is now
+	config, err = LoadConfig()
+	if err != nil {
+		return
+	}
+	changed := false
+	var applied []*SubscriptionStatus
+	for _, u := range config.Subscriptions {
+		err, ok := errs[u]
+		if !ok {
+			continue // added meanwhile, next round
+		}
+		now := Timestamp(time.Now())
+		status := &SubscriptionStatus{Url: u, LastFetch: &now, Tunnels: len(config.SubTunnels[u])}
+		if err == nil {
+			uris := make([]string, len(fetched[u]))
+			for i, t := range fetched[u] {
+				uris[i] = t.ToString()
+			}
+			status.Added, status.Removed, err = config.SetSubTunnels(u, uris)
+			status.Tunnels = len(uris)
+			changed = changed || status.Added > 0 || status.Removed > 0
+		}
+		if err != nil {
+			log.Printf("Failed fetching subscription %s: %v", u, err)
+			status.Error = err.Error()
+		} else {
+			log.Printf("Subscription %s: %d tunnels, %d added, %d removed", u, status.Tunnels, status.Added, status.Removed)
+			status.Succeed = true
+			applied = append(applied, status)
+		}
+		subStatus.Lock()
+		subStatus.m[u] = status
+		subStatus.Unlock()
+	}
+	if !changed {
+		return
+	}
+	tunnels := config.GetSSTunnels()
+	if err = SetTunnels(tunnels); err != nil {
+		log.Printf("Could not set tunnels: %v", err)
+		// the tunnels that work are used, the status tells about the others
+		subStatus.Lock()
+		for _, status := range applied {
+			status.Succeed = false
+			status.Error = err.Error()
+		}
+		subStatus.Unlock()
+	}
+	if len(tunnels) == 0 {
+		UnsetPac()
+	} else if before == 0 {
+		SetPac()
+	}
+}
+
+var subscriptionInterval int64 = defaultSubscriptionInterval
+var subscriptionWake = make(chan struct{}, 1)
+
+// SetSubscriptionInterval sets the hours between two refreshes, 0 only
+// refreshes when asked to.
+func SetSubscriptionInterval(hours int) {
+	atomic.StoreInt64(&subscriptionInterval, int64(hours))
+	WakeSubscriptions()
+}
+
+// WakeSubscriptions refreshes the subscriptions right away.
+func WakeSubscriptions() {
+	select {
+	case subscriptionWake <- struct{}{}:
+	default:
+	}
+}
+
+func StartSubscriptions() {
+	for {
+		// a wake queued before this round is served by it
+		select {
+		case <-subscriptionWake:
+		default:
+		}
+		refreshSubscriptions()
+		interval := time.Duration(atomic.LoadInt64(&subscriptionInterval)) * time.Hour
+		if interval <= 0 {
+			<-subscriptionWake
+			continue
+		}
+		select {
+		case <-time.After(interval):
+		case <-subscriptionWake:
+		}
+	}
+}
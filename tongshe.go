@@ -2,12 +2,16 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"math/rand"
+	"net"
 	"net/http"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,6 +26,13 @@ func getConfigFunc(name string) func(string, string) {
 	if name == "is_global" {
 		return func(name, value string) {
 			refreshGlobalToggle(value == "on")
+			reloadRules()
+			SetPac()
+		}
+	}
+	if name == "diy_domains" || name == "rules" {
+		return func(name, value string) {
+			reloadRules()
 			SetPac()
 		}
 	}
@@ -30,6 +41,72 @@ func getConfigFunc(name string) func(string, string) {
 			SetPac()
 		}
 	}
+	if name == "socks_deferred_reply" {
+		return func(name, value string) {
+			SetDeferredReply(value == "on")
+		}
+	}
+	if name == "socks_port" {
+		return func(name, value string) {
+			go StartSS()
+			SetPac()
+		}
+	}
+	if name == "http_port" {
+		return func(name, value string) {
+			go StartHttpProxy()
+			SetPac()
+		}
+	}
+	if name == "mixed_port" {
+		return func(name, value string) {
+			go StartMixed()
+		}
+	}
+	if name == "listen_host" {
+		return func(name, value string) {
+			go StartSS()
+			go StartHttpProxy()
+			go StartMixed()
+			SetPac()
+		}
+	}
+	if name == "probe_interval" {
+		return func(name, value string) {
+			seconds, err := strconv.Atoi(value)
+			if err != nil || seconds < 0 {
+				seconds = defaultProbeInterval
+			}
+			SetProbeInterval(seconds)
+		}
+	}
+	if name == "lb_policy" {
+		return func(name, value string) {
+			if err := SetPolicy(value); err != nil {
+				log.Printf("Could not set policy %s: %v", value, err)
+			}
+		}
+	}
+	if name == "race_dial" {
+		return func(name, value string) {
+			SetRaceDial(value == "on")
+		}
+	}
+	if name == "race_delay" {
+		return func(name, value string) {
+			ms, _ := strconv.Atoi(value)
+			SetRaceDelay(ms)
+		}
+	}
+	if name == "subscription_interval" {
+		return func(name, value string) {
+			hours, err := strconv.Atoi(value)
+			if err != nil || hours < 0 {
+				hours = defaultSubscriptionInterval
+			}
+			SetSubscriptionInterval(hours)
+		}
+	}
 	return func(s, v string) {}
 }
 
@@ -48,9 +125,39 @@ func renderJson(w http.ResponseWriter, res *JsonResponse) {
 	w.Write(buf)
 }
 
+func reloadRules() {
+	config, _ := LoadConfig()
+	SetRules(config)
+}
+
 func set(w http.ResponseWriter, r *http.Request) {
 	name := r.FormValue("name")
 	value := r.FormValue("value")
+	if name == "rules" {
+		if _, err := parseRules(value); err != nil {
+			res := &JsonResponse{Succeed: false, Data: nil, Message: err.Error()}
+			renderJson(w, res)
+			return
+		}
+	}
+	if name == "lb_policy" && checkPolicy(value) != nil {
+		res := &JsonResponse{Succeed: false, Data: nil, Message: "未知的服务器选择策略: " + value}
+		renderJson(w, res)
+		return
+	}
+	// applied before it is saved, urls that do not parse are refused
+	if name == "upstream_proxy" {
+		if err := SetUpstreamProxy(value); err != nil {
+			res := &JsonResponse{Succeed: false, Data: nil, Message: "无效的上游代理: " + err.Error()}
+			renderJson(w, res)
+			return
+		}
+	}
+	if name == "listen_host" && value != "" && net.ParseIP(value) == nil {
+		res := &JsonResponse{Succeed: false, Data: nil, Message: "监听地址须为ip地址"}
+		renderJson(w, res)
+		return
+	}
 	if err := effectSetting(name, value); err != nil {
 		res := &JsonResponse{Succeed: false, Data: nil, Message: "设置文件有问题"}
 		renderJson(w, res)
@@ -82,30 +189,18 @@ func settings(w http.ResponseWriter, r *http.Request) {
 
 func getPac(w http.ResponseWriter, r *http.Request) {
 	bt := GetRes("pac.tpl")
+	config, _ := LoadConfig()
 	var proxy string
 	if runtime.GOOS == "windows" {
-		proxy = fmt.Sprintf("PROXY %s; DIRECT;", GetHttpProxy())
+		proxy = fmt.Sprintf("PROXY %s; DIRECT;", GetHttpProxy(config))
 	} else {
-		proxy = fmt.Sprintf("SOCKS5 %s; SOCKS %s; DIRECT;", GetSocksProxy(), GetSocksProxy())
+		proxy = fmt.Sprintf("SOCKS5 %s; SOCKS %s; DIRECT;", GetSocksProxy(config), GetSocksProxy(config))
 	}
-	config, _ := LoadConfig()
-	dds := config.Get("diy_domains")
-	dms := []string{}
-	if len(dds) > 0 {
-		dms = strings.Split(dds, ",")
-	}
-	dmsJson, _ := json.Marshal(dms)
+	rulesJson, _ := json.Marshal(pacRules(configRules(config), proxy))
 
 	s := strings.Replace(string(bt), "__PROXY__", proxy, -1)
-	s = strings.Replace(s, "__DOMAINS__", string(dmsJson), -1)
-	isGs := "false"
-
-	global := config.Get("is_global") == "on"
-	if global {
-		isGs = "true"
-	}
-	s = strings.Replace(s, "__IS_GLOBAL__", isGs, -1)
-	fmt.Fprintf(w, s)
+	s = strings.Replace(s, "__RULES__", string(rulesJson), -1)
+	fmt.Fprint(w, s)
 }
 
 func tokenRequired(f func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
@@ -153,8 +248,6 @@ func RandomString(strlen int) string {
 
 func shadowsocks(w http.ResponseWriter, r *http.Request) {
 	config, err := LoadConfig()
-	if err != nil {
-	}
 	switch r.Method {
 	case "POST":
 		ss := r.FormValue("ss")
@@ -170,14 +263,95 @@ func shadowsocks(w http.ResponseWriter, r *http.Request) {
 		err = config.UpdateTunnel(old, ss)
 	}
 	log.Printf("ss tunnels count is %d now", len(config.GetSSTunnels()))
-	SetTunnels(config.GetSSTunnels())
+	if terr := SetTunnels(config.GetSSTunnels()); terr != nil && err == nil {
+		err = terr
+	}
 	if len(config.GetSSTunnels()) == 0 {
 		UnsetPac()
 	}
 	if r.Method == "POST" && len(config.GetSSTunnels()) == 1 {
 		SetPac()
 	}
-	bt, _ := json.Marshal(config.SSTunnels)
+	bt, _ := json.Marshal(config.TunnelURIs())
+	data := (*json.RawMessage)(&bt)
+	if err == nil {
+		res := &JsonResponse{Succeed: true, Data: data, Message: ""}
+		renderJson(w, res)
+	} else {
+		res := &JsonResponse{Succeed: false, Data: data, Message: err.Error()}
+		renderJson(w, res)
+	}
+}
+
+// tunnelRecords lists the tunnels added by hand and adds one; with an id it
+// changes the form values given, uri, name, remarks, enabled and order, or
+// deletes the tunnel.
+func tunnelRecords(w http.ResponseWriter, r *http.Request) {
+	config, err := LoadConfig()
+	before := len(config.GetSSTunnels())
+	id := mux.Vars(r)["id"]
+	switch {
+	case r.Method == "POST" && id == "":
+		var rec *TunnelRecord
+		if rec, err = NewTunnelRecord(r.FormValue("uri")); err == nil {
+			if name := r.FormValue("name"); name != "" {
+				rec.Name = name
+			}
+			rec.Remarks = r.FormValue("remarks")
+			log.Printf("Add tunnel %s", rec.Id)
+			err = config.AddTunnelRecord(rec)
+		}
+	case r.Method == "PUT" && id != "":
+		rec := config.GetTunnel(id)
+		if rec == nil {
+			err = errTunnelNotFound
+			break
+		}
+		r.ParseForm()
+		order := rec.Order
+		if _, ok := r.PostForm["order"]; ok {
+			if order, err = strconv.Atoi(r.PostFormValue("order")); err != nil {
+				err = errTunnelOrder
+				break
+			}
+		}
+		if _, ok := r.PostForm["uri"]; ok {
+			if err = config.SetTunnelUri(rec, r.PostFormValue("uri")); err != nil {
+				break
+			}
+		}
+		if _, ok := r.PostForm["name"]; ok {
+			rec.Name = r.PostFormValue("name")
+		}
+		if _, ok := r.PostForm["remarks"]; ok {
+			rec.Remarks = r.PostFormValue("remarks")
+		}
+		if _, ok := r.PostForm["enabled"]; ok {
+			rec.Enabled = r.PostFormValue("enabled") == "on"
+		}
+		config.MoveTunnel(rec, order)
+		log.Printf("Update tunnel %s", id)
+		err = SaveConfig(config)
+	case r.Method == "DELETE" && id != "":
+		if config.GetTunnel(id) == nil {
+			err = errTunnelNotFound
+			break
+		}
+		log.Printf("Delete tunnel %s", id)
+		err = config.DeleteTunnelById(id)
+	}
+	if r.Method != "GET" {
+		if terr := SetTunnels(config.GetSSTunnels()); terr != nil && err == nil {
+			err = terr
+		}
+		if len(config.GetSSTunnels()) == 0 {
+			UnsetPac()
+		} else if before == 0 {
+			SetPac()
+		}
+	}
+	config.updateLastUsed()
+	bt, _ := json.Marshal(config.Tunnels)
 	data := (*json.RawMessage)(&bt)
 	if err == nil {
 		res := &JsonResponse{Succeed: true, Data: data, Message: ""}
@@ -188,6 +362,190 @@ func shadowsocks(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func socksUsers(w http.ResponseWriter, r *http.Request) {
+	config, err := LoadConfig()
+	if err != nil {
+	}
+	switch r.Method {
+	case "POST":
+		user := r.FormValue("user")
+		log.Printf("Set socks user: %s", user)
+		err = config.SetSocksUser(user, r.FormValue("password"))
+	case "DELETE":
+		user := r.URL.Query().Get("user")
+		log.Printf("Delete socks user: %s", user)
+		err = config.DeleteSocksUser(user)
+	}
+	SetSocksUsers(config.SocksUsers)
+	bt, _ := json.Marshal(config.GetSocksUsers())
+	data := (*json.RawMessage)(&bt)
+	if err == nil {
+		res := &JsonResponse{Succeed: true, Data: data, Message: ""}
+		renderJson(w, res)
+	} else {
+		res := &JsonResponse{Succeed: false, Data: data, Message: err.Error()}
+		renderJson(w, res)
+	}
+}
+
+func subscriptions(w http.ResponseWriter, r *http.Request) {
+	config, err := LoadConfig()
+	switch r.Method {
+	case "POST":
+		u := r.FormValue("url")
+		log.Printf("Add subscription: %s", u)
+		if err = config.AddSubscription(u); err == nil {
+			WakeSubscriptions()
+		}
+	case "PUT":
+		log.Printf("Refresh subscriptions")
+		WakeSubscriptions()
+	case "DELETE":
+		u := r.URL.Query().Get("url")
+		log.Printf("Delete subscription: %s", u)
+		if err = config.DeleteSubscription(u); err == nil {
+			if terr := SetTunnels(config.GetSSTunnels()); terr != nil {
+				err = terr
+			}
+			if len(config.GetSSTunnels()) == 0 {
+				UnsetPac()
+			}
+		}
+	}
+	bt, _ := json.Marshal(GetSubscriptionStatus())
+	data := (*json.RawMessage)(&bt)
+	if err == nil {
+		res := &JsonResponse{Succeed: true, Data: data, Message: ""}
+		renderJson(w, res)
+	} else {
+		res := &JsonResponse{Succeed: false, Data: data, Message: err.Error()}
+		renderJson(w, res)
+	}
+}
+
+// importTunnels takes a client config as the data form value or as an
+// uploaded file.
+func importTunnels(w http.ResponseWriter, r *http.Request) {
+	serveImport(w, r, "data", true)
+}
+
+// batchTunnels adds the ss:// links in the text form value, text pasted from
+// a provider's mail for one, without fetching Outline keys found in it.
+func batchTunnels(w http.ResponseWriter, r *http.Request) {
+	serveImport(w, r, "text", false)
+}
+
+// serveImport adds the servers in the field form value, or an uploaded file,
+// reporting each as added, duplicate or invalid.
+func serveImport(w http.ResponseWriter, r *http.Request, field string, fetchKeys bool) {
+	config, err := LoadConfig()
+	body := []byte(r.FormValue(field))
+	if file, _, ferr := r.FormFile("file"); ferr == nil {
+		body, err = ioutil.ReadAll(file)
+		file.Close()
+	}
+	var entries []*ImportEntry
+	if err == nil {
+		before := len(config.GetSSTunnels())
+		entries, err = config.ImportTunnels(body, fetchKeys)
+		log.Printf("Imported %d entries", len(entries))
+		if terr := SetTunnels(config.GetSSTunnels()); terr != nil && err == nil {
+			err = terr
+		}
+		if before == 0 && len(config.GetSSTunnels()) > 0 {
+			SetPac()
+		}
+	}
+	bt, _ := json.Marshal(entries)
+	data := (*json.RawMessage)(&bt)
+	if err == nil {
+		res := &JsonResponse{Succeed: true, Data: data, Message: ""}
+		renderJson(w, res)
+	} else {
+		res := &JsonResponse{Succeed: false, Data: data, Message: err.Error()}
+		renderJson(w, res)
+	}
+}
+
+// qrCode serves the QR code of the ss query value as a PNG, a POSTed image
+// of one adds its tunnel.
+func qrCode(w http.ResponseWriter, r *http.Request) {
+	config, err := LoadConfig()
+	if r.Method == "GET" {
+		var tunnel *SSTunnel
+		if tunnel, err = NewSSTunnel(r.URL.Query().Get("ss")); err == nil {
+			size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+			var bt []byte
+			if bt, err = TunnelQRCode(tunnel, size); err == nil {
+				w.Header().Set("Content-Type", "image/png")
+				w.Write(bt)
+				return
+			}
+		}
+		res := &JsonResponse{Succeed: false, Data: nil, Message: err.Error()}
+		renderJson(w, res)
+		return
+	}
+	// room for the multipart headers around the image
+	r.Body = http.MaxBytesReader(w, r.Body, qrImageMaxBytes+64<<10)
+	file, _, err := r.FormFile("file")
+	if err == nil {
+		var tunnel *SSTunnel
+		tunnel, err = DecodeQRCode(file)
+		file.Close()
+		if err == nil {
+			log.Printf("Add ss from qrcode: %s", tunnelName(tunnel))
+			err = config.AddTunnel(tunnel.ToString())
+		}
+	}
+	if terr := SetTunnels(config.GetSSTunnels()); terr != nil && err == nil {
+		err = terr
+	}
+	if err == nil && len(config.GetSSTunnels()) == 1 {
+		SetPac()
+	}
+	bt, _ := json.Marshal(config.TunnelURIs())
+	data := (*json.RawMessage)(&bt)
+	if err == nil {
+		res := &JsonResponse{Succeed: true, Data: data, Message: ""}
+		renderJson(w, res)
+	} else {
+		res := &JsonResponse{Succeed: false, Data: data, Message: err.Error()}
+		renderJson(w, res)
+	}
+}
+
+// exportTunnels serves the tunnels as a file in the format query value,
+// redact=on leaves the passwords out.
+func exportTunnels(w http.ResponseWriter, r *http.Request) {
+	config, _ := LoadConfig()
+	format := r.URL.Query().Get("format")
+	bt, err := config.ExportTunnels(format, r.URL.Query().Get("redact") == "on")
+	if err != nil {
+		res := &JsonResponse{Succeed: false, Data: nil, Message: err.Error()}
+		renderJson(w, res)
+		return
+	}
+	f := exportFormats[format]
+	w.Header().Set("Content-Type", f[1])
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", f[0]))
+	w.Write(bt)
+}
+
+func probeStats(w http.ResponseWriter, r *http.Request) {
+	bt, _ := json.Marshal(GetProbeStats())
+	data := (*json.RawMessage)(&bt)
+	res := &JsonResponse{Succeed: true, Data: data, Message: ""}
+	renderJson(w, res)
+}
+
+func breakerStats(w http.ResponseWriter, r *http.Request) {
+	bt, _ := json.Marshal(GetBreakerStats())
+	data := (*json.RawMessage)(&bt)
+	res := &JsonResponse{Succeed: true, Data: data, Message: ""}
+	renderJson(w, res)
+}
+
 func StartWeb() {
 	Token = RandomString(32)
 	rtr := mux.NewRouter()
@@ -195,6 +553,16 @@ func StartWeb() {
 	rtr.HandleFunc("/set", tokenRequired(set))
 	rtr.HandleFunc("/settings", tokenRequired(settings))
 	rtr.HandleFunc("/shadowsocks", tokenRequired(shadowsocks))
+	rtr.HandleFunc("/shadowsocks/batch", tokenRequired(batchTunnels))
+	rtr.HandleFunc("/tunnels", tokenRequired(tunnelRecords))
+	rtr.HandleFunc("/tunnels/{id}", tokenRequired(tunnelRecords))
+	rtr.HandleFunc("/socks_users", tokenRequired(socksUsers))
+	rtr.HandleFunc("/probes", tokenRequired(probeStats))
+	rtr.HandleFunc("/breakers", tokenRequired(breakerStats))
+	rtr.HandleFunc("/subscriptions", tokenRequired(subscriptions))
+	rtr.HandleFunc("/import", tokenRequired(importTunnels))
+	rtr.HandleFunc("/export", tokenRequired(exportTunnels))
+	rtr.HandleFunc("/qrcode", tokenRequired(qrCode))
 	rtr.PathPrefix("/").HandlerFunc(static)
 	http.Handle("/", rtr)
 	srv := &http.Server{
@@ -217,11 +585,30 @@ func onTrayReady() {
 	systray.SetTitle("")
 	systray.SetTooltip("铜蛇")
 
+	config, _ := LoadConfig()
+	if err := SetTunnels(config.GetSSTunnels()); err != nil {
+		log.Printf("Could not set tunnels: %v", err)
+	}
+	if err := SetUpstreamProxy(config.Get("upstream_proxy")); err != nil {
+		log.Printf("Could not set upstream proxy: %v", err)
+	}
+	SetSocksUsers(config.SocksUsers)
+	SetRules(config)
+	SetDeferredReply(config.Get("socks_deferred_reply") == "on")
+	if err := SetPolicy(config.Get("lb_policy")); err != nil {
+		log.Printf("Could not set policy %s: %v", config.Get("lb_policy"), err)
+	}
+	SetRaceDial(config.Get("race_dial") == "on")
+	SetRaceDelay(config.GetInt("race_delay", defaultRaceDelay))
+	// the users and rules are in place before anyone can connect
 	go StartSS()
 	go StartHttpProxy()
-
-	config, _ := LoadConfig()
-	SetTunnels(config.GetSSTunnels())
+	go StartMixed()
+	SetProbeInterval(config.GetInt("probe_interval", defaultProbeInterval))
+	go StartProber()
+	SetSubscriptionInterval(config.GetInt("subscription_interval", defaultSubscriptionInterval))
+	go StartSubscriptions()
+	go TrafficCounter.StartSync()
 	SetPac()
 	go traceTray()
 	StartWeb()
@@ -258,6 +645,8 @@ func traceTray() {
 		case <-mQuit.ClickedCh:
 			log.Println("clear pac settings...")
 			UnsetPac()
+			log.Println("stop plugins ...")
+			StopPlugins()
 			log.Println("sync rest traffic ...")
 			TrafficCounter.Sync()
 			log.Println("shut tray...")
@@ -279,6 +668,44 @@ func traceTray() {
 	}
 }
 
+// importCommand imports the tunnels of a client config file, for the
+// -import flag.
+func importCommand(file string) int {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	config, _ := LoadConfig()
+	entries, err := config.ImportTunnels(data, true)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	failed := 0
+	for _, e := range entries {
+		if e.Error != "" {
+			failed++
+			fmt.Printf("%s: %s\n", e.Name, e.Error)
+		} else {
+			fmt.Printf("%s: 已导入\n", e.Name)
+		}
+	}
+	fmt.Printf("%d/%d imported\n", len(entries)-failed, len(entries))
+	return 0
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "server" {
+		fs := flag.NewFlagSet("server", flag.ExitOnError)
+		configFile := fs.String("c", "config.json", "shadowsocks server config, with server_port and password or port_password, and method")
+		fs.Parse(os.Args[2:])
+		os.Exit(serverCommand(*configFile))
+	}
+	importFile := flag.String("import", "", "import tunnels from a shadowsocks-windows, shadowsocks-libev, SIP008 or Clash config, or a list of ss:// uris, then exit")
+	flag.Parse()
+	if *importFile != "" {
+		os.Exit(importCommand(*importFile))
+	}
 	systray.Run(onTrayReady)
 }
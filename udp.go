@@ -0,0 +1,330 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	ss "github.com/dawei101/shadowsocks-go/shadowsocks"
+)
+
+const (
+	udpBufSize           = 64 * 1024
+	udpReassemblyTimeout = 5 * time.Second
+)
+
+var errUDPHeader = errors.New("socks udp header malformed")
+
+// parseUDPHeader splits a SOCKS5 UDP request datagram into its fragment
+// number, the raw target address (same shape as the one getRequest returns)
+// and the payload.
+//
+//	+----+------+------+----------+----------+----------+
+//	|RSV | FRAG | ATYP | DST.ADDR | DST.PORT |   DATA   |
+//	+----+------+------+----------+----------+----------+
+//	| 2  |  1   |  1   | Variable |    2     | Variable |
+//	+----+------+------+----------+----------+----------+
+func parseUDPHeader(b []byte) (frag byte, rawaddr, data []byte, err error) {
+	const (
+		idFrag = 2
+		idType = 3
+	)
+	if len(b) < idType+1 {
+		return 0, nil, nil, errUDPHeader
+	}
+	addrLen := -1
+	switch b[idType] {
+	case socksAddrIPv4:
+		addrLen = 1 + net.IPv4len + 2
+	case socksAddrIPv6:
+		addrLen = 1 + net.IPv6len + 2
+	case socksAddrDomain:
+		if len(b) < idType+2 {
+			return 0, nil, nil, errUDPHeader
+		}
+		addrLen = 1 + 1 + int(b[idType+1]) + 2
+	default:
+		return 0, nil, nil, errAddrType
+	}
+	if len(b) < idType+addrLen {
+		return 0, nil, nil, errUDPHeader
+	}
+	return b[idFrag], b[idType : idType+addrLen], b[idType+addrLen:], nil
+}
+
+// udpReassembler rebuilds fragmented SOCKS5 UDP datagrams as described in
+// section 7 of RFC 1928. Fragments have to arrive in order; a gap, a restart
+// or an expired queue drops whatever was collected so far.
+type udpReassembler struct {
+	rawaddr  []byte
+	data     []byte
+	last     byte
+	deadline time.Time
+}
+
+func (r *udpReassembler) reset() {
+	r.rawaddr, r.data, r.last = nil, nil, 0
+}
+
+// add feeds one datagram into the queue and returns the shadowsocks UDP
+// payload (raw address followed by data) once a full datagram is available.
+func (r *udpReassembler) add(frag byte, rawaddr, data []byte) (payload []byte, ok bool) {
+	if frag == 0 {
+		r.reset()
+		payload = make([]byte, 0, len(rawaddr)+len(data))
+		payload = append(payload, rawaddr...)
+		return append(payload, data...), true
+	}
+	pos, end := frag&0x7f, frag&0x80 != 0
+	if r.last != 0 && (pos != r.last+1 || time.Now().After(r.deadline) || !bytes.Equal(rawaddr, r.rawaddr)) {
+		r.reset()
+	}
+	if r.last == 0 {
+		if pos != 1 {
+			return nil, false
+		}
+		r.rawaddr = append([]byte{}, rawaddr...)
+		r.deadline = time.Now().Add(udpReassemblyTimeout)
+	}
+	r.data = append(r.data, data...)
+	r.last = pos
+	if !end {
+		return nil, false
+	}
+	payload = append(r.rawaddr, r.data...)
+	r.reset()
+	return payload, true
+}
+
+type udpAssociation struct {
+	client   *net.UDPConn   // socket the socks client sends datagrams to
+	remote   net.PacketConn // shadowsocks side
+	direct   *net.UDPConn   // datagrams the routing rules keep off the tunnels
+	server   *net.UDPAddr
+	clientIP net.IP
+	tl       *TrafficListener
+
+	mu   sync.Mutex
+	peer *net.UDPAddr // client udp address, learned from its first datagram
+}
+
+func (a *udpAssociation) setPeer(addr *net.UDPAddr) {
+	a.mu.Lock()
+	a.peer = addr
+	a.mu.Unlock()
+}
+
+func (a *udpAssociation) getPeer() *net.UDPAddr {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.peer
+}
+
+func (a *udpAssociation) relayToServer() {
+	var frags udpReassembler
+	buf := make([]byte, udpBufSize)
+	for {
+		n, from, err := a.client.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		// only the client owning the association may use it
+		if !from.IP.Equal(a.clientIP) {
+			continue
+		}
+		a.setPeer(from)
+		frag, rawaddr, data, err := parseUDPHeader(buf[:n])
+		if err != nil {
+			log.Println("socks udp:", err)
+			continue
+		}
+		payload, ok := frags.add(frag, rawaddr, data)
+		if !ok {
+			continue
+		}
+		// datagrams follow the routing rules like tcp connections do
+		switch routeAddr(rawAddrString(rawaddr)) {
+		case ruleReject:
+			continue
+		case ruleDirect:
+			a.sendDirect(rawaddr, payload[len(rawaddr):])
+			continue
+		}
+		if _, err = a.remote.WriteTo(payload, a.server); err != nil {
+			log.Println("error sending udp to shadowsocks server:", err)
+			continue
+		}
+		a.tl.WhenOut(len(payload))
+	}
+}
+
+func (a *udpAssociation) sendDirect(rawaddr, data []byte) {
+	addr, err := net.ResolveUDPAddr("udp", rawAddrString(rawaddr))
+	if err != nil {
+		log.Println("error resolving udp target:", err)
+		return
+	}
+	if _, err = a.direct.WriteToUDP(data, addr); err != nil {
+		log.Println("error sending udp directly:", err)
+	}
+}
+
+// relayDirectToClient passes the answers to datagrams sent directly back to
+// the client, with the udp header the shadowsocks server would have added.
+func (a *udpAssociation) relayDirectToClient() {
+	buf := make([]byte, udpBufSize)
+	for {
+		n, from, err := a.direct.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		peer := a.getPeer()
+		if peer == nil {
+			continue
+		}
+		// a reply encodes the address the same way after its first 3 bytes
+		dgram := socksReply(socksRepSucceeded, from)
+		dgram[0] = 0 // RSV, RSV and FRAG
+		if _, err = a.client.WriteToUDP(append(dgram, buf[:n]...), peer); err != nil {
+			log.Println("error sending udp to socks client:", err)
+		}
+	}
+}
+
+func (a *udpAssociation) relayToClient() {
+	const lenHeader = 3 // RSV + FRAG, the address comes back from the server
+	buf := make([]byte, udpBufSize)
+	for {
+		n, _, err := a.remote.ReadFrom(buf[lenHeader:])
+		if err != nil {
+			// anyone can send a datagram that does not decrypt, only errors
+			// of the socket end the association
+			if _, ok := err.(net.Error); ok {
+				return
+			}
+			log.Println("dropped udp from shadowsocks server:", err)
+			continue
+		}
+		a.tl.WhenIn(n)
+		peer := a.getPeer()
+		if peer == nil {
+			continue
+		}
+		buf[0], buf[1], buf[2] = 0, 0, 0
+		if _, err = a.client.WriteToUDP(buf[:lenHeader+n], peer); err != nil {
+			log.Println("error sending udp to socks client:", err)
+		}
+	}
+}
+
+func (a *udpAssociation) Close() {
+	a.client.Close()
+	a.remote.Close()
+	a.direct.Close()
+}
+
+// UDP has no handshake that tells a dead server from a quiet one, so the
+// relay goes by the circuit state gathered from tcp connections and uses the
+// first healthy server in config order.
+func udpServer() *ServerCipher {
+	servers.RLock()
+	defer servers.RUnlock()
+	if len(servers.srvCipher) == 0 {
+		return nil
+	}
+	for _, se := range servers.srvCipher {
+		if se.breaker.Healthy() {
+			return se
+		}
+	}
+	return servers.srvCipher[0]
+}
+
+func serverPacketConn(pc net.PacketConn, se *ServerCipher) (net.PacketConn, error) {
+	if se.aead != nil {
+		if se.aead.ss2022 {
+			return nil, errSS2022UDP
+		}
+		return newAEADPacketConn(pc, se.aead), nil
+	}
+	return ss.NewSecurePacketConn(pc, se.cipher.Copy(), false), nil
+}
+
+// handleUDPAssociate serves a UDP ASSOCIATE request. The association lives
+// as long as the controlling tcp connection does.
+func handleUDPAssociate(conn net.Conn, tl *TrafficListener) {
+	fail := func() {
+		conn.Write(socksReply(socksRepFailure, nil))
+	}
+	se := udpServer()
+	if se == nil {
+		log.Println("no shadowsocks server for udp association")
+		fail()
+		return
+	}
+	server, err := net.ResolveUDPAddr("udp", se.server)
+	if err != nil {
+		log.Println("error resolving shadowsocks server:", err)
+		fail()
+		return
+	}
+	clientIP := conn.RemoteAddr().(*net.TCPAddr).IP
+	client, err := net.ListenUDP("udp", &net.UDPAddr{IP: conn.LocalAddr().(*net.TCPAddr).IP})
+	if err != nil {
+		log.Println("error listening udp for socks client:", err)
+		fail()
+		return
+	}
+	pc, err := net.ListenPacket("udp", "")
+	if err != nil {
+		log.Println("error listening udp for shadowsocks server:", err)
+		client.Close()
+		fail()
+		return
+	}
+	remote, err := serverPacketConn(pc, se)
+	if err != nil {
+		log.Println("error associating udp:", err)
+		pc.Close()
+		client.Close()
+		fail()
+		return
+	}
+	direct, err := net.ListenUDP("udp", nil)
+	if err != nil {
+		log.Println("error listening udp for direct datagrams:", err)
+		remote.Close()
+		client.Close()
+		fail()
+		return
+	}
+	a := &udpAssociation{
+		client:   client,
+		remote:   remote,
+		direct:   direct,
+		server:   server,
+		clientIP: clientIP,
+		tl:       tl,
+	}
+	defer a.Close()
+
+	if _, err = conn.Write(socksReply(socksRepSucceeded, client.LocalAddr())); err != nil {
+		log.Println("send udp association confirmation:", err)
+		return
+	}
+	log.Printf("udp association for %s at %s via %s\n", conn.RemoteAddr(), client.LocalAddr(), se.server)
+	go a.relayToServer()
+	go a.relayToClient()
+	go a.relayDirectToClient()
+
+	// the client keeps the tcp connection open for as long as it needs the
+	// association, so block until it goes away
+	conn.SetReadDeadline(time.Time{})
+	io.Copy(ioutil.Discard, conn)
+	log.Println("closed udp association for", conn.RemoteAddr())
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestParseUDPHeader(t *testing.T) {
+	dgram := []byte{0, 0, 0, 3, 6, 'a', '.', 'b', '.', 'c', 'n', 0x01, 0xbb, 'h', 'i'}
+	frag, rawaddr, data, err := parseUDPHeader(dgram)
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if frag != 0 || !bytes.Equal(rawaddr, dgram[3:13]) || string(data) != "hi" {
+		t.Errorf("unexpected parse result: %d %v %q", frag, rawaddr, data)
+	}
+	if _, _, _, err = parseUDPHeader(dgram[:8]); err == nil {
+		t.Error("truncated datagram should not parse")
+	}
+}
+
+func TestUDPReassembler(t *testing.T) {
+	var r udpReassembler
+	addr := []byte{1, 127, 0, 0, 1, 0, 53}
+	if _, ok := r.add(1, addr, []byte("ab")); ok {
+		t.Fatal("first fragment should not complete a datagram")
+	}
+	payload, ok := r.add(0x82, addr, []byte("cd"))
+	if !ok || !bytes.Equal(payload, append(append([]byte{}, addr...), "abcd"...)) {
+		t.Errorf("unexpected payload: %v %v", ok, payload)
+	}
+	// a gap in the sequence drops the queue
+	r.add(1, addr, []byte("ab"))
+	if _, ok := r.add(0x83, addr, []byte("ef")); ok {
+		t.Error("fragments with a gap should be dropped")
+	}
+}
+
+func TestRelayToClientSkipsBadDatagrams(t *testing.T) {
+	listen := func() *net.UDPConn {
+		c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	client, pc, direct, server, peer := listen(), listen(), listen(), listen(), listen()
+	defer server.Close()
+	defer peer.Close()
+	cipher, _ := newAEADCipher("aes-256-gcm", "secret")
+	a := &udpAssociation{client: client, remote: newAEADPacketConn(pc, cipher), direct: direct, tl: TrafficCounter}
+	a.setPeer(peer.LocalAddr().(*net.UDPAddr))
+	done := make(chan struct{})
+	go func() {
+		a.relayToClient()
+		close(done)
+	}()
+
+	// spoofed, truncated and corrupt datagrams are dropped
+	server.WriteTo([]byte("spoofed datagram that is long enough to have a salt"), pc.LocalAddr())
+	server.WriteTo([]byte("short"), pc.LocalAddr())
+	answer := []byte{socksAddrIPv4, 1, 2, 3, 4, 0, 53, 'o', 'k'}
+	newAEADPacketConn(server, cipher).WriteTo(answer, pc.LocalAddr())
+
+	buf := make([]byte, 64)
+	peer.SetReadDeadline(time.Now().Add(2 * time.Second))
+	n, _, err := peer.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("association ended on bad datagrams: %v", err)
+	}
+	if !bytes.Equal(buf[:n], append([]byte{0, 0, 0}, answer...)) {
+		t.Errorf("unexpected datagram %v", buf[:n])
+	}
+	a.Close()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Error("relay did not stop with its socket")
+	}
+}
+
+func TestUDPFollowsRules(t *testing.T) {
+	rules, _ := parseRules("DST-PORT,9,reject\nIP-CIDR,127.0.0.0/8,direct\nMATCH,proxy")
+	currentRules.Store(rules)
+	defer currentRules.Store([]*Rule{})
+	listen := func() *net.UDPConn {
+		c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	client, pc, direct, server, target, peer := listen(), listen(), listen(), listen(), listen(), listen()
+	defer server.Close()
+	defer target.Close()
+	defer peer.Close()
+	cipher, _ := newAEADCipher("aes-256-gcm", "secret")
+	a := &udpAssociation{
+		client:   client,
+		remote:   newAEADPacketConn(pc, cipher),
+		direct:   direct,
+		server:   server.LocalAddr().(*net.UDPAddr),
+		clientIP: net.IPv4(127, 0, 0, 1),
+		tl:       TrafficCounter,
+	}
+	defer a.Close()
+	go a.relayToServer()
+	go a.relayDirectToClient()
+
+	dgram := func(addr []byte, data string) []byte {
+		return append(append([]byte{0, 0, 0}, addr...), data...)
+	}
+	to := target.LocalAddr().(*net.UDPAddr)
+	local := []byte{socksAddrIPv4, 127, 0, 0, 1, byte(to.Port >> 8), byte(to.Port)}
+	rejected := []byte{socksAddrIPv4, 1, 2, 3, 4, 0, 9}
+	proxied := []byte{socksAddrIPv4, 1, 2, 3, 4, 0, 53}
+	peer.WriteTo(dgram(rejected, "no"), client.LocalAddr())
+	peer.WriteTo(dgram(local, "ping"), client.LocalAddr())
+	peer.WriteTo(dgram(proxied, "query"), client.LocalAddr())
+
+	buf := make([]byte, 128)
+	target.SetReadDeadline(time.Now().Add(2 * time.Second))
+	n, from, err := target.ReadFrom(buf)
+	if err != nil || string(buf[:n]) != "ping" {
+		t.Fatalf("local datagram not sent directly: %q %v", buf[:n], err)
+	}
+	target.WriteTo([]byte("pong"), from)
+	peer.SetReadDeadline(time.Now().Add(2 * time.Second))
+	if n, _, err = peer.ReadFrom(buf); err != nil || !bytes.Equal(buf[:n], dgram(local, "pong")) {
+		t.Errorf("unexpected direct answer %v %v", buf[:n], err)
+	}
+
+	server.SetReadDeadline(time.Now().Add(2 * time.Second))
+	n, _, err = newAEADPacketConn(server, cipher).ReadFrom(buf)
+	if err != nil || !bytes.Equal(buf[:n], append(proxied, "query"...)) {
+		t.Errorf("expected only the proxied datagram at the server, got %v %v", buf[:n], err)
+	}
+}
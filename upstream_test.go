@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestHttpConnectDialer(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil {
+			return
+		}
+		if req.Method != "CONNECT" || req.Host != "example.com:8388" || req.Header.Get("Proxy-Authorization") != "Basic dTpw" {
+			conn.Write([]byte("HTTP/1.1 407 Proxy Authentication Required\r\n\r\n"))
+			return
+		}
+		// greeting sent together with the response must not get lost
+		conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\nhello"))
+	}()
+
+	u, _ := url.Parse("http://u:p@" + ln.Addr().String())
+	d, _ := newHttpConnectDialer(u, &net.Dialer{})
+	conn, err := d.Dial("tcp", "example.com:8388")
+	if err != nil {
+		t.Fatalf("dial through upstream proxy: %v", err)
+	}
+	defer conn.Close()
+	if b, _ := ioutil.ReadAll(conn); string(b) != "hello" {
+		t.Errorf("unexpected data after CONNECT: %q", b)
+	}
+}
+
+func TestHttpConnectDialerTimeout(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		// accepts and never answers
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		ioutil.ReadAll(conn)
+	}()
+
+	d := &httpConnectDialer{addr: ln.Addr().String(), forward: &net.Dialer{}, timeout: 100 * time.Millisecond}
+	done := make(chan error, 1)
+	go func() {
+		_, err := d.Dial("tcp", "example.com:8388")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("dial through a silent proxy succeeded")
+		}
+	case <-time.After(2 * time.Second):
+		t.Error("dial through a silent proxy hangs")
+	}
+}
+
+func TestSetRefusesBadUpstreamProxy(t *testing.T) {
+	defer tempStorage(t)()
+	defer SetUpstreamProxy("")
+	for _, u := range []string{"ftp://127.0.0.1:21", "http://[::1", "127.0.0.1:1080"} {
+		if callSet("upstream_proxy", u) {
+			t.Errorf("upstream proxy %s set", u)
+		}
+	}
+	if config, _ := LoadConfig(); config.Get("upstream_proxy") != "" {
+		t.Errorf("bad upstream proxy saved: %s", config.Get("upstream_proxy"))
+	}
+	if !callSet("upstream_proxy", "socks5://127.0.0.1:1080") || upstreamDialer() == nil {
+		t.Error("socks5 upstream proxy refused")
+	}
+}